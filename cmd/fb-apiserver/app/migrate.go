@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/loveRyujin/fast_blog/cmd/fb-apiserver/app/options"
+	"github.com/loveRyujin/fast_blog/internal/pkg/migrate"
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// defaultMigrationDir 是迁移文件在源码中的存放目录，migrate create 会在其中为每个存储后端生成迁移文件.
+const defaultMigrationDir = "internal/apiserver/migrations"
+
+// newMigrateCommand 创建 migrate 子命令，用于管理数据库迁移.
+func newMigrateCommand(opts *options.ServerOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "migrate",
+		Short:        "Manage database schema migrations",
+		Long:         "Apply, roll back and inspect the SQL migrations embedded in fb-apiserver for the configured db.type",
+		SilenceUsage: true,
+	}
+
+	var upSteps int
+	upCmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate(opts, func(ctx context.Context, m *migrate.Migrator) error {
+				done, err := m.Up(ctx, upSteps)
+				for _, migration := range done {
+					fmt.Fprintf(cmd.OutOrStdout(), "applied %04d_%s\n", migration.Version, migration.Name)
+				}
+				if err == nil && len(done) == 0 {
+					fmt.Fprintln(cmd.OutOrStdout(), "no pending migrations")
+				}
+				return err
+			})
+		},
+	}
+	upCmd.Flags().IntVar(&upSteps, "steps", 0, "maximum number of migrations to apply, 0 means all pending migrations.")
+
+	var downSteps int
+	downCmd := &cobra.Command{
+		Use:   "down",
+		Short: "Roll back applied migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate(opts, func(ctx context.Context, m *migrate.Migrator) error {
+				done, err := m.Down(ctx, downSteps)
+				for _, migration := range done {
+					fmt.Fprintf(cmd.OutOrStdout(), "rolled back %04d_%s\n", migration.Version, migration.Name)
+				}
+				return err
+			})
+		},
+	}
+	downCmd.Flags().IntVar(&downSteps, "steps", 1, "number of migrations to roll back.")
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of all migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate(opts, func(ctx context.Context, m *migrate.Migrator) error {
+				statuses, err := m.Status(ctx)
+				if err != nil {
+					return err
+				}
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+				for _, status := range statuses {
+					appliedAt := "pending"
+					if status.AppliedAt != nil {
+						appliedAt = status.AppliedAt.Format(time.DateTime)
+					}
+					fmt.Fprintf(w, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+				}
+				return w.Flush()
+			})
+		},
+	}
+
+	var dir string
+	createCmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create empty up/down migration files for every db type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dirs := []string{
+				filepath.Join(dir, genericoptions.DBTypeMySQL),
+				filepath.Join(dir, genericoptions.DBTypeSQLite),
+				filepath.Join(dir, genericoptions.DBTypePostgreSQL),
+			}
+			files, err := migrate.Create(args[0], dirs...)
+			for _, file := range files {
+				fmt.Fprintf(cmd.OutOrStdout(), "created %s\n", file)
+			}
+			return err
+		},
+	}
+	createCmd.Flags().StringVar(&dir, "dir", defaultMigrationDir, "directory containing the migrations of each db type.")
+
+	cmd.AddCommand(upCmd, downCmd, statusCmd, createCmd)
+	return cmd
+}
+
+// runMigrate 读取配置并连接数据库，使用当前存储后端的迁移器执行 fn.
+func runMigrate(opts *options.ServerOptions, fn func(ctx context.Context, m *migrate.Migrator) error) error {
+	if err := viper.Unmarshal(opts); err != nil {
+		return err
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	cfg := opts.Config()
+	db, err := cfg.NewDB()
+	if err != nil {
+		return err
+	}
+	m, err := cfg.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	return fn(context.Background(), m)
+}
@@ -15,22 +15,68 @@ var availableServerOptions = sets.New(
 	apiserver.GRPCGatewayServerMode,
 )
 
+var availableStores = sets.New(
+	apiserver.DBStore,
+	apiserver.MemoryStore,
+)
+
 type ServerOptions struct {
-	ServerMode   string                       `json:"server-mode" mapstructure:"server-mode"` // 服务器模式，支持grpc、http、grpc-gateway
-	MysqlOptions *genericoptions.MysqlOptions `json:"mysql" mapstructure:"mysql"`
-	GRPCOptions  *genericoptions.GRPCOptions  `json:"grpc" mapstructure:"grpc"`
-	HTTPOptions  *genericoptions.HTTPOptions  `json:"http" mapstructure:"http"`
-	JWTKey       string                       `json:"jwt-key" mapstructure:"jwt-key"`
-	Expiration   time.Duration                `json:"expiration" mapstructure:"expiration"`
+	ServerMode              string                            `json:"server-mode" mapstructure:"server-mode"` // 服务器模式，支持grpc、http、grpc-gateway
+	Store                   string                            `json:"store" mapstructure:"store"`             // 存储层实现，支持db、memory
+	DBOptions               *genericoptions.DBOptions         `json:"db" mapstructure:"db"`
+	MysqlOptions            *genericoptions.MysqlOptions      `json:"mysql" mapstructure:"mysql"`
+	SQLiteOptions           *genericoptions.SQLiteOptions     `json:"sqlite" mapstructure:"sqlite"`
+	PostgreSQLOptions       *genericoptions.PostgreSQLOptions `json:"postgresql" mapstructure:"postgresql"`
+	AutoMigrate             bool                              `json:"auto-migrate" mapstructure:"auto-migrate"` // 服务启动时自动执行未执行的数据库迁移
+	GRPCOptions             *genericoptions.GRPCOptions       `json:"grpc" mapstructure:"grpc"`
+	HTTPOptions             *genericoptions.HTTPOptions       `json:"http" mapstructure:"http"`
+	SearchOptions           *genericoptions.SearchOptions     `json:"search" mapstructure:"search"`
+	CacheOptions            *genericoptions.CacheOptions      `json:"cache" mapstructure:"cache"`
+	RedisOptions            *genericoptions.RedisOptions      `json:"redis" mapstructure:"redis"`
+	LockoutOptions          *genericoptions.LockoutOptions    `json:"lockout" mapstructure:"lockout"`
+	JWTKey                  string                            `json:"jwt-key" mapstructure:"jwt-key"`
+	JWTSigningKeyFile       string                            `json:"jwt-signing-key-file" mapstructure:"jwt-signing-key-file"`             // 签发 token 使用的 RSA 或 Ed25519 私钥 PEM 文件，为空时使用 jwt-key 以 HS256 签发
+	JWTVerificationKeyFiles []string                          `json:"jwt-verification-key-files" mapstructure:"jwt-verification-key-files"` // 额外用于验证 token 的密钥 PEM 文件，轮换密钥时保留旧密钥直到其签发的 token 过期
+	JWTIssuer               string                            `json:"jwt-issuer" mapstructure:"jwt-issuer"`                                 // token 的签发者（iss），为空时不校验
+	JWTAudience             string                            `json:"jwt-audience" mapstructure:"jwt-audience"`                             // token 的受众（aud），为空时不校验
+	Expiration              time.Duration                     `json:"expiration" mapstructure:"expiration"`                                 // 访问令牌的有效期
+	RefreshTokenExpiration  time.Duration                     `json:"refresh-token-expiration" mapstructure:"refresh-token-expiration"`     // 刷新令牌的有效期，必须大于访问令牌的有效期
+	TOTPIssuer              string                            `json:"totp-issuer" mapstructure:"totp-issuer"`                               // 两步验证的签发者，显示在验证器应用中
+	TOTPChallengeExpiration time.Duration                     `json:"totp-challenge-expiration" mapstructure:"totp-challenge-expiration"`   // 两步验证挑战令牌的有效期，密码验证通过后需要在此时间内提交验证码
+	AuthnWhitelist          []string                          `json:"authn-whitelist" mapstructure:"authn-whitelist"`                       // 额外无需认证的 gRPC 方法全名，例如 /v1.FastBlog/GetPost
+	PolicyReloadInterval    time.Duration                     `json:"policy-reload-interval" mapstructure:"policy-reload-interval"`         // 从 casbin_rule 表重新加载授权策略的时间间隔
+	SchedulerInterval       time.Duration                     `json:"scheduler-interval" mapstructure:"scheduler-interval"`                 // 检查并发布到期定时博客的时间间隔
+	UserDeletionGracePeriod time.Duration                     `json:"user-deletion-grace-period" mapstructure:"user-deletion-grace-period"` // 用户注销的冷静期，为 0 时立即删除用户数据
+	PurgeInterval           time.Duration                     `json:"purge-interval" mapstructure:"purge-interval"`                         // 彻底删除冷静期已结束用户和过期回收站文章的时间间隔
+	TrashRetentionDays      int                               `json:"trash-retention-days" mapstructure:"trash-retention-days"`             // 文章在回收站中保留的天数，为 0 时不自动清理回收站
+	LoginAuditRetentionDays int                               `json:"login-audit-retention-days" mapstructure:"login-audit-retention-days"` // 登录失败审计记录保留的天数，为 0 时永久保留
 }
 
 func NewServerOptions() *ServerOptions {
 	return &ServerOptions{
-		ServerMode:   apiserver.GRPCGatewayServerMode,
-		MysqlOptions: genericoptions.NewMysqlOptions(),
-		GRPCOptions:  genericoptions.NewGRPCOptions(),
-		HTTPOptions:  genericoptions.NewHTTPOptions(),
-		Expiration:   2 * time.Hour,
+		ServerMode:              apiserver.GRPCGatewayServerMode,
+		Store:                   apiserver.DBStore,
+		DBOptions:               genericoptions.NewDBOptions(),
+		MysqlOptions:            genericoptions.NewMysqlOptions(),
+		SQLiteOptions:           genericoptions.NewSQLiteOptions(),
+		PostgreSQLOptions:       genericoptions.NewPostgreSQLOptions(),
+		GRPCOptions:             genericoptions.NewGRPCOptions(),
+		HTTPOptions:             genericoptions.NewHTTPOptions(),
+		SearchOptions:           genericoptions.NewSearchOptions(),
+		CacheOptions:            genericoptions.NewCacheOptions(),
+		RedisOptions:            genericoptions.NewRedisOptions(),
+		LockoutOptions:          genericoptions.NewLockoutOptions(),
+		JWTIssuer:               "fast_blog",
+		JWTAudience:             "fast_blog",
+		Expiration:              15 * time.Minute,
+		RefreshTokenExpiration:  30 * 24 * time.Hour,
+		TOTPIssuer:              "fast_blog",
+		TOTPChallengeExpiration: 5 * time.Minute,
+		PolicyReloadInterval:    10 * time.Second,
+		SchedulerInterval:       30 * time.Second,
+		PurgeInterval:           time.Hour,
+		TrashRetentionDays:      30,
+		LoginAuditRetentionDays: 90,
 	}
 }
 
@@ -40,10 +86,72 @@ func (o *ServerOptions) Validate() error {
 		fmt.Printf("invalid server mode: %s, available modes: %v\n", o.ServerMode, availableServerOptions.UnsortedList())
 	}
 
-	if err := o.MysqlOptions.Validate(); err != nil {
+	if o.JWTSigningKeyFile == "" && len(o.JWTVerificationKeyFiles) > 0 {
+		return fmt.Errorf("jwt-verification-key-files requires jwt-signing-key-file")
+	}
+
+	if o.Expiration <= 0 {
+		return fmt.Errorf("expiration must be greater than 0")
+	}
+
+	// 撤销检查依赖刷新令牌记录，过期的刷新令牌被清理时其签发的访问令牌必须已经过期
+	if o.RefreshTokenExpiration <= o.Expiration {
+		return fmt.Errorf("refresh-token-expiration must be greater than expiration")
+	}
+
+	if o.TOTPChallengeExpiration <= 0 {
+		return fmt.Errorf("totp-challenge-expiration must be greater than 0")
+	}
+
+	if o.PolicyReloadInterval <= 0 {
+		return fmt.Errorf("policy-reload-interval must be greater than 0")
+	}
+
+	if o.SchedulerInterval <= 0 {
+		return fmt.Errorf("scheduler-interval must be greater than 0")
+	}
+
+	if o.UserDeletionGracePeriod < 0 {
+		return fmt.Errorf("user-deletion-grace-period must not be negative")
+	}
+
+	if o.PurgeInterval <= 0 {
+		return fmt.Errorf("purge-interval must be greater than 0")
+	}
+
+	if o.TrashRetentionDays < 0 {
+		return fmt.Errorf("trash-retention-days must not be negative")
+	}
+
+	if o.LoginAuditRetentionDays < 0 {
+		return fmt.Errorf("login-audit-retention-days must not be negative")
+	}
+
+	if !availableStores.Has(o.Store) {
+		return fmt.Errorf("invalid store: %s, available stores: %v", o.Store, sets.List(availableStores))
+	}
+
+	if err := o.DBOptions.Validate(); err != nil {
 		return err
 	}
 
+	// 只校验当前使用的存储后端配置，内存存储不需要数据库连接
+	switch {
+	case o.Store == apiserver.MemoryStore:
+	case o.DBOptions.Type == genericoptions.DBTypeSQLite:
+		if err := o.SQLiteOptions.Validate(); err != nil {
+			return err
+		}
+	case o.DBOptions.Type == genericoptions.DBTypePostgreSQL:
+		if err := o.PostgreSQLOptions.Validate(); err != nil {
+			return err
+		}
+	default:
+		if err := o.MysqlOptions.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if err := o.HTTPOptions.Validate(); err != nil {
 		return err
 	}
@@ -52,17 +160,65 @@ func (o *ServerOptions) Validate() error {
 		return err
 	}
 
+	if err := o.SearchOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.CacheOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.LockoutOptions.Validate(); err != nil {
+		return err
+	}
+
+	// 只有使用 redis 缓存或使用 redis 保存登录失败计数时才需要 redis 连接
+	if o.CacheOptions.Type == genericoptions.CacheTypeRedis || o.LockoutOptions.Store == genericoptions.LockoutStoreRedis {
+		if err := o.RedisOptions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// MySQL 全文检索依赖 post 表上的 FULLTEXT 索引
+	if o.SearchOptions.Engine == genericoptions.SearchEngineMySQL &&
+		(o.Store != apiserver.DBStore || o.DBOptions.Type != genericoptions.DBTypeMySQL) {
+		return fmt.Errorf("search engine %s requires store %s with db type %s", genericoptions.SearchEngineMySQL, apiserver.DBStore, genericoptions.DBTypeMySQL)
+	}
+
 	return nil
 }
 
 // Config 基于ServerOptions配置生成apiserver.Config
 func (o *ServerOptions) Config() *apiserver.Config {
 	return &apiserver.Config{
-		ServerMode:   o.ServerMode,
-		MysqlOptions: o.MysqlOptions,
-		HTTPOptions:  o.HTTPOptions,
-		GRPCOptions:  o.GRPCOptions,
-		JWTKey:       o.JWTKey,
-		Expiration:   o.Expiration,
+		ServerMode:              o.ServerMode,
+		Store:                   o.Store,
+		DBOptions:               o.DBOptions,
+		MysqlOptions:            o.MysqlOptions,
+		SQLiteOptions:           o.SQLiteOptions,
+		PostgreSQLOptions:       o.PostgreSQLOptions,
+		AutoMigrate:             o.AutoMigrate,
+		HTTPOptions:             o.HTTPOptions,
+		GRPCOptions:             o.GRPCOptions,
+		SearchOptions:           o.SearchOptions,
+		CacheOptions:            o.CacheOptions,
+		RedisOptions:            o.RedisOptions,
+		LockoutOptions:          o.LockoutOptions,
+		JWTKey:                  o.JWTKey,
+		JWTSigningKeyFile:       o.JWTSigningKeyFile,
+		JWTVerificationKeyFiles: o.JWTVerificationKeyFiles,
+		JWTIssuer:               o.JWTIssuer,
+		JWTAudience:             o.JWTAudience,
+		Expiration:              o.Expiration,
+		RefreshTokenExpiration:  o.RefreshTokenExpiration,
+		TOTPIssuer:              o.TOTPIssuer,
+		TOTPChallengeExpiration: o.TOTPChallengeExpiration,
+		AuthnWhitelist:          o.AuthnWhitelist,
+		PolicyReloadInterval:    o.PolicyReloadInterval,
+		SchedulerInterval:       o.SchedulerInterval,
+		UserDeletionGracePeriod: o.UserDeletionGracePeriod,
+		PurgeInterval:           o.PurgeInterval,
+		TrashRetention:          time.Duration(o.TrashRetentionDays) * 24 * time.Hour,
+		LoginAuditRetention:     time.Duration(o.LoginAuditRetentionDays) * 24 * time.Hour,
 	}
 }
@@ -37,9 +37,16 @@ func NewFastBlogCommand() *cobra.Command {
 	// 将命令行参数解析到变量当中
 	cmd.PersistentFlags().StringVarP(&configPath, "config", "c", "", "path to fb-apiserver confuguration file.")
 
+	// 增加--auto-migrate标志，也可以通过配置项auto-migrate开启
+	cmd.Flags().Bool("auto-migrate", false, "apply pending database migrations before starting the server.")
+	cobra.CheckErr(viper.BindPFlag("auto-migrate", cmd.Flags().Lookup("auto-migrate")))
+
 	// 增加--version标志
 	version.AddFlags(cmd.PersistentFlags())
 
+	// 增加migrate子命令，用于管理数据库迁移
+	cmd.AddCommand(newMigrateCommand(opts))
+
 	return cmd
 }
 
@@ -0,0 +1,303 @@
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpchandler "github.com/loveRyujin/fast_blog/internal/apiserver/handler/grpc"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+)
+
+// newTestServerConfig 创建使用内存存储的 ServerConfig，授权策略来自 SQLite 的数据库迁移，与 store: memory 模式一致.
+func newTestServerConfig(t *testing.T, gracePeriod time.Duration) *ServerConfig {
+	t.Helper()
+
+	cfg := &Config{
+		ServerMode:              HTTPServerMode,
+		Store:                   MemoryStore,
+		SearchOptions:           genericoptions.NewSearchOptions(),
+		CacheOptions:            &genericoptions.CacheOptions{Type: genericoptions.CacheTypeNone},
+		RedisOptions:            genericoptions.NewRedisOptions(),
+		LockoutOptions:          genericoptions.NewLockoutOptions(),
+		JWTKey:                  "test-jwt-key-with-at-least-32-characters",
+		JWTIssuer:               "fast_blog",
+		JWTAudience:             "fast_blog",
+		Expiration:              15 * time.Minute,
+		RefreshTokenExpiration:  time.Hour,
+		TOTPChallengeExpiration: 5 * time.Minute,
+		PolicyReloadInterval:    time.Minute,
+		UserDeletionGracePeriod: gracePeriod,
+	}
+	c, err := cfg.NewServerConfig()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		c.authz.StopAutoLoadPolicy()
+		_ = c.searcher.Close()
+	})
+	return c
+}
+
+// testHTTPClient 通过 gin 路由发送请求，经过与 http 服务模式相同的认证和授权中间件.
+type testHTTPClient struct {
+	t      *testing.T
+	engine *gin.Engine
+}
+
+func newTestHTTPClient(t *testing.T, c *ServerConfig) *testHTTPClient {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	c.SetupRouter(engine)
+	return &testHTTPClient{t: t, engine: engine}
+}
+
+// do 发送请求并将响应体解码到 out 中，返回响应状态码.
+func (c *testHTTPClient) do(method, path, token string, body, out any) int {
+	c.t.Helper()
+
+	w := c.request(method, path, token, nil, body)
+	if out != nil && w.Code == http.StatusOK {
+		require.NoError(c.t, json.Unmarshal(w.Body.Bytes(), out))
+	}
+	return w.Code
+}
+
+// request 发送带有请求头 header 的请求，返回完整的响应.
+func (c *testHTTPClient) request(method, path, token string, header http.Header, body any) *httptest.ResponseRecorder {
+	c.t.Helper()
+
+	var reader bytes.Buffer
+	if body != nil {
+		require.NoError(c.t, json.NewEncoder(&reader).Encode(body))
+	}
+	req := httptest.NewRequest(method, path, &reader)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	c.engine.ServeHTTP(w, req)
+	return w
+}
+
+// signup 注册用户并登录，返回用户 ID 和访问令牌.
+func (c *testHTTPClient) signup(username, phone string) (string, string) {
+	c.t.Helper()
+
+	var created apiv1.CreateUserResponse
+	require.Equal(c.t, http.StatusOK, c.do(http.MethodPost, "/v1/users", "", map[string]any{
+		"username": username, "password": "password123", "email": username + "@example.com", "phone": phone,
+	}, &created))
+
+	var login apiv1.LoginResponse
+	require.Equal(c.t, http.StatusOK, c.do(http.MethodPost, "/login", "", map[string]any{
+		"username": username, "password": "password123",
+	}, &login))
+	return created.UserID, login.Token
+}
+
+// grantAdmin 为通过 CreateUser 注册的用户授予管理员角色，该用户同时保留注册时获得的普通用户角色.
+func grantAdmin(t *testing.T, c *ServerConfig, userID string) {
+	t.Helper()
+
+	_, err := c.authz.AddGroupingPolicy(userID, known.RoleAdmin)
+	require.NoError(t, err)
+}
+
+// newTestGRPCClient 使用与 grpc 服务模式相同的拦截器启动内存中的 gRPC 服务.
+func newTestGRPCClient(t *testing.T, c *ServerConfig) apiv1.FastBlogClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(c.grpcServerOptions()...)
+	apiv1.RegisterFastBlogServer(srv, grpchandler.NewHandler(c.biz, c.val))
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return apiv1.NewFastBlogClient(conn)
+}
+
+// withToken 返回携带访问令牌的 gRPC 调用上下文.
+func withToken(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func TestUserDeleteAuthzOverHTTP(t *testing.T) {
+	client := newTestHTTPClient(t, newTestServerConfig(t, 0))
+	alice, aliceToken := client.signup("alice", "13800000001")
+	bob, _ := client.signup("bob1", "13800000002")
+
+	// 普通用户不能查询用户列表，也不能注销其他用户
+	assert.Equal(t, http.StatusForbidden, client.do(http.MethodGet, "/v1/users?limit=10", aliceToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, client.do(http.MethodDelete, "/v1/users/"+bob, aliceToken, nil, nil))
+
+	// 普通用户可以注销自己的账号，注销后无法登录
+	assert.Equal(t, http.StatusOK, client.do(http.MethodDelete, "/v1/users/"+alice, aliceToken, nil, nil))
+	assert.Equal(t, http.StatusUnauthorized, client.do(http.MethodPost, "/login", "", map[string]any{
+		"username": "alice", "password": "password123",
+	}, nil))
+}
+
+func TestUserDeleteAuthzOverGRPC(t *testing.T) {
+	c := newTestServerConfig(t, 0)
+	alice, _ := newTestHTTPClient(t, c).signup("alice", "13800000001")
+	bob, _ := newTestHTTPClient(t, c).signup("bob1", "13800000002")
+	client := newTestGRPCClient(t, c)
+
+	login, err := client.Login(context.Background(), &apiv1.LoginRequest{Username: "alice", Password: "password123"})
+	require.NoError(t, err)
+	ctx := withToken(login.Token)
+
+	_, err = client.ListUser(ctx, &apiv1.ListUserRequest{Limit: 10})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.DeleteUser(ctx, &apiv1.DeleteUserRequest{UserID: bob})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.DeleteUser(ctx, &apiv1.DeleteUserRequest{UserID: alice})
+	require.NoError(t, err)
+	_, err = client.Login(context.Background(), &apiv1.LoginRequest{Username: "alice", Password: "password123"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestUserSelfDeleteWithGracePeriodOverHTTP(t *testing.T) {
+	const gracePeriod = 7 * 24 * time.Hour
+	client := newTestHTTPClient(t, newTestServerConfig(t, gracePeriod))
+	alice, aliceToken := client.signup("alice", "13800000001")
+	_, bobToken := client.signup("bob1", "13800000002")
+
+	// 普通用户注销自己的账号后进入冷静期，返回彻底删除的时间
+	var deleted apiv1.DeleteUserResponse
+	require.Equal(t, http.StatusOK, client.do(http.MethodDelete, "/v1/users/"+alice, aliceToken, nil, &deleted))
+	require.NotNil(t, deleted.PurgeAt)
+	assert.WithinDuration(t, time.Now().Add(gracePeriod), deleted.PurgeAt.AsTime(), time.Minute)
+	assert.Equal(t, http.StatusUnauthorized, client.do(http.MethodPost, "/login", "", map[string]any{
+		"username": "alice", "password": "password123",
+	}, nil))
+
+	// 已注销用户的恢复和彻底删除仍然只有管理员可以操作
+	assert.Equal(t, http.StatusForbidden, client.do(http.MethodGet, "/v1/trash/users", bobToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, client.do(http.MethodPost, "/v1/trash/users/"+alice+"/restore", bobToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, client.do(http.MethodDelete, "/v1/trash/users/"+alice, bobToken, nil, nil))
+}
+
+func TestUserSelfDeleteWithGracePeriodOverGRPC(t *testing.T) {
+	const gracePeriod = 7 * 24 * time.Hour
+	c := newTestServerConfig(t, gracePeriod)
+	alice, aliceToken := newTestHTTPClient(t, c).signup("alice", "13800000001")
+	_, bobToken := newTestHTTPClient(t, c).signup("bob1", "13800000002")
+	client := newTestGRPCClient(t, c)
+
+	deleted, err := client.DeleteUser(withToken(aliceToken), &apiv1.DeleteUserRequest{UserID: alice})
+	require.NoError(t, err)
+	require.NotNil(t, deleted.PurgeAt)
+	assert.WithinDuration(t, time.Now().Add(gracePeriod), deleted.PurgeAt.AsTime(), time.Minute)
+	_, err = client.Login(context.Background(), &apiv1.LoginRequest{Username: "alice", Password: "password123"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := withToken(bobToken)
+	_, err = client.ListTrashUser(ctx, &apiv1.ListTrashUserRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.RestoreUser(ctx, &apiv1.RestoreUserRequest{UserID: alice})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = client.PurgeUser(ctx, &apiv1.PurgeUserRequest{UserID: alice})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSecondAdminManagesUsersOverHTTP(t *testing.T) {
+	c := newTestServerConfig(t, 0)
+	client := newTestHTTPClient(t, c)
+	admin, adminToken := client.signup("admin2", "13800000001")
+	alice, aliceToken := client.signup("alice", "13800000002")
+	grantAdmin(t, c, admin)
+
+	// 非初始管理员也可以查询和管理任意用户
+	var list apiv1.ListUserResponse
+	require.Equal(t, http.StatusOK, client.do(http.MethodGet, "/v1/users?limit=10", adminToken, nil, &list))
+	assert.EqualValues(t, 2, list.TotalCount)
+	assert.Equal(t, http.StatusOK, client.do(http.MethodGet, "/v1/users/"+alice, adminToken, nil, nil))
+
+	// 普通用户仍然不能查询用户列表
+	assert.Equal(t, http.StatusForbidden, client.do(http.MethodGet, "/v1/users?limit=10", aliceToken, nil, nil))
+	assert.Equal(t, http.StatusForbidden, client.do(http.MethodGet, "/v1/users/"+admin, aliceToken, nil, nil))
+
+	assert.Equal(t, http.StatusOK, client.do(http.MethodDelete, "/v1/users/"+alice, adminToken, nil, nil))
+	assert.Equal(t, http.StatusNotFound, client.do(http.MethodGet, "/v1/users/"+alice, adminToken, nil, nil))
+}
+
+func TestSecondAdminManagesUsersOverGRPC(t *testing.T) {
+	c := newTestServerConfig(t, 0)
+	admin, adminToken := newTestHTTPClient(t, c).signup("admin2", "13800000001")
+	alice, aliceToken := newTestHTTPClient(t, c).signup("alice", "13800000002")
+	grantAdmin(t, c, admin)
+	client := newTestGRPCClient(t, c)
+
+	list, err := client.ListUser(withToken(adminToken), &apiv1.ListUserRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, list.TotalCount)
+	_, err = client.ListUser(withToken(aliceToken), &apiv1.ListUserRequest{Limit: 10})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.DeleteUser(withToken(adminToken), &apiv1.DeleteUserRequest{UserID: alice})
+	require.NoError(t, err)
+	_, err = client.GetUser(withToken(adminToken), &apiv1.GetUserRequest{UserID: alice})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSecondAdminManagesTrashUsers(t *testing.T) {
+	c := newTestServerConfig(t, 7*24*time.Hour)
+	client := newTestHTTPClient(t, c)
+	admin, adminToken := client.signup("admin2", "13800000001")
+	alice, aliceToken := client.signup("alice", "13800000002")
+	bob, bobToken := client.signup("bob1", "13800000003")
+	grantAdmin(t, c, admin)
+	grpcClient := newTestGRPCClient(t, c)
+
+	require.Equal(t, http.StatusOK, client.do(http.MethodDelete, "/v1/users/"+alice, aliceToken, nil, nil))
+	require.Equal(t, http.StatusOK, client.do(http.MethodDelete, "/v1/users/"+bob, bobToken, nil, nil))
+
+	// 非初始管理员可以查看、恢复和彻底删除已注销的用户
+	var trash apiv1.ListTrashUserResponse
+	require.Equal(t, http.StatusOK, client.do(http.MethodGet, "/v1/trash/users?limit=10", adminToken, nil, &trash))
+	assert.EqualValues(t, 2, trash.TotalCount)
+	assert.Equal(t, http.StatusOK, client.do(http.MethodPost, "/v1/trash/users/"+alice+"/restore", adminToken, nil, nil))
+	assert.Equal(t, http.StatusOK, client.do(http.MethodPost, "/login", "", map[string]any{
+		"username": "alice", "password": "password123",
+	}, nil))
+
+	ctx := withToken(adminToken)
+	rs, err := grpcClient.ListTrashUser(ctx, &apiv1.ListTrashUserRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, rs.TotalCount)
+	_, err = grpcClient.PurgeUser(ctx, &apiv1.PurgeUserRequest{UserID: bob})
+	require.NoError(t, err)
+	rs, err = grpcClient.ListTrashUser(ctx, &apiv1.ListTrashUserRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, rs.TotalCount)
+}
@@ -1,30 +1,85 @@
 package biz
 
 import (
+	"time"
+
+	categoryv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/category"
+	commentv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/comment"
 	postv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/post"
+	tagv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/tag"
 	userv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/user"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/lockout"
+	"github.com/loveRyujin/fast_blog/pkg/token"
+	"github.com/onexstack/onexstack/pkg/authz"
 )
 
 type IBiz interface {
 	UserV1() userv1.UserBiz
 	PostV1() postv1.PostBiz
+	TagV1() tagv1.TagBiz
+	CategoryV1() categoryv1.CategoryBiz
+	CommentV1() commentv1.CommentBiz
 }
 
 type Biz struct {
-	store store.IStore
+	store    store.IStore
+	authz    *authz.Authz
+	searcher search.Searcher
+	tokens   *token.Manager
+	guard    *lockout.LoginGuard
+	opts     Options
+}
+
+// Options 包含业务层的配置项.
+type Options struct {
+	// TOTPIssuer 是两步验证的签发者
+	TOTPIssuer string
+	// UserDeletionGracePeriod 是用户注销的冷静期
+	UserDeletionGracePeriod time.Duration
+	// TrashRetention 是文章在回收站中的保留时长
+	TrashRetention time.Duration
+	// RefreshTokenExpiration 是刷新令牌的有效期
+	RefreshTokenExpiration time.Duration
+	// LoginAuditRetention 是登录失败审计记录的保留时长
+	LoginAuditRetention time.Duration
 }
 
 var _ IBiz = (*Biz)(nil)
 
-func NewBiz(store store.IStore) IBiz {
-	return &Biz{store: store}
+func NewBiz(store store.IStore, authz *authz.Authz, searcher search.Searcher, tokens *token.Manager, guard *lockout.LoginGuard, opts Options) IBiz {
+	return &Biz{
+		store:    store,
+		authz:    authz,
+		searcher: searcher,
+		tokens:   tokens,
+		guard:    guard,
+		opts:     opts,
+	}
 }
 
 func (b *Biz) UserV1() userv1.UserBiz {
-	return userv1.New(b.store)
+	return userv1.New(b.store, b.authz, b.searcher, b.tokens, b.guard, userv1.Options{
+		TOTPIssuer:             b.opts.TOTPIssuer,
+		DeletionGracePeriod:    b.opts.UserDeletionGracePeriod,
+		RefreshTokenExpiration: b.opts.RefreshTokenExpiration,
+		LoginAuditRetention:    b.opts.LoginAuditRetention,
+	})
 }
 
 func (b *Biz) PostV1() postv1.PostBiz {
-	return postv1.New(b.store)
+	return postv1.New(b.store, b.searcher, b.opts.TrashRetention)
+}
+
+func (b *Biz) TagV1() tagv1.TagBiz {
+	return tagv1.New(b.store)
+}
+
+func (b *Biz) CategoryV1() categoryv1.CategoryBiz {
+	return categoryv1.New(b.store)
+}
+
+func (b *Biz) CommentV1() commentv1.CommentBiz {
+	return commentv1.New(b.store)
 }
@@ -0,0 +1,138 @@
+package category
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// CategoryBiz 定义处理分类请求所需的方法.
+type CategoryBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateCategoryRequest) (*apiv1.CreateCategoryResponse, error)
+	Update(ctx context.Context, rq *apiv1.UpdateCategoryRequest) (*apiv1.UpdateCategoryResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteCategoryRequest) (*apiv1.DeleteCategoryResponse, error)
+	Get(ctx context.Context, rq *apiv1.GetCategoryRequest) (*apiv1.GetCategoryResponse, error)
+	List(ctx context.Context, rq *apiv1.ListCategoryRequest) (*apiv1.ListCategoryResponse, error)
+
+	CategoryExpansion
+}
+
+// CategoryExpansion 定义额外的分类操作方法.
+type CategoryExpansion interface{}
+
+// categoryBiz 是 CategoryBiz 接口的实现.
+type categoryBiz struct {
+	store store.IStore
+}
+
+// 确保 categoryBiz 实现了 CategoryBiz 接口.
+var _ CategoryBiz = (*categoryBiz)(nil)
+
+// New 创建 categoryBiz 的实例.
+func New(store store.IStore) *categoryBiz {
+	return &categoryBiz{store: store}
+}
+
+// Create 实现 CategoryBiz 接口中的 Create 方法，同一用户下分类名称不能重复.
+func (b *categoryBiz) Create(ctx context.Context, rq *apiv1.CreateCategoryRequest) (*apiv1.CreateCategoryResponse, error) {
+	if err := b.checkNameAvailable(ctx, rq.Name); err != nil {
+		return nil, err
+	}
+
+	categoryM := model.Category{UserID: contextx.UserID(ctx), Name: rq.Name, Description: rq.Description}
+	if err := b.store.Category().Create(ctx, &categoryM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreateCategoryResponse{CategoryID: categoryM.CategoryID}, nil
+}
+
+// Update 实现 CategoryBiz 接口中的 Update 方法.
+func (b *categoryBiz) Update(ctx context.Context, rq *apiv1.UpdateCategoryRequest) (*apiv1.UpdateCategoryResponse, error) {
+	categoryM, err := b.store.Category().Get(ctx, where.F("userID", contextx.UserID(ctx), "categoryID", rq.CategoryID))
+	if err != nil {
+		return nil, err
+	}
+
+	if rq.Name != nil && *rq.Name != categoryM.Name {
+		if err := b.checkNameAvailable(ctx, *rq.Name); err != nil {
+			return nil, err
+		}
+		categoryM.Name = *rq.Name
+	}
+
+	if rq.Description != nil {
+		categoryM.Description = *rq.Description
+	}
+
+	if err := b.store.Category().Update(ctx, categoryM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateCategoryResponse{}, nil
+}
+
+// Delete 实现 CategoryBiz 接口中的 Delete 方法，在同一个事务中解除分类与文章的关联.
+func (b *categoryBiz) Delete(ctx context.Context, rq *apiv1.DeleteCategoryRequest) (*apiv1.DeleteCategoryResponse, error) {
+	categoryM, err := b.store.Category().Get(ctx, where.F("userID", contextx.UserID(ctx), "categoryID", rq.CategoryID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.PostCategory().Delete(ctx, where.F("categoryID", categoryM.CategoryID)); err != nil {
+			return err
+		}
+		return b.store.Category().Delete(ctx, where.F("categoryID", categoryM.CategoryID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeleteCategoryResponse{}, nil
+}
+
+// Get 实现 CategoryBiz 接口中的 Get 方法.
+func (b *categoryBiz) Get(ctx context.Context, rq *apiv1.GetCategoryRequest) (*apiv1.GetCategoryResponse, error) {
+	categoryM, err := b.store.Category().Get(ctx, where.F("userID", contextx.UserID(ctx), "categoryID", rq.CategoryID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetCategoryResponse{Category: conversion.CategoryodelToCategoryV1(categoryM)}, nil
+}
+
+// List 实现 CategoryBiz 接口中的 List 方法.
+func (b *categoryBiz) List(ctx context.Context, rq *apiv1.ListCategoryRequest) (*apiv1.ListCategoryResponse, error) {
+	whr := where.F("userID", contextx.UserID(ctx)).O(int(rq.Offset)).L(int(rq.Limit))
+	count, categoryList, err := b.store.Category().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*apiv1.Category, 0, len(categoryList))
+	for _, category := range categoryList {
+		categories = append(categories, conversion.CategoryodelToCategoryV1(category))
+	}
+
+	return &apiv1.ListCategoryResponse{TotalCount: count, Categories: categories}, nil
+}
+
+// checkNameAvailable 检查当前用户下是否已存在同名分类.
+func (b *categoryBiz) checkNameAvailable(ctx context.Context, name string) error {
+	count, _, err := b.store.Category().List(ctx, where.F("userID", contextx.UserID(ctx), "name", name))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errorx.ErrCategoryAlreadyExists
+	}
+
+	return nil
+}
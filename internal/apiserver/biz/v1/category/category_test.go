@@ -0,0 +1,55 @@
+package category_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	categoryv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/category"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+func TestCategoryBiz(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	s := store.NewMemoryStore()
+	b := categoryv1.New(s)
+
+	created, err := b.Create(ctx, &apiv1.CreateCategoryRequest{Name: "go", Description: "golang"})
+	require.NoError(t, err)
+	_, err = b.Create(ctx, &apiv1.CreateCategoryRequest{Name: "go"})
+	assert.ErrorIs(t, err, errorx.ErrCategoryAlreadyExists)
+
+	// 不同用户之间的分类名称互不影响，也互相不可见
+	other := contextx.WithUserID(context.Background(), "user-2")
+	_, err = b.Create(other, &apiv1.CreateCategoryRequest{Name: "go"})
+	require.NoError(t, err)
+	_, err = b.Get(other, &apiv1.GetCategoryRequest{CategoryID: created.CategoryID})
+	assert.ErrorIs(t, err, errorx.ErrCategoryNotFound)
+	list, err := b.List(ctx, &apiv1.ListCategoryRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.TotalCount)
+
+	postM := &model.Post{UserID: "user-1", Title: "title", Content: "content"}
+	require.NoError(t, s.Post().Create(context.Background(), postM))
+	require.NoError(t, s.PostCategory().Create(context.Background(), &model.PostCategory{PostID: postM.PostID, CategoryID: created.CategoryID}))
+
+	// 删除分类时解除其与文章的关联，文章本身保留
+	_, err = b.Delete(other, &apiv1.DeleteCategoryRequest{CategoryID: created.CategoryID})
+	assert.ErrorIs(t, err, errorx.ErrCategoryNotFound)
+	_, err = b.Delete(ctx, &apiv1.DeleteCategoryRequest{CategoryID: created.CategoryID})
+	require.NoError(t, err)
+	_, err = b.Get(ctx, &apiv1.GetCategoryRequest{CategoryID: created.CategoryID})
+	assert.ErrorIs(t, err, errorx.ErrCategoryNotFound)
+	count, _, err := s.PostCategory().List(context.Background(), where.F("postID", postM.PostID))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	_, err = s.Post().Get(context.Background(), where.F("postID", postM.PostID))
+	assert.NoError(t, err)
+}
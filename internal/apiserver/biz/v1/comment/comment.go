@@ -0,0 +1,301 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// CommentBiz 定义处理评论请求所需的方法.
+type CommentBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error)
+	Update(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error)
+	List(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error)
+
+	CommentExpansion
+}
+
+// CommentExpansion 定义额外的评论操作方法.
+type CommentExpansion interface {
+	Moderate(ctx context.Context, rq *apiv1.ModerateCommentRequest) (*apiv1.ModerateCommentResponse, error)
+	ListPublic(ctx context.Context, rq *apiv1.ListPublicCommentRequest) (*apiv1.ListPublicCommentResponse, error)
+}
+
+// commentBiz 是 CommentBiz 接口的实现.
+type commentBiz struct {
+	store store.IStore
+}
+
+// 确保 commentBiz 实现了 CommentBiz 接口.
+var _ CommentBiz = (*commentBiz)(nil)
+
+// New 创建 commentBiz 的实例.
+func New(store store.IStore) *commentBiz {
+	return &commentBiz{store: store}
+}
+
+// Create 实现 CommentBiz 接口中的 Create 方法.
+// 只能评论已发布的文章（作者可以评论自己的任意文章），作者的评论无需审核，其他用户的评论需要作者审核后才对读者可见.
+func (b *commentBiz) Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error) {
+	userID := contextx.UserID(ctx)
+	postM, err := b.getVisiblePost(ctx, rq.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentM := model.Comment{
+		PostID:  rq.PostID,
+		UserID:  userID,
+		Content: rq.Content,
+		Status:  int32(apiv1.CommentStatus_Pending),
+	}
+	if postM.UserID == userID {
+		commentM.Status = int32(apiv1.CommentStatus_Approved)
+	}
+
+	if rq.ParentID != "" {
+		parent, err := b.store.Comment().Get(ctx, where.F("commentID", rq.ParentID))
+		if err != nil {
+			return nil, err
+		}
+		if parent.PostID != rq.PostID {
+			return nil, errorx.ErrInvalidParentComment
+		}
+
+		commentM.ParentID = parent.CommentID
+		commentM.RootID = parent.RootID
+		if commentM.RootID == "" {
+			commentM.RootID = parent.CommentID
+		}
+	}
+
+	if err := b.store.Comment().Create(ctx, &commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreateCommentResponse{CommentID: commentM.CommentID, Status: apiv1.CommentStatus(commentM.Status)}, nil
+}
+
+// Update 实现 CommentBiz 接口中的 Update 方法，只有评论作者可以修改评论，修改后的评论需要重新审核.
+func (b *commentBiz) Update(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error) {
+	userID := contextx.UserID(ctx)
+	commentM, err := b.store.Comment().Get(ctx, where.F("commentID", rq.CommentID, "userID", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	if commentM.Content != rq.Content {
+		postM, err := b.store.Post().Get(ctx, where.F("postID", commentM.PostID))
+		if err != nil {
+			return nil, err
+		}
+
+		commentM.Content = rq.Content
+		if postM.UserID != userID {
+			commentM.Status = int32(apiv1.CommentStatus_Pending)
+		}
+	}
+
+	if err := b.store.Comment().Update(ctx, commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateCommentResponse{Status: apiv1.CommentStatus(commentM.Status)}, nil
+}
+
+// Delete 实现 CommentBiz 接口中的 Delete 方法，只有评论作者可以删除评论，评论的所有回复会被一并删除.
+func (b *commentBiz) Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error) {
+	commentM, err := b.store.Comment().Get(ctx, where.F("commentID", rq.CommentID, "userID", contextx.UserID(ctx)))
+	if err != nil {
+		return nil, err
+	}
+
+	rootID := commentM.RootID
+	if rootID == "" {
+		rootID = commentM.CommentID
+	}
+	_, thread, err := b.store.Comment().List(ctx, where.F("rootID", rootID))
+	if err != nil {
+		return nil, err
+	}
+
+	commentIDs := descendants(commentM.CommentID, thread)
+	if err := b.store.Comment().Delete(ctx, where.F("commentID", commentIDs)); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeleteCommentResponse{}, nil
+}
+
+// List 实现 CommentBiz 接口中的 List 方法.
+// 文章作者可以看到所有状态的评论，并可以按状态过滤；其他用户只能看到已发布文章中已通过审核的评论.
+func (b *commentBiz) List(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error) {
+	postM, err := b.getVisiblePost(ctx, rq.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	isAuthor := postM.UserID == contextx.UserID(ctx)
+	page, pageSize := normalizePage(rq.Page, rq.PageSize)
+	resp := &apiv1.ListCommentResponse{Page: page, PageSize: pageSize}
+
+	// 按状态过滤时平铺返回，便于文章作者批量审核
+	if rq.Status != nil {
+		if !isAuthor {
+			return nil, errorx.ErrPermissionDenied
+		}
+
+		whr := where.F("postID", rq.PostID, "status", int32(*rq.Status)).P(int(page), int(pageSize))
+		count, commentList, err := b.store.Comment().List(ctx, whr)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.TotalCount = count
+		for _, comment := range commentList {
+			resp.Comments = append(resp.Comments, conversion.CommentodelToCommentV1(comment))
+		}
+		return resp, nil
+	}
+
+	resp.TotalCount, resp.Comments, err = b.listThreads(ctx, rq.PostID, page, pageSize, !isAuthor)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Moderate 实现 CommentExpansion 接口中的 Moderate 方法，只有文章作者可以审核评论.
+func (b *commentBiz) Moderate(ctx context.Context, rq *apiv1.ModerateCommentRequest) (*apiv1.ModerateCommentResponse, error) {
+	commentM, err := b.store.Comment().Get(ctx, where.F("commentID", rq.CommentID))
+	if err != nil {
+		return nil, err
+	}
+
+	postM, err := b.store.Post().Get(ctx, where.F("postID", commentM.PostID))
+	if err != nil {
+		return nil, err
+	}
+	if postM.UserID != contextx.UserID(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	commentM.Status = int32(rq.Status)
+	if err := b.store.Comment().Update(ctx, commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ModerateCommentResponse{}, nil
+}
+
+// ListPublic 实现 CommentExpansion 接口中的 ListPublic 方法，匿名读者只能看到已发布文章中已通过审核的评论.
+func (b *commentBiz) ListPublic(ctx context.Context, rq *apiv1.ListPublicCommentRequest) (*apiv1.ListPublicCommentResponse, error) {
+	if _, err := b.store.Post().Get(ctx, where.F("postID", rq.PostID, "status", int32(apiv1.PostStatus_Published))); err != nil {
+		return nil, err
+	}
+
+	page, pageSize := normalizePage(rq.Page, rq.PageSize)
+	count, comments, err := b.listThreads(ctx, rq.PostID, page, pageSize, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListPublicCommentResponse{TotalCount: count, Page: page, PageSize: pageSize, Comments: comments}, nil
+}
+
+// getVisiblePost 获取当前用户可以查看和评论的文章，即已发布的文章或当前用户自己的文章.
+func (b *commentBiz) getVisiblePost(ctx context.Context, postID string) (*model.Post, error) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", postID))
+	if err != nil {
+		return nil, err
+	}
+	if postM.Status != int32(apiv1.PostStatus_Published) && postM.UserID != contextx.UserID(ctx) {
+		return nil, errorx.ErrPostNotFound
+	}
+
+	return postM, nil
+}
+
+// listThreads 分页查询文章的顶层评论，并将回复按创建时间嵌套到对应评论的 replies 中.
+// approvedOnly 为 true 时只返回已通过审核的评论，未通过审核的评论下的回复也不会返回.
+func (b *commentBiz) listThreads(ctx context.Context, postID string, page, pageSize int64, approvedOnly bool) (int64, []*apiv1.Comment, error) {
+	whr := where.F("postID", postID, "rootID", "").P(int(page), int(pageSize))
+	if approvedOnly {
+		whr = whr.F("status", int32(apiv1.CommentStatus_Approved))
+	}
+	count, rootList, err := b.store.Comment().List(ctx, whr)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(rootList) == 0 {
+		return count, []*apiv1.Comment{}, nil
+	}
+
+	roots := make([]*apiv1.Comment, 0, len(rootList))
+	nodes := make(map[string]*apiv1.Comment)
+	rootIDs := make([]string, 0, len(rootList))
+	for _, root := range rootList {
+		node := conversion.CommentodelToCommentV1(root)
+		roots = append(roots, node)
+		nodes[root.CommentID] = node
+		rootIDs = append(rootIDs, root.CommentID)
+	}
+
+	replyWhr := where.F("postID", postID, "rootID", rootIDs)
+	if approvedOnly {
+		replyWhr = replyWhr.F("status", int32(apiv1.CommentStatus_Approved))
+	}
+	_, replyList, err := b.store.Comment().List(ctx, replyWhr)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// 列表按 ID 倒序返回，倒序遍历使回复按创建时间排列，并保证父评论先于子评论处理
+	for i := len(replyList) - 1; i >= 0; i-- {
+		reply := replyList[i]
+		parent, ok := nodes[reply.ParentID]
+		if !ok {
+			continue
+		}
+
+		node := conversion.CommentodelToCommentV1(reply)
+		parent.Replies = append(parent.Replies, node)
+		nodes[reply.CommentID] = node
+	}
+
+	return count, roots, nil
+}
+
+// descendants 返回 commentID 及其在 thread 中的所有后代评论 ID.
+func descendants(commentID string, thread []*model.Comment) []string {
+	children := make(map[string][]string)
+	for _, comment := range thread {
+		children[comment.ParentID] = append(children[comment.ParentID], comment.CommentID)
+	}
+
+	ret := []string{commentID}
+	for i := 0; i < len(ret); i++ {
+		ret = append(ret, children[ret[i]]...)
+	}
+	return ret
+}
+
+// normalizePage 为未指定的分页参数设置默认值.
+func normalizePage(page, pageSize int64) (int64, int64) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = known.DefaultPageSize
+	}
+	return page, pageSize
+}
@@ -0,0 +1,173 @@
+package comment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commentv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/comment"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+var (
+	author = contextx.WithUserID(context.Background(), "user-1")
+	reader = contextx.WithUserID(context.Background(), "user-2")
+)
+
+// createPost 以 user-1 的身份创建一篇指定状态的文章，返回文章 ID.
+func createPost(t *testing.T, s store.IStore, status apiv1.PostStatus) string {
+	t.Helper()
+
+	postM := &model.Post{UserID: "user-1", Title: "title", Content: "content", Status: int32(status)}
+	require.NoError(t, s.Post().Create(context.Background(), postM))
+	return postM.PostID
+}
+
+// comment 发表一条评论，返回评论 ID.
+func comment(t *testing.T, b commentv1.CommentBiz, ctx context.Context, postID, parentID string) string {
+	t.Helper()
+
+	created, err := b.Create(ctx, &apiv1.CreateCommentRequest{PostID: postID, ParentID: parentID, Content: "comment"})
+	require.NoError(t, err)
+	return created.CommentID
+}
+
+func TestCommentBizThreads(t *testing.T) {
+	s := store.NewMemoryStore()
+	b := commentv1.New(s)
+	postID := createPost(t, s, apiv1.PostStatus_Published)
+
+	// 回复按层级嵌套到父评论中，并按创建时间排列
+	root := comment(t, b, author, postID, "")
+	first := comment(t, b, author, postID, root)
+	nested := comment(t, b, author, postID, first)
+	second := comment(t, b, author, postID, root)
+
+	list, err := b.ListPublic(context.Background(), &apiv1.ListPublicCommentRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.TotalCount)
+	require.Len(t, list.Comments, 1)
+	assert.Equal(t, root, list.Comments[0].CommentID)
+	require.Len(t, list.Comments[0].Replies, 2)
+	assert.Equal(t, first, list.Comments[0].Replies[0].CommentID)
+	assert.Equal(t, second, list.Comments[0].Replies[1].CommentID)
+	require.Len(t, list.Comments[0].Replies[0].Replies, 1)
+	assert.Equal(t, nested, list.Comments[0].Replies[0].Replies[0].CommentID)
+
+	// 不能回复其他文章下的评论
+	otherPostID := createPost(t, s, apiv1.PostStatus_Published)
+	_, err = b.Create(author, &apiv1.CreateCommentRequest{PostID: otherPostID, ParentID: root, Content: "comment"})
+	assert.ErrorIs(t, err, errorx.ErrInvalidParentComment)
+
+	// 其他用户不能评论或查看草稿的评论
+	draftID := createPost(t, s, apiv1.PostStatus_Draft)
+	_, err = b.Create(reader, &apiv1.CreateCommentRequest{PostID: draftID, Content: "comment"})
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	_, err = b.ListPublic(context.Background(), &apiv1.ListPublicCommentRequest{PostID: draftID})
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	comment(t, b, author, draftID, "")
+}
+
+func TestCommentBizModeration(t *testing.T) {
+	s := store.NewMemoryStore()
+	b := commentv1.New(s)
+	postID := createPost(t, s, apiv1.PostStatus_Published)
+
+	// 作者的评论直接通过，其他用户的评论需要审核
+	created, err := b.Create(author, &apiv1.CreateCommentRequest{PostID: postID, Content: "comment"})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.CommentStatus_Approved, created.Status)
+	created, err = b.Create(reader, &apiv1.CreateCommentRequest{PostID: postID, Content: "comment"})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.CommentStatus_Pending, created.Status)
+	pending := created.CommentID
+	reply := comment(t, b, author, postID, pending)
+
+	// 未通过审核的评论及其回复对读者不可见，作者可以看到全部评论并按状态过滤
+	list, err := b.ListPublic(context.Background(), &apiv1.ListPublicCommentRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.TotalCount)
+	readerList, err := b.List(reader, &apiv1.ListCommentRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, readerList.TotalCount)
+	authorList, err := b.List(author, &apiv1.ListCommentRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, authorList.TotalCount)
+
+	status := apiv1.CommentStatus_Pending
+	_, err = b.List(reader, &apiv1.ListCommentRequest{PostID: postID, Status: &status})
+	assert.ErrorIs(t, err, errorx.ErrPermissionDenied)
+	authorList, err = b.List(author, &apiv1.ListCommentRequest{PostID: postID, Status: &status})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, authorList.TotalCount)
+	assert.Equal(t, pending, authorList.Comments[0].CommentID)
+
+	// 只有文章作者可以审核评论
+	_, err = b.Moderate(reader, &apiv1.ModerateCommentRequest{CommentID: pending, Status: apiv1.CommentStatus_Approved})
+	assert.ErrorIs(t, err, errorx.ErrPermissionDenied)
+	_, err = b.Moderate(author, &apiv1.ModerateCommentRequest{CommentID: pending, Status: apiv1.CommentStatus_Approved})
+	require.NoError(t, err)
+	list, err = b.ListPublic(context.Background(), &apiv1.ListPublicCommentRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, list.TotalCount)
+	for _, c := range list.Comments {
+		if c.CommentID == pending {
+			require.Len(t, c.Replies, 1)
+			assert.Equal(t, reply, c.Replies[0].CommentID)
+		}
+	}
+
+	// 读者修改评论内容后需要重新审核
+	updated, err := b.Update(reader, &apiv1.UpdateCommentRequest{CommentID: pending, Content: "edited"})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.CommentStatus_Pending, updated.Status)
+	list, err = b.ListPublic(context.Background(), &apiv1.ListPublicCommentRequest{PostID: postID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.TotalCount)
+}
+
+func TestCommentBizDeleteCascade(t *testing.T) {
+	s := store.NewMemoryStore()
+	b := commentv1.New(s)
+	postID := createPost(t, s, apiv1.PostStatus_Published)
+
+	root := comment(t, b, author, postID, "")
+	first := comment(t, b, author, postID, root)
+	nested := comment(t, b, author, postID, first)
+	second := comment(t, b, author, postID, root)
+	sibling := comment(t, b, author, postID, "")
+
+	remaining := func() []string {
+		t.Helper()
+
+		_, commentList, err := s.Comment().List(context.Background(), where.F("postID", postID))
+		require.NoError(t, err)
+		commentIDs := make([]string, 0, len(commentList))
+		for _, c := range commentList {
+			commentIDs = append(commentIDs, c.CommentID)
+		}
+		return commentIDs
+	}
+
+	// 只有评论作者可以删除评论
+	_, err := b.Delete(reader, &apiv1.DeleteCommentRequest{CommentID: first})
+	assert.ErrorIs(t, err, errorx.ErrCommentNotFound)
+
+	// 删除评论时一并删除其所有回复，不影响兄弟评论
+	_, err = b.Delete(author, &apiv1.DeleteCommentRequest{CommentID: first})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{root, second, sibling}, remaining())
+	_, err = b.Delete(author, &apiv1.DeleteCommentRequest{CommentID: nested})
+	assert.ErrorIs(t, err, errorx.ErrCommentNotFound)
+
+	_, err = b.Delete(author, &apiv1.DeleteCommentRequest{CommentID: root})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{sibling}, remaining())
+}
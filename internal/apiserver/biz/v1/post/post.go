@@ -2,15 +2,23 @@ package post
 
 import (
 	"context"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/query"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
 	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
 	"github.com/loveRyujin/fast_blog/internal/pkg/log"
 	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
 	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm/clause"
 )
 
 // PostBiz 定义处理帖子请求所需的方法.
@@ -25,19 +33,40 @@ type PostBiz interface {
 }
 
 // PostExpansion 定义额外的帖子操作方法.
-type PostExpansion interface{}
+type PostExpansion interface {
+	Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error)
+	Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error)
+	Archive(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error)
+	PublishScheduled(ctx context.Context) (int64, error)
+	ListRevision(ctx context.Context, rq *apiv1.ListPostRevisionRequest) (*apiv1.ListPostRevisionResponse, error)
+	GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error)
+	DiffRevision(ctx context.Context, rq *apiv1.DiffPostRevisionRequest) (*apiv1.DiffPostRevisionResponse, error)
+	RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error)
+	ListPublic(ctx context.Context, rq *apiv1.ListPublicPostRequest) (*apiv1.ListPublicPostResponse, error)
+	GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error)
+	Search(ctx context.Context, rq *apiv1.SearchPostRequest) (*apiv1.SearchPostResponse, error)
+	SearchPublic(ctx context.Context, rq *apiv1.SearchPublicPostRequest) (*apiv1.SearchPublicPostResponse, error)
+	Reindex(ctx context.Context) (int64, error)
+	ListTrash(ctx context.Context, rq *apiv1.ListTrashPostRequest) (*apiv1.ListTrashPostResponse, error)
+	Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error)
+	Purge(ctx context.Context, rq *apiv1.PurgePostRequest) (*apiv1.PurgePostResponse, error)
+	PurgeTrash(ctx context.Context) (int64, error)
+}
 
 // postBiz 是 PostBiz 接口的实现.
 type postBiz struct {
-	store store.IStore
+	store    store.IStore
+	searcher search.Searcher
+	// trashRetention 是文章在回收站中的保留时长，为 0 时不自动清理回收站
+	trashRetention time.Duration
 }
 
 // 确保 postBiz 实现了 PostBiz 接口.
 var _ PostBiz = (*postBiz)(nil)
 
 // New 创建 postBiz 的实例.
-func New(store store.IStore) *postBiz {
-	return &postBiz{store: store}
+func New(store store.IStore, searcher search.Searcher, trashRetention time.Duration) *postBiz {
+	return &postBiz{store: store, searcher: searcher, trashRetention: trashRetention}
 }
 
 // Create 实现 PostBiz 接口中的 Create 方法.
@@ -46,9 +75,20 @@ func (b *postBiz) Create(ctx context.Context, rq *apiv1.CreatePostRequest) (*api
 	_ = copier.Copy(&postM, rq)
 	postM.UserID = contextx.UserID(ctx)
 
-	if err := b.store.Post().Create(ctx, &postM); err != nil {
+	// 创建文章的同时设置其标签和分类
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Post().Create(ctx, &postM); err != nil {
+			return err
+		}
+		if err := b.setTags(ctx, postM.PostID, rq.Tags); err != nil {
+			return err
+		}
+		return b.setCategories(ctx, postM.PostID, rq.CategoryIDs)
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.syncIndex(ctx, &postM)
 
 	return &apiv1.CreatePostResponse{PostID: postM.PostID}, nil
 }
@@ -56,33 +96,65 @@ func (b *postBiz) Create(ctx context.Context, rq *apiv1.CreatePostRequest) (*api
 // Update 实现 PostBiz 接口中的 Update 方法.
 func (b *postBiz) Update(ctx context.Context, rq *apiv1.UpdatePostRequest) (*apiv1.UpdatePostResponse, error) {
 	log.With(ctx).Infow("biz update request postID", "postID", rq.PostID)
-	whr := where.F("userID", contextx.UserID(ctx), "postID", rq.PostID)
-	postM, err := b.store.Post().Get(ctx, whr)
-	if err != nil {
-		return nil, err
-	}
-
-	if rq.Title != nil {
-		postM.Title = *rq.Title
-	}
+	var postM *model.Post
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		var err error
+		if postM, err = b.lockOwnedPost(ctx, rq.PostID); err != nil {
+			return err
+		}
+		// 请求带有 If-Match 时，只有文章未被其他请求修改才允许更新
+		if ifMatch := contextx.IfMatch(ctx); ifMatch != "" && !conditional.Match(ifMatch, conditional.ETag(postM.UpdatedAt)) {
+			return errorx.ErrPreconditionFailed
+		}
 
-	if rq.Content != nil {
-		postM.Content = *rq.Content
-	}
+		revision := &model.PostRevision{PostID: postM.PostID, Title: postM.Title, Content: postM.Content}
+		if rq.Title != nil {
+			postM.Title = *rq.Title
+		}
+		if rq.Content != nil {
+			postM.Content = *rq.Content
+		}
 
-	if err := b.store.Post().Update(ctx, postM); err != nil {
+		if err := b.saveWithRevision(ctx, postM, revision); err != nil {
+			return err
+		}
+		if rq.Tags != nil {
+			if err := b.setTags(ctx, postM.PostID, stringValues(rq.Tags)); err != nil {
+				return err
+			}
+		}
+		if rq.CategoryIDs != nil {
+			return b.setCategories(ctx, postM.PostID, stringValues(rq.CategoryIDs))
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.syncIndex(ctx, postM)
 
 	return &apiv1.UpdatePostResponse{}, nil
 }
 
-// Delete 实现 PostBiz 接口中的 Delete 方法.
+// Delete 实现 PostBiz 接口中的 Delete 方法，文章被移入回收站，历史版本、评论以及与标签、分类的关联在彻底删除时才会删除.
 func (b *postBiz) Delete(ctx context.Context, rq *apiv1.DeletePostRequest) (*apiv1.DeletePostResponse, error) {
-	whr := where.F("userID", contextx.UserID(ctx), "postID", rq.PostIDs)
-	if err := b.store.Post().Delete(ctx, whr); err != nil {
+	_, postList, err := b.store.Post().List(ctx, where.F("userID", contextx.UserID(ctx), "postID", rq.PostIDs))
+	if err != nil {
 		return nil, err
 	}
+	if len(postList) == 0 {
+		return &apiv1.DeletePostResponse{}, nil
+	}
+
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	if err := b.store.Post().Delete(ctx, where.F("postID", postIDs)); err != nil {
+		return nil, err
+	}
+	b.removeIndex(ctx, postIDs...)
 
 	return &apiv1.DeletePostResponse{}, nil
 }
@@ -95,17 +167,46 @@ func (b *postBiz) Get(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.Get
 		return nil, err
 	}
 
-	return &apiv1.GetPostResponse{Post: conversion.PostodelToPostV1(postM)}, nil
+	post := conversion.PostodelToPostV1(postM)
+	if err := b.fillTerms(ctx, post); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPostResponse{Post: post}, nil
 }
 
 // List 实现 PostBiz 接口中的 List 方法.
+// 请求中设置了 page_token 时按 (createdAt, id) 倒序使用键集分页，否则按 ID 倒序使用偏移量分页.
 func (b *postBiz) List(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
-	whr := where.F("userID", contextx.UserID(ctx)).P(int(rq.Offset), int(rq.Limit))
+	whr := where.F("userID", contextx.UserID(ctx))
 	if rq.Title != nil {
 		whr = whr.Q("title like ?", "%"+*rq.Title+"%")
 	}
+	if rq.Status != nil {
+		whr = whr.F("status", int32(*rq.Status))
+	}
+	if rq.TagID != nil || rq.CategoryID != nil {
+		postIDs, err := b.filterByTerms(ctx, rq.TagID, rq.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		whr = whr.F("postID", postIDs)
+	}
+	whr, err := listWhere(whr, rq)
+	if err != nil {
+		return nil, err
+	}
 
-	count, postList, err := b.store.Post().List(ctx, whr)
+	var (
+		count         int64
+		postList      []*model.Post
+		nextPageToken string
+	)
+	if rq.PageToken != nil {
+		count, postList, nextPageToken, err = b.seek(ctx, whr, rq.GetPageToken(), rq.Limit, rq.WithTotalCount)
+	} else {
+		count, postList, err = b.store.Post().List(ctx, whr.P(int(rq.Offset), int(rq.Limit)))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -115,6 +216,248 @@ func (b *postBiz) List(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.L
 		converted := conversion.PostodelToPostV1(post)
 		posts = append(posts, converted)
 	}
+	if err := b.fillTerms(ctx, posts...); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListPostResponse{TotalCount: count, Posts: posts, NextPageToken: nextPageToken}, nil
+}
+
+// postFilterFields 是文章列表过滤表达式中允许使用的字段.
+var postFilterFields = query.Fields{
+	"title":       {Column: "title", Type: query.String},
+	"content":     {Column: "content", Type: query.String},
+	"status":      {Column: "status", Type: query.Enum, Values: apiv1.PostStatus_value},
+	"createdAt":   {Column: "createdAt", Type: query.Time},
+	"updatedAt":   {Column: "updatedAt", Type: query.Time},
+	"publishedAt": {Column: "publishedAt", Type: query.Time},
+}
+
+// postOrderFields 是文章列表允许排序的字段.
+var postOrderFields = query.Fields{
+	"createdAt": {Column: "createdAt"},
+	"updatedAt": {Column: "updatedAt"},
+	"title":     {Column: "title"},
+}
+
+// listWhere 在 whr 中追加请求的创建时间范围、过滤表达式和排序方式.
+func listWhere(whr *where.Options, rq *apiv1.ListPostRequest) (*where.Options, error) {
+	if rq.CreatedAfter != nil {
+		whr = whr.C(clause.Gte{Column: clause.Column{Name: "createdAt"}, Value: rq.CreatedAfter.AsTime()})
+	}
+	if rq.CreatedBefore != nil {
+		whr = whr.C(clause.Lt{Column: clause.Column{Name: "createdAt"}, Value: rq.CreatedBefore.AsTime()})
+	}
+
+	expr, err := query.ParseFilter(rq.Filter, postFilterFields)
+	if err != nil {
+		return nil, errorx.ErrInvalidArugment.WithMessage(err.Error())
+	}
+	if expr != nil {
+		whr = whr.C(expr)
+	}
+
+	columns, err := query.ParseOrderBy(rq.OrderBy, postOrderFields)
+	if err != nil {
+		return nil, errorx.ErrInvalidArugment.WithMessage(err.Error())
+	}
+	if len(columns) > 0 {
+		whr = whr.C(clause.OrderBy{Columns: columns})
+	}
+	return whr, nil
+}
+
+// seek 使用键集分页查询 pageToken 之后的 limit 篇文章，多查询一篇用于判断是否还有下一页.
+// withCount 为 false 时不统计总数，返回的总数为 0.
+func (b *postBiz) seek(ctx context.Context, whr *where.Options, pageToken string, limit int64, withCount bool) (int64, []*model.Post, string, error) {
+	var after *store.Cursor
+	if pageToken != "" {
+		cursor, err := store.ParseCursor(pageToken)
+		if err != nil {
+			return 0, nil, "", errorx.ErrInvalidArugment.WithMessage(err.Error())
+		}
+		after = cursor
+	}
+	if limit <= 0 {
+		limit = known.DefaultPageSize
+	}
+
+	var count int64
+	if withCount {
+		var err error
+		if count, err = b.store.Post().Count(ctx, whr); err != nil {
+			return 0, nil, "", err
+		}
+	}
+
+	postList, err := b.store.Post().Seek(ctx, whr.L(int(limit)+1), after)
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	var nextPageToken string
+	if len(postList) > int(limit) {
+		postList = postList[:limit]
+		last := postList[len(postList)-1]
+		nextPageToken = (&store.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}).Encode()
+	}
+
+	return count, postList, nextPageToken, nil
+}
+
+// Publish 实现 PostExpansion 接口中的 Publish 方法.
+// 未指定发布时间或发布时间早于当前时间时立即发布，否则进入定时发布状态，由调度器在发布时间到达后自动发布.
+func (b *postBiz) Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error) {
+	whr := where.F("userID", contextx.UserID(ctx), "postID", rq.PostID)
+	postM, err := b.store.Post().Get(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	status, publishAt := apiv1.PostStatus_Published, now
+	if rq.PublishAt != nil && rq.PublishAt.AsTime().After(now) {
+		status, publishAt = apiv1.PostStatus_Scheduled, rq.PublishAt.AsTime()
+	}
+
+	// 重复发布已发布的文章时，保留原有的发布时间
+	if status == apiv1.PostStatus_Published && postM.Status == int32(apiv1.PostStatus_Published) && postM.PublishedAt != nil {
+		publishAt = *postM.PublishedAt
+	}
+
+	// 只更新状态和发布时间，不会覆盖其它请求并发修改的标题和内容
+	if _, err := b.store.Post().Updates(ctx, whr, map[string]any{"status": int32(status), "publishedAt": &publishAt}); err != nil {
+		return nil, err
+	}
+	b.reindex(ctx, rq.PostID)
+
+	return &apiv1.PublishPostResponse{Status: status, PublishedAt: timestamppb.New(publishAt)}, nil
+}
+
+// Unpublish 实现 PostExpansion 接口中的 Unpublish 方法，文章恢复为草稿.
+func (b *postBiz) Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error) {
+	whr := where.F("userID", contextx.UserID(ctx), "postID", rq.PostID)
+	if err := b.updateStatus(ctx, whr, map[string]any{"status": int32(apiv1.PostStatus_Draft), "publishedAt": nil}); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnpublishPostResponse{}, nil
+}
+
+// Archive 实现 PostExpansion 接口中的 Archive 方法，归档后的文章不再对读者展示.
+func (b *postBiz) Archive(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error) {
+	whr := where.F("userID", contextx.UserID(ctx), "postID", rq.PostID)
+	if err := b.updateStatus(ctx, whr, map[string]any{"status": int32(apiv1.PostStatus_Archived)}); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ArchivePostResponse{}, nil
+}
+
+// updateStatus 只更新文章的状态相关列，不会覆盖其它请求并发修改的标题和内容，文章不存在时返回错误.
+func (b *postBiz) updateStatus(ctx context.Context, whr *where.Options, values map[string]any) error {
+	postM, err := b.store.Post().Get(ctx, whr)
+	if err != nil {
+		return err
+	}
+
+	if _, err := b.store.Post().Updates(ctx, whr, values); err != nil {
+		return err
+	}
+	b.reindex(ctx, postM.PostID)
+	return nil
+}
+
+// PublishScheduled 实现 PostExpansion 接口中的 PublishScheduled 方法，
+// 将所有到达发布时间的定时发布文章置为已发布，返回本次发布的文章数量.
+func (b *postBiz) PublishScheduled(ctx context.Context) (int64, error) {
+	due := clause.Lte{Column: clause.Column{Name: "publishedAt"}, Value: time.Now()}
+	_, postList, err := b.store.Post().List(ctx, where.F("status", int32(apiv1.PostStatus_Scheduled)).C(due))
+	if err != nil {
+		return 0, err
+	}
+
+	var published int64
+	for _, postM := range postList {
+		// 查询之后文章可能被作者撤回、修改了发布时间或者移入回收站，只发布仍然到期的定时发布文章
+		whr := where.F("postID", postM.PostID, "status", int32(apiv1.PostStatus_Scheduled)).C(due)
+		n, err := b.store.Post().Updates(ctx, whr, map[string]any{"status": int32(apiv1.PostStatus_Published)})
+		if err != nil {
+			return published, err
+		}
+		if n == 1 {
+			b.reindex(ctx, postM.PostID)
+			published++
+		}
+	}
+
+	return published, nil
+}
+
+// ListPublic 实现 PostExpansion 接口中的 ListPublic 方法，匿名读者可以查看所有作者或指定作者的文章.
+func (b *postBiz) ListPublic(ctx context.Context, rq *apiv1.ListPublicPostRequest) (*apiv1.ListPublicPostResponse, error) {
+	page, pageSize := normalizePage(rq.Page, rq.PageSize)
+
+	whr, err := b.publicWhere(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whr = whr.P(int(page), int(pageSize))
+	if rq.UserID != "" {
+		whr = whr.F("userID", rq.UserID)
+	}
+
+	count, postList, err := b.store.Post().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range postList {
+		posts = append(posts, conversion.PostodelToPostV1(post))
+	}
+	if err := b.fillTerms(ctx, posts...); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListPublicPostResponse{TotalCount: count, Page: page, PageSize: pageSize, Posts: posts}, nil
+}
+
+// GetPublic 实现 PostExpansion 接口中的 GetPublic 方法，匿名读者可以根据文章 ID 查看文章.
+func (b *postBiz) GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error) {
+	whr, err := b.publicWhere(ctx)
+	if err != nil {
+		return nil, err
+	}
+	postM, err := b.store.Post().Get(ctx, whr.F("postID", rq.PostID))
+	if err != nil {
+		return nil, err
+	}
+
+	post := conversion.PostodelToPostV1(postM)
+	if err := b.fillTerms(ctx, post); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPublicPostResponse{Post: post}, nil
+}
+
+// publicWhere 返回匿名读者可以看到的文章的查询条件：文章已发布，并且作者没有注销.
+// 注销冷静期内作者的文章仍然保留以便恢复账号，但不再对匿名读者展示.
+func (b *postBiz) publicWhere(ctx context.Context) (*where.Options, error) {
+	whr := where.F("status", int32(apiv1.PostStatus_Published))
+
+	_, userList, err := b.store.User().ListDeleted(ctx, where.NewWhere())
+	if err != nil {
+		return nil, err
+	}
+	if len(userList) > 0 {
+		userIDs := make([]any, 0, len(userList))
+		for _, user := range userList {
+			userIDs = append(userIDs, user.UserID)
+		}
+		whr = whr.C(clause.Not(clause.IN{Column: clause.Column{Name: "userID"}, Values: userIDs}))
+	}
 
-	return &apiv1.ListPostResponse{TotalCount: count, Posts: posts}, nil
+	return whr, nil
 }
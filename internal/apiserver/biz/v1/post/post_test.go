@@ -0,0 +1,507 @@
+package post_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	postv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/post"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// newPostBiz 创建基于内存存储和内存索引的 PostBiz，不依赖数据库.
+func newPostBiz(t *testing.T) postv1.PostBiz {
+	return newPostBizWithStore(t, store.NewMemoryStore())
+}
+
+// newPostBizWithStore 创建基于存储 s 和内存索引的 PostBiz.
+func newPostBizWithStore(t *testing.T, s store.IStore) postv1.PostBiz {
+	t.Helper()
+
+	searcher, err := search.New(&genericoptions.SearchOptions{Engine: genericoptions.SearchEngineBleve}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = searcher.Close() })
+
+	return postv1.New(s, searcher, 0)
+}
+
+func TestPostBizWithMemoryStore(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	b := newPostBiz(t)
+
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "hello", Content: "world", Tags: []string{"go", "gorm"}})
+	require.NoError(t, err)
+
+	title := "hello, memory store"
+	tags, err := structpb.NewList([]any{"go"})
+	require.NoError(t, err)
+	_, err = b.Update(ctx, &apiv1.UpdatePostRequest{PostID: created.PostID, Title: &title, Tags: tags})
+	require.NoError(t, err)
+
+	got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.Equal(t, title, got.Post.Title)
+	require.Len(t, got.Post.Tags, 1)
+	assert.Equal(t, "go", got.Post.Tags[0].Name)
+
+	revisions, err := b.ListRevision(ctx, &apiv1.ListPostRevisionRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, revisions.TotalCount)
+
+	keyword := "MEMORY"
+	list, err := b.List(ctx, &apiv1.ListPostRequest{Title: &keyword})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.TotalCount)
+
+	// 其它用户看不到该文章
+	_, err = b.Get(contextx.WithUserID(context.Background(), "user-2"), &apiv1.GetPostRequest{PostID: created.PostID})
+	assert.Error(t, err)
+
+	_, err = b.Delete(ctx, &apiv1.DeletePostRequest{PostIDs: []string{created.PostID}})
+	require.NoError(t, err)
+	list, err = b.List(ctx, &apiv1.ListPostRequest{})
+	require.NoError(t, err)
+	assert.Zero(t, list.TotalCount)
+
+	// 删除的文章移入回收站，恢复后保留标签和历史版本
+	trash, err := b.ListTrash(ctx, &apiv1.ListTrashPostRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, trash.TotalCount)
+	assert.NotNil(t, trash.Posts[0].DeletedAt)
+	_, err = b.Restore(ctx, &apiv1.RestorePostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	got, err = b.Get(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.Nil(t, got.Post.DeletedAt)
+	assert.Len(t, got.Post.Tags, 1)
+	_, err = b.Restore(ctx, &apiv1.RestorePostRequest{PostID: created.PostID})
+	assert.Error(t, err)
+
+	// 只有回收站中的文章可以被彻底删除
+	_, err = b.Purge(ctx, &apiv1.PurgePostRequest{PostIDs: []string{created.PostID}})
+	require.NoError(t, err)
+	_, err = b.Get(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	_, err = b.Delete(ctx, &apiv1.DeletePostRequest{PostIDs: []string{created.PostID}})
+	require.NoError(t, err)
+	_, err = b.Purge(ctx, &apiv1.PurgePostRequest{PostIDs: []string{created.PostID}})
+	require.NoError(t, err)
+	trash, err = b.ListTrash(ctx, &apiv1.ListTrashPostRequest{})
+	require.NoError(t, err)
+	assert.Zero(t, trash.TotalCount)
+	_, err = b.Restore(ctx, &apiv1.RestorePostRequest{PostID: created.PostID})
+	assert.Error(t, err)
+}
+
+func TestPostBizListTrashOffset(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	b := newPostBiz(t)
+
+	postIDs := make([]string, 0, 3)
+	for _, title := range []string{"first", "second", "third"} {
+		created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: title, Content: title})
+		require.NoError(t, err)
+		_, err = b.Delete(ctx, &apiv1.DeletePostRequest{PostIDs: []string{created.PostID}})
+		require.NoError(t, err)
+		postIDs = append(postIDs, created.PostID)
+	}
+
+	// 回收站按删除顺序倒序返回，offset 表示跳过的记录数
+	trash, err := b.ListTrash(ctx, &apiv1.ListTrashPostRequest{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, trash.TotalCount)
+	require.Len(t, trash.Posts, 1)
+	assert.Equal(t, postIDs[1], trash.Posts[0].PostID)
+
+	trash, err = b.ListTrash(ctx, &apiv1.ListTrashPostRequest{Offset: 0, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, trash.Posts, 2)
+	assert.Equal(t, postIDs[2], trash.Posts[0].PostID)
+}
+
+func TestPostBizPublicHidesDeletedAuthors(t *testing.T) {
+	s := store.NewMemoryStore()
+	b := newPostBizWithStore(t, s)
+
+	userM := &model.User{Username: "alice", Password: "password", Email: "alice@example.com", Phone: "13800000001"}
+	require.NoError(t, s.User().Create(context.Background(), userM))
+	ctx := contextx.WithUserID(context.Background(), userM.UserID)
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "hello", Content: "public content"})
+	require.NoError(t, err)
+	_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+
+	assertVisible := func(visible bool) {
+		t.Helper()
+
+		list, err := b.ListPublic(context.Background(), &apiv1.ListPublicPostRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, visible, list.TotalCount == 1)
+		_, err = b.GetPublic(context.Background(), &apiv1.GetPublicPostRequest{PostID: created.PostID})
+		assert.Equal(t, visible, err == nil)
+		found, err := b.SearchPublic(context.Background(), &apiv1.SearchPublicPostRequest{Q: "public"})
+		require.NoError(t, err)
+		assert.Equal(t, visible, len(found.Hits) == 1)
+	}
+	assertVisible(true)
+
+	// 注销冷静期内作者的文章不再对匿名读者展示，恢复账号后重新可见
+	require.NoError(t, s.User().Delete(context.Background(), where.F("userID", userM.UserID)))
+	assertVisible(false)
+	require.NoError(t, s.User().Restore(context.Background(), where.F("userID", userM.UserID)))
+	assertVisible(true)
+}
+
+func TestPostBizRestoreRevisionOwnership(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	b := newPostBiz(t)
+
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "hello", Content: "v0"})
+	require.NoError(t, err)
+	content := "v1"
+	_, err = b.Update(ctx, &apiv1.UpdatePostRequest{PostID: created.PostID, Content: &content})
+	require.NoError(t, err)
+
+	// 其他用户无论历史版本是否存在都只会得到文章不存在
+	other := contextx.WithUserID(context.Background(), "user-2")
+	for _, version := range []int64{1, 2} {
+		_, err = b.RestoreRevision(other, &apiv1.RestorePostRevisionRequest{PostID: created.PostID, Version: version})
+		assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	}
+
+	_, err = b.RestoreRevision(ctx, &apiv1.RestorePostRevisionRequest{PostID: created.PostID, Version: 1})
+	require.NoError(t, err)
+	got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.Equal(t, "v0", got.Post.Content)
+	_, err = b.RestoreRevision(ctx, &apiv1.RestorePostRevisionRequest{PostID: created.PostID, Version: 9})
+	assert.ErrorIs(t, err, errorx.ErrPostRevisionNotFound)
+}
+
+// slowStore 在读取文章后短暂等待，使并发更新的读写交错.
+type slowStore struct {
+	store.IStore
+}
+
+func (s slowStore) Post() store.PostStore { return slowPostStore{s.IStore.Post()} }
+
+type slowPostStore struct {
+	store.PostStore
+}
+
+func (s slowPostStore) Get(ctx context.Context, opts *where.Options) (*model.Post, error) {
+	postM, err := s.PostStore.Get(ctx, opts)
+	time.Sleep(10 * time.Millisecond)
+	return postM, err
+}
+
+func TestPostBizConcurrentUpdateRevisions(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	b := newPostBizWithStore(t, slowStore{store.NewMemoryStore()})
+
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "hello", Content: "v0"})
+	require.NoError(t, err)
+
+	const n = 8
+	var wg sync.WaitGroup
+	for i := 1; i <= n; i++ {
+		wg.Add(1)
+		go func(content string) {
+			defer wg.Done()
+			_, err := b.Update(ctx, &apiv1.UpdatePostRequest{PostID: created.PostID, Content: &content})
+			assert.NoError(t, err)
+		}(fmt.Sprintf("v%d", i))
+	}
+	wg.Wait()
+
+	// 每个历史版本都对应被替换的内容，所有写入过的内容恰好出现一次
+	got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	revisions, err := b.ListRevision(ctx, &apiv1.ListPostRevisionRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	require.EqualValues(t, n, revisions.TotalCount)
+
+	contents := []string{got.Post.Content}
+	for _, revision := range revisions.Revisions {
+		contents = append(contents, revision.Content)
+	}
+	expected := make([]string, 0, n+1)
+	for i := 0; i <= n; i++ {
+		expected = append(expected, fmt.Sprintf("v%d", i))
+	}
+	assert.ElementsMatch(t, expected, contents)
+}
+
+func TestPostBizListQuery(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	b := newPostBiz(t)
+
+	for _, title := range []string{"go basics", "rust", "Go advanced"} {
+		_, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: title, Content: "content"})
+		require.NoError(t, err)
+	}
+
+	titles := func(rq *apiv1.ListPostRequest) []string {
+		t.Helper()
+		list, err := b.List(ctx, rq)
+		require.NoError(t, err)
+		var ret []string
+		for _, post := range list.Posts {
+			ret = append(ret, post.Title)
+		}
+		return ret
+	}
+
+	assert.Equal(t, []string{"Go advanced", "rust", "go basics"}, titles(&apiv1.ListPostRequest{}))
+	assert.Equal(t, []string{"go basics", "Go advanced"}, titles(&apiv1.ListPostRequest{Filter: "title:go", OrderBy: "createdAt asc"}))
+	assert.Equal(t, []string{"rust"}, titles(&apiv1.ListPostRequest{Filter: "status=Draft NOT title:go"}))
+	assert.Equal(t, []string{"Go advanced", "go basics", "rust"}, titles(&apiv1.ListPostRequest{OrderBy: "title"}))
+	assert.Empty(t, titles(&apiv1.ListPostRequest{CreatedAfter: timestamppb.New(time.Now().Add(time.Hour))}))
+
+	_, err := b.List(ctx, &apiv1.ListPostRequest{Filter: "userID:user-2"})
+	assert.Error(t, err)
+	_, err = b.List(ctx, &apiv1.ListPostRequest{OrderBy: "content"})
+	assert.Error(t, err)
+}
+
+func TestPostBizPublicHidesUnpublished(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	b := newPostBiz(t)
+
+	create := func(title string) string {
+		created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: title, Content: "public " + title})
+		require.NoError(t, err)
+		return created.PostID
+	}
+	draftID, scheduledID, publishedID, archivedID := create("draft"), create("scheduled"), create("published"), create("archived")
+	_, err := b.Publish(ctx, &apiv1.PublishPostRequest{PostID: scheduledID, PublishAt: timestamppb.New(time.Now().Add(time.Hour))})
+	require.NoError(t, err)
+	_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: publishedID})
+	require.NoError(t, err)
+	_, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: archivedID})
+	require.NoError(t, err)
+	_, err = b.Archive(ctx, &apiv1.ArchivePostRequest{PostID: archivedID})
+	require.NoError(t, err)
+
+	// 匿名读者只能看到已发布的文章
+	anonymous := context.Background()
+	list, err := b.ListPublic(anonymous, &apiv1.ListPublicPostRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, list.TotalCount)
+	assert.Equal(t, publishedID, list.Posts[0].PostID)
+	list, err = b.ListPublic(anonymous, &apiv1.ListPublicPostRequest{UserID: "user-1"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.TotalCount)
+
+	found, err := b.SearchPublic(anonymous, &apiv1.SearchPublicPostRequest{Q: "public"})
+	require.NoError(t, err)
+	require.Len(t, found.Hits, 1)
+	assert.Equal(t, publishedID, found.Hits[0].Post.PostID)
+
+	_, err = b.GetPublic(anonymous, &apiv1.GetPublicPostRequest{PostID: publishedID})
+	require.NoError(t, err)
+	for _, postID := range []string{draftID, scheduledID, archivedID} {
+		_, err = b.GetPublic(anonymous, &apiv1.GetPublicPostRequest{PostID: postID})
+		assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	}
+
+	// 撤回发布后文章恢复为草稿，不再公开
+	_, err = b.Unpublish(ctx, &apiv1.UnpublishPostRequest{PostID: publishedID})
+	require.NoError(t, err)
+	list, err = b.ListPublic(anonymous, &apiv1.ListPublicPostRequest{})
+	require.NoError(t, err)
+	assert.Zero(t, list.TotalCount)
+	found, err = b.SearchPublic(anonymous, &apiv1.SearchPublicPostRequest{Q: "public"})
+	require.NoError(t, err)
+	assert.Empty(t, found.Hits)
+}
+
+func TestPostBizStatusTransitions(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	b := newPostBiz(t)
+
+	created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "hello", Content: "world"})
+	require.NoError(t, err)
+	assertStatus := func(status apiv1.PostStatus) *apiv1.Post {
+		t.Helper()
+
+		got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+		require.NoError(t, err)
+		assert.Equal(t, status, got.Post.Status)
+		return got.Post
+	}
+	assertStatus(apiv1.PostStatus_Draft)
+
+	// 立即发布，重复发布时保留首次发布时间
+	published, err := b.Publish(ctx, &apiv1.PublishPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.PostStatus_Published, published.Status)
+	republished, err := b.Publish(ctx, &apiv1.PublishPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.True(t, published.PublishedAt.AsTime().Equal(republished.PublishedAt.AsTime()))
+	assertStatus(apiv1.PostStatus_Published)
+
+	_, err = b.Archive(ctx, &apiv1.ArchivePostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assertStatus(apiv1.PostStatus_Archived)
+
+	_, err = b.Unpublish(ctx, &apiv1.UnpublishPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.Nil(t, assertStatus(apiv1.PostStatus_Draft).PublishedAt)
+
+	// 早于当前时间的发布时间按立即发布处理
+	published, err = b.Publish(ctx, &apiv1.PublishPostRequest{PostID: created.PostID, PublishAt: timestamppb.New(time.Now().Add(-time.Hour))})
+	require.NoError(t, err)
+	assert.Equal(t, apiv1.PostStatus_Published, published.Status)
+
+	// 其他用户不能修改文章状态
+	other := contextx.WithUserID(context.Background(), "user-2")
+	_, err = b.Publish(other, &apiv1.PublishPostRequest{PostID: created.PostID})
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	_, err = b.Unpublish(other, &apiv1.UnpublishPostRequest{PostID: created.PostID})
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	_, err = b.Archive(other, &apiv1.ArchivePostRequest{PostID: created.PostID})
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	assertStatus(apiv1.PostStatus_Published)
+}
+
+func TestPostBizPublishScheduled(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	s := store.NewMemoryStore()
+	b := newPostBizWithStore(t, s)
+
+	schedule := func(title string) string {
+		created, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: title, Content: title})
+		require.NoError(t, err)
+		scheduled, err := b.Publish(ctx, &apiv1.PublishPostRequest{PostID: created.PostID, PublishAt: timestamppb.New(time.Now().Add(time.Hour))})
+		require.NoError(t, err)
+		assert.Equal(t, apiv1.PostStatus_Scheduled, scheduled.Status)
+		return created.PostID
+	}
+	dueID, withdrawnID, laterID := schedule("due"), schedule("withdrawn"), schedule("later")
+
+	// 未到发布时间的文章不会被发布
+	n, err := b.PublishScheduled(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	// 发布时间到达后由调度器发布，撤回的定时发布文章保持草稿
+	past := time.Now().Add(-time.Minute)
+	for _, postID := range []string{dueID, withdrawnID} {
+		_, err = s.Post().Updates(context.Background(), where.F("postID", postID), map[string]any{"publishedAt": &past})
+		require.NoError(t, err)
+	}
+	_, err = b.Unpublish(ctx, &apiv1.UnpublishPostRequest{PostID: withdrawnID})
+	require.NoError(t, err)
+
+	n, err = b.PublishScheduled(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, n)
+	n, err = b.PublishScheduled(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	for postID, status := range map[string]apiv1.PostStatus{
+		dueID:       apiv1.PostStatus_Published,
+		withdrawnID: apiv1.PostStatus_Draft,
+		laterID:     apiv1.PostStatus_Scheduled,
+	} {
+		got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: postID})
+		require.NoError(t, err)
+		assert.Equal(t, status, got.Post.Status)
+	}
+	_, err = b.GetPublic(context.Background(), &apiv1.GetPublicPostRequest{PostID: dueID})
+	assert.NoError(t, err)
+}
+
+func TestPostBizTerms(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	s := store.NewMemoryStore()
+	b := newPostBizWithStore(t, s)
+
+	goCategory := &model.Category{UserID: "user-1", Name: "go"}
+	dbCategory := &model.Category{UserID: "user-1", Name: "db"}
+	otherCategory := &model.Category{UserID: "user-2", Name: "go"}
+	for _, categoryM := range []*model.Category{goCategory, dbCategory, otherCategory} {
+		require.NoError(t, s.Category().Create(context.Background(), categoryM))
+	}
+
+	// 不存在或属于其他用户的分类不能关联到文章
+	for _, categoryID := range []string{"category-missing", otherCategory.CategoryID} {
+		_, err := b.Create(ctx, &apiv1.CreatePostRequest{Title: "bad", Content: "bad", CategoryIDs: []string{categoryID}})
+		assert.ErrorIs(t, err, errorx.ErrCategoryNotFound)
+	}
+
+	// 标签按名称去重，不存在的标签自动创建
+	first, err := b.Create(ctx, &apiv1.CreatePostRequest{
+		Title: "first", Content: "first", Tags: []string{"go", " go ", "gorm"}, CategoryIDs: []string{goCategory.CategoryID},
+	})
+	require.NoError(t, err)
+	second, err := b.Create(ctx, &apiv1.CreatePostRequest{
+		Title: "second", Content: "second", Tags: []string{"go"}, CategoryIDs: []string{dbCategory.CategoryID},
+	})
+	require.NoError(t, err)
+	count, tagList, err := s.Tag().List(context.Background(), where.F("userID", "user-1"))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, count)
+	tagIDs := make(map[string]string, len(tagList))
+	for _, tag := range tagList {
+		tagIDs[tag.Name] = tag.TagID
+	}
+
+	got, err := b.Get(ctx, &apiv1.GetPostRequest{PostID: first.PostID})
+	require.NoError(t, err)
+	assert.Len(t, got.Post.Tags, 2)
+	require.Len(t, got.Post.Categories, 1)
+	assert.Equal(t, goCategory.CategoryID, got.Post.Categories[0].CategoryID)
+
+	listIDs := func(rq *apiv1.ListPostRequest) []string {
+		t.Helper()
+
+		list, err := b.List(ctx, rq)
+		require.NoError(t, err)
+		postIDs := make([]string, 0, len(list.Posts))
+		for _, post := range list.Posts {
+			postIDs = append(postIDs, post.PostID)
+		}
+		return postIDs
+	}
+	tagID := func(name string) *string {
+		tagID := tagIDs[name]
+		return &tagID
+	}
+	assert.ElementsMatch(t, []string{first.PostID, second.PostID}, listIDs(&apiv1.ListPostRequest{TagID: tagID("go")}))
+	assert.Equal(t, []string{first.PostID}, listIDs(&apiv1.ListPostRequest{TagID: tagID("gorm")}))
+	assert.Equal(t, []string{second.PostID}, listIDs(&apiv1.ListPostRequest{CategoryID: &dbCategory.CategoryID}))
+	assert.Equal(t, []string{second.PostID}, listIDs(&apiv1.ListPostRequest{TagID: tagID("go"), CategoryID: &dbCategory.CategoryID}))
+	assert.Empty(t, listIDs(&apiv1.ListPostRequest{TagID: tagID("gorm"), CategoryID: &dbCategory.CategoryID}))
+
+	// 更新时替换文章的全部标签和分类，空列表表示清空
+	tags, err := structpb.NewList([]any{"gorm"})
+	require.NoError(t, err)
+	categoryIDs, err := structpb.NewList([]any{})
+	require.NoError(t, err)
+	_, err = b.Update(ctx, &apiv1.UpdatePostRequest{PostID: second.PostID, Tags: tags, CategoryIDs: categoryIDs})
+	require.NoError(t, err)
+	got, err = b.Get(ctx, &apiv1.GetPostRequest{PostID: second.PostID})
+	require.NoError(t, err)
+	require.Len(t, got.Post.Tags, 1)
+	assert.Equal(t, "gorm", got.Post.Tags[0].Name)
+	assert.Empty(t, got.Post.Categories)
+	assert.Equal(t, []string{first.PostID}, listIDs(&apiv1.ListPostRequest{TagID: tagID("go")}))
+	assert.Empty(t, listIDs(&apiv1.ListPostRequest{CategoryID: &dbCategory.CategoryID}))
+}
@@ -0,0 +1,160 @@
+package post
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/diff"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// ListRevision 实现 PostExpansion 接口中的 ListRevision 方法，按版本号从新到旧返回文章的历史版本.
+func (b *postBiz) ListRevision(ctx context.Context, rq *apiv1.ListPostRevisionRequest) (*apiv1.ListPostRevisionResponse, error) {
+	if _, err := b.getOwnedPost(ctx, rq.PostID); err != nil {
+		return nil, err
+	}
+
+	whr := where.F("postID", rq.PostID).O(int(rq.Offset)).L(int(rq.Limit))
+	count, revisionList, err := b.store.PostRevision().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*apiv1.PostRevision, 0, len(revisionList))
+	for _, revision := range revisionList {
+		revisions = append(revisions, conversion.PostRevisionodelToPostRevisionV1(revision))
+	}
+
+	return &apiv1.ListPostRevisionResponse{TotalCount: count, Revisions: revisions}, nil
+}
+
+// GetRevision 实现 PostExpansion 接口中的 GetRevision 方法.
+func (b *postBiz) GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error) {
+	if _, err := b.getOwnedPost(ctx, rq.PostID); err != nil {
+		return nil, err
+	}
+
+	revisionM, err := b.store.PostRevision().Get(ctx, where.F("postID", rq.PostID, "version", rq.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPostRevisionResponse{Revision: conversion.PostRevisionodelToPostRevisionV1(revisionM)}, nil
+}
+
+// DiffRevision 实现 PostExpansion 接口中的 DiffRevision 方法，按行比较两个版本的内容.
+// 未指定新版本时与文章当前内容比较.
+func (b *postBiz) DiffRevision(ctx context.Context, rq *apiv1.DiffPostRevisionRequest) (*apiv1.DiffPostRevisionResponse, error) {
+	postM, err := b.getOwnedPost(ctx, rq.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := b.store.PostRevision().Get(ctx, where.F("postID", rq.PostID, "version", rq.From))
+	if err != nil {
+		return nil, err
+	}
+
+	toTitle, toContent := postM.Title, postM.Content
+	if rq.To != 0 {
+		to, err := b.store.PostRevision().Get(ctx, where.F("postID", rq.PostID, "version", rq.To))
+		if err != nil {
+			return nil, err
+		}
+		toTitle, toContent = to.Title, to.Content
+	}
+
+	lines := make([]*apiv1.DiffLine, 0)
+	for _, line := range diff.Lines(from.Content, toContent) {
+		lines = append(lines, &apiv1.DiffLine{Operation: diffOperations[line.Operation], Text: line.Text})
+	}
+
+	return &apiv1.DiffPostRevisionResponse{FromTitle: from.Title, ToTitle: toTitle, Lines: lines}, nil
+}
+
+// RestoreRevision 实现 PostExpansion 接口中的 RestoreRevision 方法.
+// 恢复操作本身也是一次更新，恢复前的内容会被记录为新的历史版本，因此恢复可以撤销.
+func (b *postBiz) RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error) {
+	// 先校验文章属于当前用户，避免其他用户通过返回的错误判断历史版本是否存在
+	if _, err := b.getOwnedPost(ctx, rq.PostID); err != nil {
+		return nil, err
+	}
+
+	revisionM, err := b.store.PostRevision().Get(ctx, where.F("postID", rq.PostID, "version", rq.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	var postM *model.Post
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		var err error
+		if postM, err = b.lockOwnedPost(ctx, rq.PostID); err != nil {
+			return err
+		}
+
+		revision := &model.PostRevision{PostID: postM.PostID, Title: postM.Title, Content: postM.Content}
+		postM.Title, postM.Content = revisionM.Title, revisionM.Content
+		return b.saveWithRevision(ctx, postM, revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.syncIndex(ctx, postM)
+
+	return &apiv1.RestorePostRevisionResponse{}, nil
+}
+
+// diffOperations 将差异行的类型转换为 Protobuf 中的定义.
+var diffOperations = map[diff.Operation]apiv1.DiffOperation{
+	diff.Equal:  apiv1.DiffOperation_Equal,
+	diff.Insert: apiv1.DiffOperation_Insert,
+	diff.Delete: apiv1.DiffOperation_Delete,
+}
+
+// getOwnedPost 获取当前用户的文章，文章不存在或不属于当前用户时返回 ErrPostNotFound.
+func (b *postBiz) getOwnedPost(ctx context.Context, postID string) (*model.Post, error) {
+	return b.store.Post().Get(ctx, where.F("userID", contextx.UserID(ctx), "postID", postID))
+}
+
+// lockOwnedPost 获取当前用户的文章并锁定该行直到事务结束，需要在事务中调用.
+// 并发更新同一篇文章时，后到的请求读到的是前一个请求提交后的内容，记录的历史版本与被替换的内容一致.
+func (b *postBiz) lockOwnedPost(ctx context.Context, postID string) (*model.Post, error) {
+	whr := where.F("userID", contextx.UserID(ctx), "postID", postID).C(clause.Locking{Strength: clause.LockingStrengthUpdate})
+	return b.store.Post().Get(ctx, whr)
+}
+
+// saveWithRevision 保存文章的标题和内容，并将更新之前的标题和内容记录为新的历史版本，需要在事务中调用.
+// 标题和内容都没有变化时不记录历史版本.
+// 只有文章的最后修改时间仍然是读取时的 postM.UpdatedAt 时才会保存，否则返回 ErrPreconditionFailed.
+func (b *postBiz) saveWithRevision(ctx context.Context, postM *model.Post, revision *model.PostRevision) error {
+	if postM.Title != revision.Title || postM.Content != revision.Content {
+		version, err := b.store.PostRevision().LatestVersion(ctx, postM.PostID)
+		if err != nil {
+			return err
+		}
+
+		revision.Version = version + 1
+		if err := b.store.PostRevision().Create(ctx, revision); err != nil {
+			return err
+		}
+	}
+
+	updatedAt := conditional.NextModified(postM.UpdatedAt)
+	whr := where.F("postID", postM.PostID, "updatedAt", postM.UpdatedAt)
+	n, err := b.store.Post().Updates(ctx, whr, map[string]any{"title": postM.Title, "content": postM.Content, "updatedAt": updatedAt})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errorx.ErrPreconditionFailed
+	}
+	postM.UpdatedAt = updatedAt
+
+	return nil
+}
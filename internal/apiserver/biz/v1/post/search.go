@@ -0,0 +1,159 @@
+package post
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// reindexBatchSize 是重建索引时每批读取的文章数量.
+const reindexBatchSize = 500
+
+// Search 实现 PostExpansion 接口中的 Search 方法，检索当前用户的所有文章.
+func (b *postBiz) Search(ctx context.Context, rq *apiv1.SearchPostRequest) (*apiv1.SearchPostResponse, error) {
+	page, pageSize := normalizePage(rq.Page, rq.PageSize)
+	userID := contextx.UserID(ctx)
+
+	count, hits, err := b.search(ctx, &search.Query{Keyword: rq.Q, UserID: userID}, page, pageSize, where.F("userID", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.SearchPostResponse{TotalCount: count, Page: page, PageSize: pageSize, Hits: hits}, nil
+}
+
+// SearchPublic 实现 PostExpansion 接口中的 SearchPublic 方法，匿名读者只能检索已发布的文章.
+func (b *postBiz) SearchPublic(ctx context.Context, rq *apiv1.SearchPublicPostRequest) (*apiv1.SearchPublicPostResponse, error) {
+	page, pageSize := normalizePage(rq.Page, rq.PageSize)
+	status := int32(apiv1.PostStatus_Published)
+
+	// 索引中包含已注销作者的文章，读取文章时按匿名读者可见的条件过滤
+	whr, err := b.publicWhere(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count, hits, err := b.search(ctx, &search.Query{Keyword: rq.Q, UserID: rq.UserID, Status: &status}, page, pageSize, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.SearchPublicPostResponse{TotalCount: count, Page: page, PageSize: pageSize, Hits: hits}, nil
+}
+
+// Reindex 实现 PostExpansion 接口中的 Reindex 方法，根据数据库中的所有文章重建全文索引，返回索引的文章数量.
+func (b *postBiz) Reindex(ctx context.Context) (int64, error) {
+	var indexed int64
+	for page := 1; ; page++ {
+		_, postList, err := b.store.Post().List(ctx, where.P(page, reindexBatchSize))
+		if err != nil {
+			return indexed, err
+		}
+		if len(postList) == 0 {
+			return indexed, nil
+		}
+
+		docs := make([]*search.Document, 0, len(postList))
+		for _, postM := range postList {
+			docs = append(docs, search.NewDocument(postM))
+		}
+		if err := b.searcher.Index(ctx, docs...); err != nil {
+			return indexed, err
+		}
+		indexed += int64(len(docs))
+	}
+}
+
+// search 执行全文检索，并根据 whr 从数据库中读取命中的文章，索引中已不存在或不满足条件的文章会被忽略.
+func (b *postBiz) search(ctx context.Context, q *search.Query, page, pageSize int64, whr *where.Options) (int64, []*apiv1.SearchHit, error) {
+	q.Offset, q.Limit = int((page-1)*pageSize), int(pageSize)
+	res, err := b.searcher.Search(ctx, q)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(res.Hits) == 0 {
+		return res.TotalCount, []*apiv1.SearchHit{}, nil
+	}
+
+	postIDs := make([]string, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		postIDs = append(postIDs, hit.PostID)
+	}
+	_, postList, err := b.store.Post().List(ctx, whr.F("postID", postIDs))
+	if err != nil {
+		return 0, nil, err
+	}
+
+	postMap := make(map[string]*apiv1.Post, len(postList))
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, postM := range postList {
+		post := conversion.PostodelToPostV1(postM)
+		postMap[post.PostID] = post
+		posts = append(posts, post)
+	}
+	if err := b.fillTerms(ctx, posts...); err != nil {
+		return 0, nil, err
+	}
+
+	// 按检索结果的相关度顺序返回
+	hits := make([]*apiv1.SearchHit, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		post, ok := postMap[hit.PostID]
+		if !ok {
+			continue
+		}
+		hits = append(hits, &apiv1.SearchHit{
+			Post:           post,
+			Score:          hit.Score,
+			TitleSnippet:   hit.TitleSnippet,
+			ContentSnippet: hit.ContentSnippet,
+		})
+	}
+
+	return res.TotalCount, hits, nil
+}
+
+// syncIndex 将文章的最新内容同步到全文索引，同步失败只记录日志，不影响文章本身的操作.
+func (b *postBiz) syncIndex(ctx context.Context, postList ...*model.Post) {
+	docs := make([]*search.Document, 0, len(postList))
+	for _, postM := range postList {
+		docs = append(docs, search.NewDocument(postM))
+	}
+	if err := b.searcher.Index(ctx, docs...); err != nil {
+		log.With(ctx).Errorw("Failed to index posts", "err", err)
+	}
+}
+
+// reindex 重新读取文章并同步到全文索引，只更新了部分列时使用，避免用修改前读取的内容覆盖索引.
+func (b *postBiz) reindex(ctx context.Context, postID string) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", postID))
+	if err != nil {
+		log.With(ctx).Errorw("Failed to load post for indexing", "err", err, "postID", postID)
+		return
+	}
+	b.syncIndex(ctx, postM)
+}
+
+// removeIndex 从全文索引中删除文章，删除失败只记录日志.
+func (b *postBiz) removeIndex(ctx context.Context, postIDs ...string) {
+	if err := b.searcher.Delete(ctx, postIDs...); err != nil {
+		log.With(ctx).Errorw("Failed to delete posts from index", "err", err, "postIDs", postIDs)
+	}
+}
+
+// normalizePage 返回规范化后的页码和每页数量.
+func normalizePage(page, pageSize int64) (int64, int64) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = known.DefaultPageSize
+	}
+	return page, pageSize
+}
@@ -0,0 +1,225 @@
+package post
+
+import (
+	"context"
+	"strings"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// setTags 将文章的标签替换为 names 指定的标签，当前用户下不存在的标签会自动创建，需要在事务中调用.
+func (b *postBiz) setTags(ctx context.Context, postID string, names []string) error {
+	names = uniqueStrings(names)
+	userID := contextx.UserID(ctx)
+
+	tagIDs := make([]string, 0, len(names))
+	if len(names) > 0 {
+		_, tagList, err := b.store.Tag().List(ctx, where.F("userID", userID, "name", names))
+		if err != nil {
+			return err
+		}
+
+		existing := make(map[string]string, len(tagList))
+		for _, tag := range tagList {
+			existing[tag.Name] = tag.TagID
+		}
+
+		for _, name := range names {
+			if tagID, ok := existing[name]; ok {
+				tagIDs = append(tagIDs, tagID)
+				continue
+			}
+
+			tagM := model.Tag{UserID: userID, Name: name}
+			if err := b.store.Tag().Create(ctx, &tagM); err != nil {
+				return err
+			}
+			tagIDs = append(tagIDs, tagM.TagID)
+		}
+	}
+
+	if err := b.store.PostTag().Delete(ctx, where.F("postID", postID)); err != nil {
+		return err
+	}
+	for _, tagID := range tagIDs {
+		if err := b.store.PostTag().Create(ctx, &model.PostTag{PostID: postID, TagID: tagID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setCategories 将文章的分类替换为 categoryIDs 指定的分类，分类必须属于当前用户，需要在事务中调用.
+func (b *postBiz) setCategories(ctx context.Context, postID string, categoryIDs []string) error {
+	categoryIDs = uniqueStrings(categoryIDs)
+	if len(categoryIDs) > 0 {
+		count, _, err := b.store.Category().List(ctx, where.F("userID", contextx.UserID(ctx), "categoryID", categoryIDs))
+		if err != nil {
+			return err
+		}
+		if count != int64(len(categoryIDs)) {
+			return errorx.ErrCategoryNotFound
+		}
+	}
+
+	if err := b.store.PostCategory().Delete(ctx, where.F("postID", postID)); err != nil {
+		return err
+	}
+	for _, categoryID := range categoryIDs {
+		if err := b.store.PostCategory().Create(ctx, &model.PostCategory{PostID: postID, CategoryID: categoryID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fillTerms 批量查询文章的标签和分类，并填充到 posts 中.
+func (b *postBiz) fillTerms(ctx context.Context, posts ...*apiv1.Post) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	postIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	_, postTags, err := b.store.PostTag().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return err
+	}
+	_, postCategories, err := b.store.PostCategory().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return err
+	}
+
+	tags := make(map[string]*apiv1.Tag)
+	if len(postTags) > 0 {
+		tagIDs := make([]string, 0, len(postTags))
+		for _, postTag := range postTags {
+			tagIDs = append(tagIDs, postTag.TagID)
+		}
+		_, tagList, err := b.store.Tag().List(ctx, where.F("tagID", uniqueStrings(tagIDs)))
+		if err != nil {
+			return err
+		}
+		for _, tag := range tagList {
+			tags[tag.TagID] = conversion.TagodelToTagV1(tag)
+		}
+	}
+
+	categories := make(map[string]*apiv1.Category)
+	if len(postCategories) > 0 {
+		categoryIDs := make([]string, 0, len(postCategories))
+		for _, postCategory := range postCategories {
+			categoryIDs = append(categoryIDs, postCategory.CategoryID)
+		}
+		_, categoryList, err := b.store.Category().List(ctx, where.F("categoryID", uniqueStrings(categoryIDs)))
+		if err != nil {
+			return err
+		}
+		for _, category := range categoryList {
+			categories[category.CategoryID] = conversion.CategoryodelToCategoryV1(category)
+		}
+	}
+
+	byID := make(map[string]*apiv1.Post, len(posts))
+	for _, post := range posts {
+		byID[post.PostID] = post
+	}
+	for _, postTag := range postTags {
+		if post, tag := byID[postTag.PostID], tags[postTag.TagID]; post != nil && tag != nil {
+			post.Tags = append(post.Tags, tag)
+		}
+	}
+	for _, postCategory := range postCategories {
+		if post, category := byID[postCategory.PostID], categories[postCategory.CategoryID]; post != nil && category != nil {
+			post.Categories = append(post.Categories, category)
+		}
+	}
+
+	return nil
+}
+
+// filterByTerms 返回同时关联了指定标签和分类的文章 ID 列表，tagID 或 categoryID 为 nil 时不按其过滤.
+func (b *postBiz) filterByTerms(ctx context.Context, tagID, categoryID *string) ([]string, error) {
+	var postIDs []string
+	if tagID != nil {
+		_, postTags, err := b.store.PostTag().List(ctx, where.F("tagID", *tagID))
+		if err != nil {
+			return nil, err
+		}
+		postIDs = make([]string, 0, len(postTags))
+		for _, postTag := range postTags {
+			postIDs = append(postIDs, postTag.PostID)
+		}
+	}
+
+	if categoryID != nil {
+		_, postCategories, err := b.store.PostCategory().List(ctx, where.F("categoryID", *categoryID))
+		if err != nil {
+			return nil, err
+		}
+		categoryPostIDs := make([]string, 0, len(postCategories))
+		for _, postCategory := range postCategories {
+			categoryPostIDs = append(categoryPostIDs, postCategory.PostID)
+		}
+
+		if tagID == nil {
+			return categoryPostIDs, nil
+		}
+		postIDs = intersectStrings(postIDs, categoryPostIDs)
+	}
+
+	return postIDs, nil
+}
+
+// stringValues 将 ListValue 转换为字符串列表，忽略非字符串的元素.
+func stringValues(list *structpb.ListValue) []string {
+	ret := make([]string, 0, len(list.GetValues()))
+	for _, value := range list.GetValues() {
+		if s, ok := value.GetKind().(*structpb.Value_StringValue); ok {
+			ret = append(ret, s.StringValue)
+		}
+	}
+	return ret
+}
+
+// uniqueStrings 去掉字符串首尾的空白，并按原有顺序去重，空字符串会被忽略.
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	ret := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if _, ok := seen[value]; ok || value == "" {
+			continue
+		}
+		seen[value] = struct{}{}
+		ret = append(ret, value)
+	}
+	return ret
+}
+
+// intersectStrings 返回同时出现在 a 和 b 中的字符串.
+func intersectStrings(a, b []string) []string {
+	set := make(map[string]struct{}, len(b))
+	for _, value := range b {
+		set[value] = struct{}{}
+	}
+
+	ret := make([]string, 0, len(a))
+	for _, value := range a {
+		if _, ok := set[value]; ok {
+			ret = append(ret, value)
+		}
+	}
+	return ret
+}
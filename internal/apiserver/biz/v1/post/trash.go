@@ -0,0 +1,120 @@
+package post
+
+import (
+	"context"
+	"time"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// ListTrash 实现 PostExpansion 接口中的 ListTrash 方法，按删除顺序倒序列出当前用户回收站中的文章.
+func (b *postBiz) ListTrash(ctx context.Context, rq *apiv1.ListTrashPostRequest) (*apiv1.ListTrashPostResponse, error) {
+	whr := where.F("userID", contextx.UserID(ctx)).O(int(rq.Offset)).L(int(rq.Limit))
+	count, postList, err := b.store.Post().ListDeleted(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, post := range postList {
+		posts = append(posts, conversion.PostodelToPostV1(post))
+	}
+	if err := b.fillTerms(ctx, posts...); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListTrashPostResponse{TotalCount: count, Posts: posts}, nil
+}
+
+// Restore 实现 PostExpansion 接口中的 Restore 方法，将回收站中的文章恢复到删除前的状态.
+func (b *postBiz) Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error) {
+	whr := where.F("userID", contextx.UserID(ctx), "postID", rq.PostID)
+	count, _, err := b.store.Post().ListDeleted(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, errorx.ErrPostNotFound
+	}
+
+	if err := b.store.Post().Restore(ctx, whr); err != nil {
+		return nil, err
+	}
+	postM, err := b.store.Post().Get(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	b.syncIndex(ctx, postM)
+
+	return &apiv1.RestorePostResponse{}, nil
+}
+
+// Purge 实现 PostExpansion 接口中的 Purge 方法，彻底删除当前用户回收站中的文章，不在回收站中的文章会被忽略.
+func (b *postBiz) Purge(ctx context.Context, rq *apiv1.PurgePostRequest) (*apiv1.PurgePostResponse, error) {
+	_, postList, err := b.store.Post().ListDeleted(ctx, where.F("userID", contextx.UserID(ctx), "postID", rq.PostIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+	if err := b.purge(ctx, postIDs); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PurgePostResponse{}, nil
+}
+
+// PurgeTrash 实现 PostExpansion 接口中的 PurgeTrash 方法，
+// 彻底删除在回收站中超过保留时长的文章，返回本次删除的文章数量.
+func (b *postBiz) PurgeTrash(ctx context.Context) (int64, error) {
+	if b.trashRetention <= 0 {
+		return 0, nil
+	}
+
+	expired := clause.Lte{Column: clause.Column{Name: "deletedAt"}, Value: time.Now().Add(-b.trashRetention)}
+	_, postList, err := b.store.Post().ListDeleted(ctx, where.C(expired))
+	if err != nil {
+		return 0, err
+	}
+
+	postIDs := make([]string, 0, len(postList))
+	for _, post := range postList {
+		postIDs = append(postIDs, post.PostID)
+	}
+	if err := b.purge(ctx, postIDs); err != nil {
+		return 0, err
+	}
+
+	return int64(len(postIDs)), nil
+}
+
+// purge 彻底删除文章，同时删除其历史版本、评论以及与标签、分类的关联.
+func (b *postBiz) purge(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	return b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Post().Purge(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.PostRevision().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.PostTag().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		if err := b.store.PostCategory().Delete(ctx, where.F("postID", postIDs)); err != nil {
+			return err
+		}
+		return b.store.Comment().Delete(ctx, where.F("postID", postIDs))
+	})
+}
@@ -0,0 +1,168 @@
+package tag
+
+import (
+	"context"
+	"sort"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// TagBiz 定义处理标签请求所需的方法.
+type TagBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateTagRequest) (*apiv1.CreateTagResponse, error)
+	Update(ctx context.Context, rq *apiv1.UpdateTagRequest) (*apiv1.UpdateTagResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteTagRequest) (*apiv1.DeleteTagResponse, error)
+	Get(ctx context.Context, rq *apiv1.GetTagRequest) (*apiv1.GetTagResponse, error)
+	List(ctx context.Context, rq *apiv1.ListTagRequest) (*apiv1.ListTagResponse, error)
+
+	TagExpansion
+}
+
+// TagExpansion 定义额外的标签操作方法.
+type TagExpansion interface {
+	Cloud(ctx context.Context, rq *apiv1.GetTagCloudRequest) (*apiv1.GetTagCloudResponse, error)
+}
+
+// tagBiz 是 TagBiz 接口的实现.
+type tagBiz struct {
+	store store.IStore
+}
+
+// 确保 tagBiz 实现了 TagBiz 接口.
+var _ TagBiz = (*tagBiz)(nil)
+
+// New 创建 tagBiz 的实例.
+func New(store store.IStore) *tagBiz {
+	return &tagBiz{store: store}
+}
+
+// Create 实现 TagBiz 接口中的 Create 方法，同一用户下标签名称不能重复.
+func (b *tagBiz) Create(ctx context.Context, rq *apiv1.CreateTagRequest) (*apiv1.CreateTagResponse, error) {
+	if err := b.checkNameAvailable(ctx, rq.Name); err != nil {
+		return nil, err
+	}
+
+	tagM := model.Tag{UserID: contextx.UserID(ctx), Name: rq.Name}
+	if err := b.store.Tag().Create(ctx, &tagM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreateTagResponse{TagID: tagM.TagID}, nil
+}
+
+// Update 实现 TagBiz 接口中的 Update 方法.
+func (b *tagBiz) Update(ctx context.Context, rq *apiv1.UpdateTagRequest) (*apiv1.UpdateTagResponse, error) {
+	tagM, err := b.store.Tag().Get(ctx, where.F("userID", contextx.UserID(ctx), "tagID", rq.TagID))
+	if err != nil {
+		return nil, err
+	}
+
+	if rq.Name != nil && *rq.Name != tagM.Name {
+		if err := b.checkNameAvailable(ctx, *rq.Name); err != nil {
+			return nil, err
+		}
+		tagM.Name = *rq.Name
+	}
+
+	if err := b.store.Tag().Update(ctx, tagM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateTagResponse{}, nil
+}
+
+// Delete 实现 TagBiz 接口中的 Delete 方法，在同一个事务中解除标签与文章的关联.
+func (b *tagBiz) Delete(ctx context.Context, rq *apiv1.DeleteTagRequest) (*apiv1.DeleteTagResponse, error) {
+	tagM, err := b.store.Tag().Get(ctx, where.F("userID", contextx.UserID(ctx), "tagID", rq.TagID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.PostTag().Delete(ctx, where.F("tagID", tagM.TagID)); err != nil {
+			return err
+		}
+		return b.store.Tag().Delete(ctx, where.F("tagID", tagM.TagID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeleteTagResponse{}, nil
+}
+
+// Get 实现 TagBiz 接口中的 Get 方法.
+func (b *tagBiz) Get(ctx context.Context, rq *apiv1.GetTagRequest) (*apiv1.GetTagResponse, error) {
+	tagM, err := b.store.Tag().Get(ctx, where.F("userID", contextx.UserID(ctx), "tagID", rq.TagID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetTagResponse{Tag: conversion.TagodelToTagV1(tagM)}, nil
+}
+
+// List 实现 TagBiz 接口中的 List 方法.
+func (b *tagBiz) List(ctx context.Context, rq *apiv1.ListTagRequest) (*apiv1.ListTagResponse, error) {
+	whr := where.F("userID", contextx.UserID(ctx)).O(int(rq.Offset)).L(int(rq.Limit))
+	count, tagList, err := b.store.Tag().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*apiv1.Tag, 0, len(tagList))
+	for _, tag := range tagList {
+		tags = append(tags, conversion.TagodelToTagV1(tag))
+	}
+
+	return &apiv1.ListTagResponse{TotalCount: count, Tags: tags}, nil
+}
+
+// Cloud 实现 TagExpansion 接口中的 Cloud 方法，返回当前用户每个标签下的文章数量，按文章数量从多到少排列.
+func (b *tagBiz) Cloud(ctx context.Context, rq *apiv1.GetTagCloudRequest) (*apiv1.GetTagCloudResponse, error) {
+	_, tagList, err := b.store.Tag().List(ctx, where.F("userID", contextx.UserID(ctx)))
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := make([]string, 0, len(tagList))
+	for _, tag := range tagList {
+		tagIDs = append(tagIDs, tag.TagID)
+	}
+
+	counts, err := b.store.PostTag().CountPosts(ctx, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*apiv1.TagCloudItem, 0, len(tagList))
+	for _, tag := range tagList {
+		items = append(items, &apiv1.TagCloudItem{TagID: tag.TagID, Name: tag.Name, PostCount: counts[tag.TagID]})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].PostCount != items[j].PostCount {
+			return items[i].PostCount > items[j].PostCount
+		}
+		return items[i].Name < items[j].Name
+	})
+
+	return &apiv1.GetTagCloudResponse{Items: items}, nil
+}
+
+// checkNameAvailable 检查当前用户下是否已存在同名标签.
+func (b *tagBiz) checkNameAvailable(ctx context.Context, name string) error {
+	count, _, err := b.store.Tag().List(ctx, where.F("userID", contextx.UserID(ctx), "name", name))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errorx.ErrTagAlreadyExists
+	}
+
+	return nil
+}
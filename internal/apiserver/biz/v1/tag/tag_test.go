@@ -0,0 +1,89 @@
+package tag_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tagv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/tag"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/store/where"
+)
+
+// createPost 创建一篇关联了 tagIDs 的文章，返回文章 ID.
+func createPost(t *testing.T, s store.IStore, tagIDs ...string) string {
+	t.Helper()
+
+	postM := &model.Post{UserID: "user-1", Title: "title", Content: "content"}
+	require.NoError(t, s.Post().Create(context.Background(), postM))
+	for _, tagID := range tagIDs {
+		require.NoError(t, s.PostTag().Create(context.Background(), &model.PostTag{PostID: postM.PostID, TagID: tagID}))
+	}
+	return postM.PostID
+}
+
+func TestTagBizCloud(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	s := store.NewMemoryStore()
+	b := tagv1.New(s)
+
+	tagIDs := make(map[string]string)
+	for _, name := range []string{"go", "db", "rust", "api"} {
+		created, err := b.Create(ctx, &apiv1.CreateTagRequest{Name: name})
+		require.NoError(t, err)
+		tagIDs[name] = created.TagID
+	}
+	_, err := b.Create(contextx.WithUserID(context.Background(), "user-2"), &apiv1.CreateTagRequest{Name: "go"})
+	require.NoError(t, err)
+
+	createPost(t, s, tagIDs["go"], tagIDs["db"])
+	createPost(t, s, tagIDs["go"])
+	deleted := createPost(t, s, tagIDs["go"], tagIDs["rust"])
+	createPost(t, s, tagIDs["api"])
+	require.NoError(t, s.Post().Delete(context.Background(), where.F("postID", deleted)))
+
+	// 回收站中的文章不计数，文章数量相同时按名称排列，只返回当前用户的标签
+	cloud, err := b.Cloud(ctx, &apiv1.GetTagCloudRequest{})
+	require.NoError(t, err)
+	items := make([]string, 0, len(cloud.Items))
+	counts := make([]int64, 0, len(cloud.Items))
+	for _, item := range cloud.Items {
+		items = append(items, item.Name)
+		counts = append(counts, item.PostCount)
+	}
+	assert.Equal(t, []string{"go", "api", "db", "rust"}, items)
+	assert.Equal(t, []int64{2, 1, 1, 0}, counts)
+}
+
+func TestTagBizDelete(t *testing.T) {
+	ctx := contextx.WithUserID(context.Background(), "user-1")
+	s := store.NewMemoryStore()
+	b := tagv1.New(s)
+
+	created, err := b.Create(ctx, &apiv1.CreateTagRequest{Name: "go"})
+	require.NoError(t, err)
+	_, err = b.Create(ctx, &apiv1.CreateTagRequest{Name: "go"})
+	assert.ErrorIs(t, err, errorx.ErrTagAlreadyExists)
+	postID := createPost(t, s, created.TagID)
+
+	// 其他用户不能删除该标签
+	_, err = b.Delete(contextx.WithUserID(context.Background(), "user-2"), &apiv1.DeleteTagRequest{TagID: created.TagID})
+	assert.ErrorIs(t, err, errorx.ErrTagNotFound)
+
+	// 删除标签时解除其与文章的关联，文章本身保留
+	_, err = b.Delete(ctx, &apiv1.DeleteTagRequest{TagID: created.TagID})
+	require.NoError(t, err)
+	_, err = b.Get(ctx, &apiv1.GetTagRequest{TagID: created.TagID})
+	assert.ErrorIs(t, err, errorx.ErrTagNotFound)
+	count, _, err := s.PostTag().List(context.Background(), where.F("postID", postID))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	_, err = s.Post().Get(context.Background(), where.F("postID", postID))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// exportBatchSize 是导出用户数据时每次从存储层读取的博文数量.
+const exportBatchSize = 500
+
+// Archive 是导出的用户数据文件，博文在写入时才分批从存储层读取，避免一次性加载所有博文.
+type Archive struct {
+	// ContentType 是文件的 MIME 类型
+	ContentType string
+	// Filename 是下载时建议使用的文件名
+	Filename string
+
+	write func(w io.Writer) error
+}
+
+// Write 将文件内容写入 w.
+func (a *Archive) Write(w io.Writer) error {
+	return a.write(w)
+}
+
+// Export 实现 UserExpansion 接口中的 Export 方法，导出用户的个人资料和所有博文.
+func (b *userBiz) Export(ctx context.Context, rq *apiv1.ExportUserDataRequest) (*Archive, error) {
+	if err := b.checkAccess(ctx, rq.UserID); err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+	user := conversion.UserodelToUserV1(userM)
+
+	name := fmt.Sprintf("%s-%s", userM.UserID, time.Now().Format("20060102150405"))
+	if rq.GetFormat() == known.ExportFormatJSON {
+		return &Archive{
+			ContentType: "application/json",
+			Filename:    name + ".json",
+			write: func(w io.Writer) error {
+				return b.writeJSON(ctx, w, user)
+			},
+		}, nil
+	}
+
+	return &Archive{
+		ContentType: "application/zip",
+		Filename:    name + ".zip",
+		write: func(w io.Writer) error {
+			return b.writeZIP(ctx, w, user)
+		},
+	}, nil
+}
+
+// writeZIP 将个人资料写入 profile.json，每篇博文写入 posts/<postID>.json.
+func (b *userBiz) writeZIP(ctx context.Context, w io.Writer, user *apiv1.User) error {
+	zw := zip.NewWriter(w)
+	modified := time.Now()
+	writeFile := func(name string, m proto.Message) error {
+		data, err := protojson.MarshalOptions{Multiline: true}.Marshal(m)
+		if err != nil {
+			return err
+		}
+		f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(data)
+		return err
+	}
+
+	if err := writeFile("profile.json", user); err != nil {
+		return err
+	}
+	err := b.eachPost(ctx, user.UserID, func(post *model.Post) error {
+		return writeFile("posts/"+post.PostID+".json", conversion.PostodelToPostV1(post))
+	})
+	if err != nil {
+		return err
+	}
+
+	return zw.Close()
+}
+
+// writeJSON 将个人资料和所有博文写入同一个 JSON 对象，博文逐篇写入.
+func (b *userBiz) writeJSON(ctx context.Context, w io.Writer, user *apiv1.User) error {
+	profile, err := protojson.Marshal(user)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, `{"exportedAt":%q,"user":%s,"posts":[`, time.Now().Format(time.RFC3339), profile); err != nil {
+		return err
+	}
+
+	sep := ""
+	err = b.eachPost(ctx, user.UserID, func(post *model.Post) error {
+		data, err := protojson.Marshal(conversion.PostodelToPostV1(post))
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s%s", sep, data); err != nil {
+			return err
+		}
+		sep = ","
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}")
+	return err
+}
+
+// eachPost 按 ID 倒序分批读取用户的所有博文，并依次调用 fn.
+func (b *userBiz) eachPost(ctx context.Context, userID string, fn func(post *model.Post) error) error {
+	for offset := 0; ; offset += exportBatchSize {
+		_, postList, err := b.store.Post().List(ctx, where.F("userID", userID).O(offset).L(exportBatchSize))
+		if err != nil {
+			return err
+		}
+		for _, post := range postList {
+			if err := fn(post); err != nil {
+				return err
+			}
+		}
+		if len(postList) < exportBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"github.com/loveRyujin/fast_blog/pkg/totp"
+)
+
+// recoveryCodeCount 是每次生成的恢复码数量.
+const recoveryCodeCount = 10
+
+// LoginTOTP 实现 UserExpansion 接口中的 LoginTOTP 方法，校验挑战令牌和验证码后签发令牌.
+func (b *userBiz) LoginTOTP(ctx context.Context, rq *apiv1.LoginTOTPRequest) (*apiv1.LoginResponse, error) {
+	userID, err := b.tokens.ParseChallenge(rq.ChallengeToken)
+	if err != nil {
+		return nil, errorx.ErrChallengeInvalid
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		if errors.Is(err, errorx.ErrUserNotFound) {
+			return nil, errorx.ErrChallengeInvalid
+		}
+		return nil, err
+	}
+
+	// 签发挑战令牌之后用户可能被禁用或停用了两步验证
+	if userM.Disabled {
+		return nil, errorx.ErrUserDisabled
+	}
+	totpM, err := b.enabledTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if totpM == nil {
+		return nil, errorx.ErrChallengeInvalid
+	}
+
+	if err := b.verifyCode(ctx, userM, totpM, rq.Code, true); err != nil {
+		return nil, err
+	}
+
+	return b.loginSucceeded(ctx, userM)
+}
+
+// EnrollTOTP 实现 UserExpansion 接口中的 EnrollTOTP 方法，生成新的 TOTP 密钥.
+// 密钥在 ConfirmTOTP 校验验证码之后才会启用，重复绑定时替换尚未启用的密钥.
+func (b *userBiz) EnrollTOTP(ctx context.Context, rq *apiv1.EnrollTOTPRequest) (*apiv1.EnrollTOTPResponse, error) {
+	if rq.UserID != contextx.UserID(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	totpM, err := b.store.UserTOTP().Get(ctx, where.F("userID", rq.UserID))
+	switch {
+	case errors.Is(err, errorx.ErrTOTPNotEnrolled):
+		err = b.store.UserTOTP().Create(ctx, &model.UserTOTP{UserID: rq.UserID, Secret: secret})
+	case err != nil:
+	case totpM.Enabled:
+		err = errorx.ErrTOTPAlreadyEnabled
+	default:
+		totpM.Secret, totpM.LastUsedStep = secret, 0
+		err = b.store.UserTOTP().Update(ctx, totpM)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.EnrollTOTPResponse{Secret: secret, Uri: totp.URI(b.opts.TOTPIssuer, userM.Username, secret)}, nil
+}
+
+// ConfirmTOTP 实现 UserExpansion 接口中的 ConfirmTOTP 方法，校验验证码后启用两步验证并生成恢复码.
+func (b *userBiz) ConfirmTOTP(ctx context.Context, rq *apiv1.ConfirmTOTPRequest) (*apiv1.ConfirmTOTPResponse, error) {
+	if rq.UserID != contextx.UserID(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	totpM, err := b.store.UserTOTP().Get(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if totpM.Enabled {
+		return nil, errorx.ErrTOTPAlreadyEnabled
+	}
+
+	// 确认时只接受验证码，证明验证器应用已经正确保存了密钥
+	if err := b.verifyCode(ctx, userM, totpM, rq.Code, false); err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		// 重新查询，保留校验验证码时记录的时间步
+		totpM, err := b.store.UserTOTP().Get(ctx, where.F("userID", rq.UserID))
+		if err != nil {
+			return err
+		}
+		totpM.Enabled = true
+		if err := b.store.UserTOTP().Update(ctx, totpM); err != nil {
+			return err
+		}
+
+		codes, err = b.replaceRecoveryCodes(ctx, rq.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ConfirmTOTPResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP 实现 UserExpansion 接口中的 DisableTOTP 方法，删除 TOTP 密钥和所有恢复码.
+// 用户停用自己的两步验证时需要验证码或恢复码，管理员可以直接停用丢失设备的用户的两步验证.
+func (b *userBiz) DisableTOTP(ctx context.Context, rq *apiv1.DisableTOTPRequest) (*apiv1.DisableTOTPResponse, error) {
+	if err := b.checkAccess(ctx, rq.UserID); err != nil {
+		return nil, err
+	}
+
+	totpM, err := b.store.UserTOTP().Get(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	if rq.UserID == contextx.UserID(ctx) && totpM.Enabled {
+		userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
+		if err != nil {
+			return nil, err
+		}
+		if err := b.verifyCode(ctx, userM, totpM, rq.Code, true); err != nil {
+			return nil, err
+		}
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.UserTOTP().Delete(ctx, where.F("userID", rq.UserID)); err != nil {
+			return err
+		}
+		return b.store.RecoveryCode().Delete(ctx, where.F("userID", rq.UserID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.With(ctx).Infow("Two-factor authentication disabled", "userID", rq.UserID, "operator", contextx.UserID(ctx))
+	return &apiv1.DisableTOTPResponse{}, nil
+}
+
+// RegenerateRecoveryCodes 实现 UserExpansion 接口中的 RegenerateRecoveryCodes 方法，原有的恢复码全部失效.
+func (b *userBiz) RegenerateRecoveryCodes(ctx context.Context, rq *apiv1.RegenerateRecoveryCodesRequest) (*apiv1.RegenerateRecoveryCodesResponse, error) {
+	if rq.UserID != contextx.UserID(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	totpM, err := b.enabledTOTP(ctx, rq.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if totpM == nil {
+		return nil, errorx.ErrTOTPNotEnrolled
+	}
+
+	if err := b.verifyCode(ctx, userM, totpM, rq.Code, true); err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		codes, err = b.replaceRecoveryCodes(ctx, rq.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RegenerateRecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// enabledTOTP 返回用户已启用的两步验证，用户没有启用两步验证时返回 nil.
+func (b *userBiz) enabledTOTP(ctx context.Context, userID string) (*model.UserTOTP, error) {
+	totpM, err := b.store.UserTOTP().Get(ctx, where.F("userID", userID))
+	if errors.Is(err, errorx.ErrTOTPNotEnrolled) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !totpM.Enabled {
+		return nil, nil
+	}
+	return totpM, nil
+}
+
+// verifyCode 校验验证码，allowRecovery 为 true 时也接受未使用的恢复码.
+// 校验失败计入用户名和客户端 IP 的登录失败次数，与密码共用锁定策略，避免暴力猜测 6 位验证码.
+func (b *userBiz) verifyCode(ctx context.Context, userM *model.User, totpM *model.UserTOTP, code string, allowRecovery bool) error {
+	wait, err := b.guard.Check(ctx, userM.Username, contextx.ClientIP(ctx))
+	if err != nil {
+		log.With(ctx).Errorw("Failed to check login lockout", "err", err)
+		return errorx.ErrInternal
+	}
+	if wait > 0 {
+		return tooManyLoginAttempts(wait)
+	}
+
+	var ok bool
+	switch {
+	case totp.IsCode(code):
+		ok, err = b.useCode(ctx, totpM, code)
+	case allowRecovery:
+		ok, err = b.useRecoveryCode(ctx, totpM.UserID, code)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return b.loginFailed(ctx, userM.Username, userM.UserID, loginReasonInvalidTOTP, errorx.ErrTOTPCodeInvalid)
+	}
+	return nil
+}
+
+// useCode 校验验证码并记录其时间步，已经使用过的验证码不能再次使用.
+func (b *userBiz) useCode(ctx context.Context, totpM *model.UserTOTP, code string) (bool, error) {
+	step, ok := totp.Validate(totpM.Secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+	return b.store.UserTOTP().UseStep(ctx, totpM.ID, step)
+}
+
+// useRecoveryCode 校验恢复码并将其标记为已使用.
+func (b *userBiz) useRecoveryCode(ctx context.Context, userID, code string) (bool, error) {
+	codeM, err := b.store.RecoveryCode().Get(ctx, where.F("userID", userID, "codeHash", totp.HashRecoveryCode(code)))
+	if errors.Is(err, errorx.ErrTOTPCodeInvalid) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	used, err := b.store.RecoveryCode().MarkUsed(ctx, codeM.ID, time.Now())
+	if used {
+		log.With(ctx).Infow("Recovery code used", "userID", userID)
+	}
+	return used, err
+}
+
+// replaceRecoveryCodes 删除用户原有的恢复码并生成新的恢复码，需要在事务中调用.
+func (b *userBiz) replaceRecoveryCodes(ctx context.Context, userID string) ([]string, error) {
+	if err := b.store.RecoveryCode().Delete(ctx, where.F("userID", userID)); err != nil {
+		return nil, err
+	}
+
+	codes, err := totp.NewRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	for _, code := range codes {
+		if err := b.store.RecoveryCode().Create(ctx, &model.RecoveryCode{UserID: userID, CodeHash: totp.HashRecoveryCode(code)}); err != nil {
+			return nil, err
+		}
+	}
+	return codes, nil
+}
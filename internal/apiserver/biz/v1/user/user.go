@@ -2,19 +2,29 @@ package user
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/conversion"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/query"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
 	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
 	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
 	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	"github.com/loveRyujin/fast_blog/internal/pkg/lockout"
 	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	"github.com/onexstack/onexstack/pkg/authz"
 	"github.com/onexstack/onexstack/pkg/store/where"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm/clause"
 
 	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
 	"github.com/loveRyujin/fast_blog/pkg/auth"
@@ -35,64 +45,324 @@ type UserBiz interface {
 // UserExpansion 定义用户操作的扩展方法.
 type UserExpansion interface {
 	Login(ctx context.Context, rq *apiv1.LoginRequest) (*apiv1.LoginResponse, error)
+	LoginTOTP(ctx context.Context, rq *apiv1.LoginTOTPRequest) (*apiv1.LoginResponse, error)
+	EnrollTOTP(ctx context.Context, rq *apiv1.EnrollTOTPRequest) (*apiv1.EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, rq *apiv1.ConfirmTOTPRequest) (*apiv1.ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, rq *apiv1.DisableTOTPRequest) (*apiv1.DisableTOTPResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, rq *apiv1.RegenerateRecoveryCodesRequest) (*apiv1.RegenerateRecoveryCodesResponse, error)
 	RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error)
+	Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error)
 	ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error)
+	Export(ctx context.Context, rq *apiv1.ExportUserDataRequest) (*Archive, error)
+	PurgeDeleted(ctx context.Context) (int64, error)
+	PurgeExpiredTokens(ctx context.Context) error
+	PurgeLoginAudits(ctx context.Context) error
+	ListTrash(ctx context.Context, rq *apiv1.ListTrashUserRequest) (*apiv1.ListTrashUserResponse, error)
+	Restore(ctx context.Context, rq *apiv1.RestoreUserRequest) (*apiv1.RestoreUserResponse, error)
+	Purge(ctx context.Context, rq *apiv1.PurgeUserRequest) (*apiv1.PurgeUserResponse, error)
 }
 
 // userBiz 是 UserBiz 接口的实现.
 type userBiz struct {
-	store store.IStore
+	store    store.IStore
+	authz    *authz.Authz
+	searcher search.Searcher
+	// tokens 用于签发访问令牌
+	tokens *token.Manager
+	// guard 用于限制登录失败次数
+	guard *lockout.LoginGuard
+	opts  Options
+}
+
+// Options 包含用户业务的配置项.
+type Options struct {
+	// TOTPIssuer 是两步验证的签发者，显示在验证器应用中
+	TOTPIssuer string
+	// DeletionGracePeriod 是用户注销的冷静期，为 0 时立即删除用户数据
+	DeletionGracePeriod time.Duration
+	// RefreshTokenExpiration 是刷新令牌的有效期
+	RefreshTokenExpiration time.Duration
+	// LoginAuditRetention 是登录失败审计记录的保留时长，为 0 时永久保留
+	LoginAuditRetention time.Duration
 }
 
 // 确保 userBiz 实现了 UserBiz 接口.
 var _ UserBiz = (*userBiz)(nil)
 
-func New(store store.IStore) *userBiz {
-	return &userBiz{store: store}
+func New(store store.IStore, authz *authz.Authz, searcher search.Searcher, tokens *token.Manager, guard *lockout.LoginGuard, opts Options) *userBiz {
+	return &userBiz{
+		store:    store,
+		authz:    authz,
+		searcher: searcher,
+		tokens:   tokens,
+		guard:    guard,
+		opts:     opts,
+	}
 }
 
+// 登录失败的原因，记录在登录审计中.
+const (
+	loginReasonUserNotFound    = "user_not_found"
+	loginReasonInvalidPassword = "invalid_password"
+	loginReasonUserDisabled    = "user_disabled"
+	loginReasonInvalidTOTP     = "invalid_totp"
+)
+
+// maxUserAgentLength 是登录审计中 User-Agent 的最大长度，与数据库字段长度一致.
+const maxUserAgentLength = 512
+
+// dummyPasswordHash 用于用户不存在时执行一次相同代价的密码比较，避免通过响应时间枚举用户名.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := auth.Encrypt(uuid.New().String())
+	return hash
+})
+
 // Login 实现 UserExpansion 接口中的 Login 方法.
+// 用户不存在和密码错误返回相同的错误，同一用户名或客户端 IP 失败次数过多时临时锁定.
 func (b *userBiz) Login(ctx context.Context, rq *apiv1.LoginRequest) (*apiv1.LoginResponse, error) {
+	ip := contextx.ClientIP(ctx)
+
+	// 锁定期间不校验密码，避免继续尝试
+	wait, err := b.guard.Check(ctx, rq.Username, ip)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to check login lockout", "err", err)
+		return nil, errorx.ErrInternal
+	}
+	if wait > 0 {
+		return nil, tooManyLoginAttempts(wait)
+	}
+
 	// 通过用户名获取用户信息
 	userM, err := b.store.User().Get(ctx, where.F("username", rq.Username))
 	if err != nil {
-		return nil, errorx.ErrUserNotFound
+		if !errors.Is(err, errorx.ErrUserNotFound) {
+			return nil, err
+		}
+		_ = auth.Compare(dummyPasswordHash(), rq.Password)
+		return nil, b.loginFailed(ctx, rq.Username, "", loginReasonUserNotFound, errorx.ErrInvalidCredentials)
 	}
 
 	// 比较密码是否正确
 	if err := auth.Compare(userM.Password, rq.Password); err != nil {
-		return nil, errorx.ErrPasswordInvalid
+		return nil, b.loginFailed(ctx, rq.Username, userM.UserID, loginReasonInvalidPassword, errorx.ErrInvalidCredentials)
+	}
+
+	// 被禁用的用户不允许登录，密码正确时不计入失败次数
+	if userM.Disabled {
+		b.audit(ctx, rq.Username, userM.UserID, loginReasonUserDisabled)
+		return nil, errorx.ErrUserDisabled
 	}
 
-	// 登录成功，签发token并返回
-	token, expireAt, err := token.Sign(userM.UserID)
+	// 启用了两步验证时只返回挑战令牌，验证码校验通过后才签发令牌.
+	// 此时不清除用户名的失败计数，避免知道密码的攻击者穿插密码登录来重置猜测验证码的失败次数
+	totpM, err := b.enabledTOTP(ctx, userM.UserID)
 	if err != nil {
-		return nil, errorx.ErrSignToken
+		return nil, err
+	}
+	if totpM != nil {
+		challenge, expireAt, err := b.tokens.SignChallenge(userM.UserID)
+		if err != nil {
+			return nil, errorx.ErrSignToken.WithMessage(err.Error())
+		}
+		return &apiv1.LoginResponse{
+			TotpRequired:      true,
+			ChallengeToken:    challenge,
+			ChallengeExpireAt: timestamppb.New(expireAt),
+		}, nil
+	}
+
+	return b.loginSucceeded(ctx, userM)
+}
+
+// loginSucceeded 清除用户名的失败计数，创建新的令牌家族并签发访问令牌和刷新令牌.
+func (b *userBiz) loginSucceeded(ctx context.Context, userM *model.User) (*apiv1.LoginResponse, error) {
+	if err := b.guard.Succeed(ctx, userM.Username); err != nil {
+		log.With(ctx).Warnw("Failed to reset login failures", "username", userM.Username, "err", err)
 	}
 
-	return &apiv1.LoginResponse{Token: token, ExpireAt: timestamppb.New(expireAt)}, nil
+	pair, err := b.issueTokens(ctx, userM.UserID, uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.LoginResponse{
+		Token:                pair.token,
+		ExpireAt:             timestamppb.New(pair.expireAt),
+		RefreshToken:         pair.refreshToken,
+		RefreshTokenExpireAt: timestamppb.New(pair.refreshTokenExpireAt),
+	}, nil
+}
+
+// loginFailed 记录一次登录失败，失败次数达到阈值时返回锁定错误，否则返回 failure.
+func (b *userBiz) loginFailed(ctx context.Context, username, userID, reason string, failure error) error {
+	b.audit(ctx, username, userID, reason)
+
+	wait, err := b.guard.Fail(ctx, username, contextx.ClientIP(ctx))
+	if err != nil {
+		log.With(ctx).Errorw("Failed to record login failure", "err", err)
+		return errorx.ErrInternal
+	}
+	if wait > 0 {
+		return tooManyLoginAttempts(wait)
+	}
+	return failure
+}
+
+// audit 保存登录失败的审计记录，保存失败时只记录日志，不影响登录结果.
+func (b *userBiz) audit(ctx context.Context, username, userID, reason string) {
+	ip := contextx.ClientIP(ctx)
+	log.With(ctx).Warnw("Login failed", "username", username, "ip", ip, "reason", reason)
+
+	userAgent := contextx.UserAgent(ctx)
+	if len(userAgent) > maxUserAgentLength {
+		userAgent = userAgent[:maxUserAgentLength]
+	}
+	auditM := &model.LoginAudit{
+		Username:  username,
+		UserID:    userID,
+		IP:        ip,
+		UserAgent: userAgent,
+		Reason:    reason,
+	}
+	if err := b.store.LoginAudit().Create(ctx, auditM); err != nil {
+		log.With(ctx).Errorw("Failed to create login audit", "err", err)
+	}
+}
+
+// tooManyLoginAttempts 返回带有剩余锁定时长的锁定错误.
+func tooManyLoginAttempts(wait time.Duration) error {
+	wait = max(wait.Round(time.Second), time.Second)
+	return errorx.New(errorx.ErrTooManyLoginAttempts.Code, errorx.ErrTooManyLoginAttempts.Reason,
+		fmt.Sprintf("Too many failed login attempts, please try again in %s", wait))
 }
 
 // RefreshToken 实现 UserExpansion 接口中的 RefreshToken 方法.
+// 刷新令牌只能使用一次，使用后轮换为同一家族中的新令牌；已轮换的令牌被再次使用时说明令牌可能已经泄露，撤销整个家族.
 func (b *userBiz) RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error) {
-	// 刷新 token
-	token, expireAt, err := token.Sign(contextx.UserID(ctx))
+	tokenM, err := b.store.RefreshToken().Get(ctx, where.F("tokenHash", token.HashRefreshToken(rq.RefreshToken)))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if tokenM.RevokedAt != nil || !now.Before(tokenM.ExpiresAt) {
+		return nil, errorx.ErrRefreshTokenInvalid
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", tokenM.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	// 被禁用的用户不允许刷新 token
+	if userM.Disabled {
+		return nil, errorx.ErrUserDisabled
+	}
+
+	var pair *tokenPair
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		// 并发使用同一个令牌时只有一个请求能够标记成功
+		marked, err := b.store.RefreshToken().MarkUsed(ctx, tokenM.ID, now)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			return errorx.ErrRefreshTokenReused
+		}
+
+		pair, err = b.issueTokens(ctx, tokenM.UserID, tokenM.FamilyID)
+		return err
+	})
+	if errors.Is(err, errorx.ErrRefreshTokenReused) {
+		log.With(ctx).Warnw("Refresh token reused, revoking token family", "userID", tokenM.UserID, "familyID", tokenM.FamilyID)
+		if err := b.store.RefreshToken().Revoke(ctx, where.F("familyID", tokenM.FamilyID), now); err != nil {
+			return nil, err
+		}
+		return nil, errorx.ErrRefreshTokenReused
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RefreshTokenResponse{
+		Token:                pair.token,
+		ExpireAt:             timestamppb.New(pair.expireAt),
+		RefreshToken:         pair.refreshToken,
+		RefreshTokenExpireAt: timestamppb.New(pair.refreshTokenExpireAt),
+	}, nil
+}
+
+// Logout 实现 UserExpansion 接口中的 Logout 方法，撤销当前访问令牌所属的令牌家族.
+// 撤销后该家族的刷新令牌不能再使用，Authn 中间件也会拒绝该家族签发的访问令牌.
+func (b *userBiz) Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error) {
+	if err := b.store.RefreshToken().Revoke(ctx, where.F("familyID", contextx.SessionID(ctx)), time.Now()); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.LogoutResponse{}, nil
+}
+
+// tokenPair 是一次签发的访问令牌和刷新令牌.
+type tokenPair struct {
+	token                string
+	expireAt             time.Time
+	refreshToken         string
+	refreshTokenExpireAt time.Time
+}
+
+// issueTokens 在令牌家族 familyID 中签发新的访问令牌和刷新令牌，令牌家族 ID 同时作为访问令牌的会话 ID.
+func (b *userBiz) issueTokens(ctx context.Context, userID, familyID string) (*tokenPair, error) {
+	// 访问令牌中带上用户当前的角色，供其他服务使用
+	roles, err := b.authz.GetRolesForUser(userID)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to get roles for user", "user", userID, "err", err)
+		return nil, errorx.ErrSignToken.WithMessage(err.Error())
+	}
+
+	accessToken, expireAt, err := b.tokens.Sign(userID, familyID, roles)
 	if err != nil {
 		return nil, errorx.ErrSignToken.WithMessage(err.Error())
 	}
 
-	return &apiv1.RefreshTokenResponse{Token: token, ExpireAt: timestamppb.New(expireAt)}, nil
+	refreshToken, tokenHash, err := token.NewRefreshToken()
+	if err != nil {
+		return nil, errorx.ErrSignToken.WithMessage(err.Error())
+	}
+
+	tokenM := &model.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(b.opts.RefreshTokenExpiration),
+	}
+	if err := b.store.RefreshToken().Create(ctx, tokenM); err != nil {
+		return nil, err
+	}
+
+	return &tokenPair{
+		token:                accessToken,
+		expireAt:             expireAt,
+		refreshToken:         refreshToken,
+		refreshTokenExpireAt: tokenM.ExpiresAt,
+	}, nil
 }
 
 // ChangePassword 实现UserExpansion 接口中的ChangePassword 方法.
 func (b *userBiz) ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error) {
-	userM, err := b.store.User().Get(ctx, where.F("userID", contextx.UserID(ctx)))
+	if err := b.checkAccess(ctx, rq.UserID); err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
 	if err != nil {
 		return nil, err
 	}
 
-	if err := auth.Compare(userM.Password, rq.OldPassword); err != nil {
-		return nil, errorx.ErrPasswordInvalid
+	// 管理员重置其他用户的密码时，无需校验旧密码
+	if rq.UserID == contextx.UserID(ctx) {
+		if err := auth.Compare(userM.Password, rq.OldPassword); err != nil {
+			return nil, errorx.ErrPasswordInvalid
+		}
 	}
 
 	userM.Password, err = auth.Encrypt(rq.NewPassword)
@@ -104,6 +374,15 @@ func (b *userBiz) ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRe
 		return nil, err
 	}
 
+	// 修改密码后撤销用户的其它会话，用户修改自己的密码时保留当前会话
+	whr := where.F("userID", rq.UserID)
+	if rq.UserID == contextx.UserID(ctx) {
+		whr = whr.C(clause.Neq{Column: clause.Column{Name: "familyID"}, Value: contextx.SessionID(ctx)})
+	}
+	if err := b.store.RefreshToken().Revoke(ctx, whr, time.Now()); err != nil {
+		return nil, err
+	}
+
 	return &apiv1.ChangePasswordResponse{}, nil
 }
 
@@ -122,48 +401,310 @@ func (b *userBiz) Create(ctx context.Context, rq *apiv1.CreateUserRequest) (*api
 		return nil, err
 	}
 
+	// 新注册的用户默认拥有普通用户角色
+	if _, err := b.authz.AddGroupingPolicy(userM.UserID, known.RoleUser); err != nil {
+		log.With(ctx).Errorw("Failed to add grouping policy for user", "user", userM.UserID, "role", known.RoleUser, "err", err)
+		return nil, errorx.ErrAddRole.WithMessage(err.Error())
+	}
+
 	return &apiv1.CreateUserResponse{UserID: userM.UserID}, nil
 }
 
 // Update 实现 UserBiz 接口中的 Update 方法.
 func (b *userBiz) Update(ctx context.Context, rq *apiv1.UpdateUserRequest) (*apiv1.UpdateUserResponse, error) {
-	userM, err := b.store.User().Get(ctx, where.F("userID", contextx.UserID(ctx)))
+	if err := b.checkAccess(ctx, rq.UserID); err != nil {
+		return nil, err
+	}
+
+	// 只有管理员可以禁用或启用用户
+	if rq.Disabled != nil && !b.isAdmin(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
 	if err != nil {
 		return nil, err
 	}
+	// 请求带有 If-Match 时，只有用户未被其他请求修改才允许更新
+	if ifMatch := contextx.IfMatch(ctx); ifMatch != "" && !conditional.Match(ifMatch, conditional.ETag(userM.UpdatedAt)) {
+		return nil, errorx.ErrPreconditionFailed
+	}
 
+	// 只更新请求中的字段，并且只有用户在读取之后未被修改时才更新，避免覆盖并发请求的修改
+	values := map[string]any{"updatedAt": conditional.NextModified(userM.UpdatedAt)}
 	if rq.Username != nil {
-		userM.Username = *rq.Username
+		values["username"] = *rq.Username
 	}
 	if rq.Email != nil {
-		userM.Email = *rq.Email
+		values["email"] = *rq.Email
 	}
 	if rq.Nickname != nil {
-		userM.Nickname = *rq.Nickname
+		values["nickname"] = *rq.Nickname
 	}
 	if rq.Phone != nil {
-		userM.Phone = *rq.Phone
+		values["phone"] = *rq.Phone
+	}
+	if rq.Disabled != nil {
+		userM.Disabled = *rq.Disabled
+		values["disabled"] = userM.Disabled
 	}
 
-	if err := b.store.User().Update(ctx, userM); err != nil {
+	n, err := b.store.User().Updates(ctx, where.F("userID", userM.UserID, "updatedAt", userM.UpdatedAt), values)
+	if err != nil {
 		return nil, err
 	}
+	if n == 0 {
+		return nil, errorx.ErrPreconditionFailed
+	}
+
+	// 禁用用户时撤销其所有会话，已签发的访问令牌立即失效
+	if userM.Disabled {
+		if err := b.store.RefreshToken().Revoke(ctx, where.F("userID", userM.UserID), time.Now()); err != nil {
+			return nil, err
+		}
+	}
 
 	return &apiv1.UpdateUserResponse{}, nil
 }
 
 // Delete 实现 UserBiz 接口中的 Delete 方法.
+// 设置了注销冷静期时只将用户标记为已注销，冷静期结束后由后台任务彻底删除，否则立即删除用户及其拥有的所有资源.
 func (b *userBiz) Delete(ctx context.Context, rq *apiv1.DeleteUserRequest) (*apiv1.DeleteUserResponse, error) {
-	if err := b.store.User().Delete(ctx, where.F("userID", contextx.UserID(ctx))); err != nil {
+	if err := b.checkAccess(ctx, rq.UserID); err != nil {
+		return nil, err
+	}
+
+	if b.opts.DeletionGracePeriod > 0 {
+		// 已注销的用户无法登录，冷静期内保留其数据和角色，但在同一个事务中撤销其所有会话
+		err := b.store.TX(ctx, func(ctx context.Context) error {
+			// 锁定用户后再标记注销，用户不存在或已经注销时返回 ErrUserNotFound
+			whr := where.F("userID", rq.UserID).C(clause.Locking{Strength: clause.LockingStrengthUpdate})
+			if _, err := b.store.User().Get(ctx, whr); err != nil {
+				return err
+			}
+			if err := b.store.User().Delete(ctx, where.F("userID", rq.UserID)); err != nil {
+				return err
+			}
+			return b.store.RefreshToken().Revoke(ctx, where.F("userID", rq.UserID), time.Now())
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &apiv1.DeleteUserResponse{PurgeAt: timestamppb.New(time.Now().Add(b.opts.DeletionGracePeriod))}, nil
+	}
+
+	if err := b.purge(ctx, rq.UserID); err != nil {
 		return nil, err
 	}
 
 	return &apiv1.DeleteUserResponse{}, nil
 }
 
+// PurgeDeleted 实现 UserExpansion 接口中的 PurgeDeleted 方法，彻底删除注销冷静期已结束的用户，返回删除的用户数量.
+func (b *userBiz) PurgeDeleted(ctx context.Context) (int64, error) {
+	whr := where.C(clause.Lte{Column: clause.Column{Name: "deletedAt"}, Value: time.Now().Add(-b.opts.DeletionGracePeriod)})
+	_, userList, err := b.store.User().ListDeleted(ctx, whr)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, user := range userList {
+		if err := b.purge(ctx, user.UserID); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// PurgeExpiredTokens 实现 UserExpansion 接口中的 PurgeExpiredTokens 方法，删除已过期的刷新令牌.
+// 刷新令牌的有效期大于访问令牌，删除过期的刷新令牌时其家族签发的访问令牌也已经过期，不影响撤销检查.
+func (b *userBiz) PurgeExpiredTokens(ctx context.Context) error {
+	return b.store.RefreshToken().Delete(ctx, where.C(clause.Lt{Column: clause.Column{Name: "expiresAt"}, Value: time.Now()}))
+}
+
+// PurgeLoginAudits 实现 UserExpansion 接口中的 PurgeLoginAudits 方法，删除超过保留时长的登录失败审计记录.
+func (b *userBiz) PurgeLoginAudits(ctx context.Context) error {
+	if b.opts.LoginAuditRetention <= 0 {
+		return nil
+	}
+	return b.store.LoginAudit().Delete(ctx, where.C(clause.Lt{Column: clause.Column{Name: "createdAt"}, Value: time.Now().Add(-b.opts.LoginAuditRetention)}))
+}
+
+// ListTrash 实现 UserExpansion 接口中的 ListTrash 方法，管理员可以查看已注销、等待彻底删除的用户.
+func (b *userBiz) ListTrash(ctx context.Context, rq *apiv1.ListTrashUserRequest) (*apiv1.ListTrashUserResponse, error) {
+	if !b.isAdmin(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	count, userList, err := b.store.User().ListDeleted(ctx, where.O(int(rq.Offset)).L(int(rq.Limit)))
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*apiv1.User, 0, len(userList))
+	for _, user := range userList {
+		users = append(users, conversion.UserodelToUserV1(user))
+	}
+
+	return &apiv1.ListTrashUserResponse{TotalCount: count, Users: users}, nil
+}
+
+// Restore 实现 UserExpansion 接口中的 Restore 方法，管理员可以在彻底删除之前恢复已注销的用户.
+func (b *userBiz) Restore(ctx context.Context, rq *apiv1.RestoreUserRequest) (*apiv1.RestoreUserResponse, error) {
+	if !b.isAdmin(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	whr := where.F("userID", rq.UserID)
+	count, _, err := b.store.User().ListDeleted(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, errorx.ErrUserNotFound
+	}
+
+	if err := b.store.User().Restore(ctx, whr); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RestoreUserResponse{}, nil
+}
+
+// Purge 实现 UserExpansion 接口中的 Purge 方法，管理员可以不等冷静期结束，立即彻底删除已注销的用户.
+func (b *userBiz) Purge(ctx context.Context, rq *apiv1.PurgeUserRequest) (*apiv1.PurgeUserResponse, error) {
+	if !b.isAdmin(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	count, _, err := b.store.User().ListDeleted(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, errorx.ErrUserNotFound
+	}
+
+	if err := b.purge(ctx, rq.UserID); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PurgeUserResponse{}, nil
+}
+
+// purge 彻底删除用户及其拥有的所有资源，并匿名化用户在其他人博文下发表的评论.
+func (b *userBiz) purge(ctx context.Context, userID string) error {
+	// 在同一个事务中删除用户及其文章、标签和分类，任一步骤失败时全部回滚
+	var postIDs []string
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		var err error
+		if postIDs, err = b.deletePosts(ctx, userID); err != nil {
+			return err
+		}
+		if err := b.anonymizeComments(ctx, userID); err != nil {
+			return err
+		}
+		if err := b.store.Tag().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+		if err := b.store.Category().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+		if err := b.store.RefreshToken().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+		if err := b.store.UserTOTP().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+		if err := b.store.RecoveryCode().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+		return b.store.User().Purge(ctx, where.F("userID", userID))
+	})
+	if err != nil {
+		return err
+	}
+	if len(postIDs) > 0 {
+		if err := b.searcher.Delete(ctx, postIDs...); err != nil {
+			log.With(ctx).Errorw("Failed to remove posts from search index", "postIDs", postIDs, "err", err)
+		}
+	}
+
+	// 移除用户的角色，避免残留的授权策略
+	if _, err := b.authz.RemoveFilteredGroupingPolicy(0, userID); err != nil {
+		log.With(ctx).Errorw("Failed to remove grouping policy for user", "user", userID, "err", err)
+		return errorx.ErrRemoveRole.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// anonymizeComments 清空用户在其他人博文下发表的评论的作者，保留评论内容以免破坏回复关系，需要在事务中调用.
+func (b *userBiz) anonymizeComments(ctx context.Context, userID string) error {
+	_, commentList, err := b.store.Comment().List(ctx, where.F("userID", userID))
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range commentList {
+		comment.UserID = ""
+		if err := b.store.Comment().Update(ctx, comment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deletePosts 彻底删除用户的所有文章（包括回收站中的文章）及其历史版本、评论以及与标签、分类的关联，
+// 返回被删除的文章 ID，需要在事务中调用.
+func (b *userBiz) deletePosts(ctx context.Context, userID string) ([]string, error) {
+	_, postList, err := b.store.Post().List(ctx, where.F("userID", userID))
+	if err != nil {
+		return nil, err
+	}
+	_, deletedList, err := b.store.Post().ListDeleted(ctx, where.F("userID", userID))
+	if err != nil {
+		return nil, err
+	}
+	if len(postList)+len(deletedList) == 0 {
+		return nil, nil
+	}
+
+	postIDs := make([]string, 0, len(postList)+len(deletedList))
+	for _, post := range append(postList, deletedList...) {
+		postIDs = append(postIDs, post.PostID)
+	}
+
+	if err := b.store.Post().Purge(ctx, where.F("postID", postIDs)); err != nil {
+		return nil, err
+	}
+	if err := b.store.PostRevision().Delete(ctx, where.F("postID", postIDs)); err != nil {
+		return nil, err
+	}
+	if err := b.store.PostTag().Delete(ctx, where.F("postID", postIDs)); err != nil {
+		return nil, err
+	}
+	if err := b.store.PostCategory().Delete(ctx, where.F("postID", postIDs)); err != nil {
+		return nil, err
+	}
+	if err := b.store.Comment().Delete(ctx, where.F("postID", postIDs)); err != nil {
+		return nil, err
+	}
+
+	return postIDs, nil
+}
+
 // Get 实现 UserBiz 接口中的 Get 方法.
 func (b *userBiz) Get(ctx context.Context, rq *apiv1.GetUserRequest) (*apiv1.GetUserResponse, error) {
-	userM, err := b.store.User().Get(ctx, where.F("userID", contextx.UserID(ctx)))
+	if err := b.checkAccess(ctx, rq.UserID); err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
 	if err != nil {
 		return nil, err
 	}
@@ -172,9 +713,28 @@ func (b *userBiz) Get(ctx context.Context, rq *apiv1.GetUserRequest) (*apiv1.Get
 }
 
 // List 实现 UserBiz 接口中的 List 方法.
+// 请求中设置了 page_token 时按 (createdAt, id) 倒序使用键集分页，否则按 ID 倒序使用偏移量分页.
 func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.ListUserResponse, error) {
-	whr := where.P(int(rq.Offset), int(rq.Limit))
-	count, userList, err := b.store.User().List(ctx, whr)
+	// 只有管理员可以查看所有用户
+	if !b.isAdmin(ctx) {
+		return nil, errorx.ErrPermissionDenied
+	}
+
+	whr, err := listWhere(where.NewWhere(), rq)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		count         int64
+		userList      []*model.User
+		nextPageToken string
+	)
+	if rq.PageToken != nil {
+		count, userList, nextPageToken, err = b.seek(ctx, whr, rq.GetPageToken(), rq.Limit, rq.WithTotalCount)
+	} else {
+		count, userList, err = b.store.User().List(ctx, whr.P(int(rq.Offset), int(rq.Limit)))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +752,7 @@ func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.L
 			case <-ctx.Done():
 				return nil
 			default:
-				count, _, err := b.store.Post().List(ctx, where.F("userID", contextx.UserID(ctx)))
+				count, _, err := b.store.Post().List(ctx, where.F("userID", user.UserID))
 				if err != nil {
 					return err
 				}
@@ -219,5 +779,107 @@ func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.L
 
 	log.With(ctx).Debugw("Get users from backend storage", "count", len(users))
 
-	return &apiv1.ListUserResponse{TotalCount: count, Users: users}, nil
+	return &apiv1.ListUserResponse{TotalCount: count, Users: users, NextPageToken: nextPageToken}, nil
+}
+
+// userFilterFields 是用户列表过滤表达式中允许使用的字段.
+var userFilterFields = query.Fields{
+	"username":  {Column: "username", Type: query.String},
+	"nickname":  {Column: "nickname", Type: query.String},
+	"email":     {Column: "email", Type: query.String},
+	"phone":     {Column: "phone", Type: query.String},
+	"disabled":  {Column: "disabled", Type: query.Bool},
+	"createdAt": {Column: "createdAt", Type: query.Time},
+	"updatedAt": {Column: "updatedAt", Type: query.Time},
+}
+
+// userOrderFields 是用户列表允许排序的字段.
+var userOrderFields = query.Fields{
+	"createdAt": {Column: "createdAt"},
+	"updatedAt": {Column: "updatedAt"},
+	"username":  {Column: "username"},
+}
+
+// listWhere 在 whr 中追加请求的创建时间范围、过滤表达式和排序方式.
+func listWhere(whr *where.Options, rq *apiv1.ListUserRequest) (*where.Options, error) {
+	if rq.CreatedAfter != nil {
+		whr = whr.C(clause.Gte{Column: clause.Column{Name: "createdAt"}, Value: rq.CreatedAfter.AsTime()})
+	}
+	if rq.CreatedBefore != nil {
+		whr = whr.C(clause.Lt{Column: clause.Column{Name: "createdAt"}, Value: rq.CreatedBefore.AsTime()})
+	}
+
+	expr, err := query.ParseFilter(rq.Filter, userFilterFields)
+	if err != nil {
+		return nil, errorx.ErrInvalidArugment.WithMessage(err.Error())
+	}
+	if expr != nil {
+		whr = whr.C(expr)
+	}
+
+	columns, err := query.ParseOrderBy(rq.OrderBy, userOrderFields)
+	if err != nil {
+		return nil, errorx.ErrInvalidArugment.WithMessage(err.Error())
+	}
+	if len(columns) > 0 {
+		whr = whr.C(clause.OrderBy{Columns: columns})
+	}
+	return whr, nil
+}
+
+// seek 使用键集分页查询 pageToken 之后的 limit 个用户，多查询一个用于判断是否还有下一页.
+// withCount 为 false 时不统计总数，返回的总数为 0.
+func (b *userBiz) seek(ctx context.Context, whr *where.Options, pageToken string, limit int64, withCount bool) (int64, []*model.User, string, error) {
+	var after *store.Cursor
+	if pageToken != "" {
+		cursor, err := store.ParseCursor(pageToken)
+		if err != nil {
+			return 0, nil, "", errorx.ErrInvalidArugment.WithMessage(err.Error())
+		}
+		after = cursor
+	}
+	if limit <= 0 {
+		limit = known.DefaultPageSize
+	}
+
+	var count int64
+	if withCount {
+		var err error
+		if count, err = b.store.User().Count(ctx, whr); err != nil {
+			return 0, nil, "", err
+		}
+	}
+
+	userList, err := b.store.User().Seek(ctx, whr.L(int(limit)+1), after)
+	if err != nil {
+		return 0, nil, "", err
+	}
+
+	var nextPageToken string
+	if len(userList) > int(limit) {
+		userList = userList[:limit]
+		last := userList[len(userList)-1]
+		nextPageToken = (&store.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}).Encode()
+	}
+
+	return count, userList, nextPageToken, nil
+}
+
+// isAdmin 判断当前请求的用户是否拥有管理员角色.
+func (b *userBiz) isAdmin(ctx context.Context) bool {
+	isAdmin, err := b.authz.HasRoleForUser(contextx.UserID(ctx), known.RoleAdmin)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to check admin role", "err", err)
+		return false
+	}
+	return isAdmin
+}
+
+// checkAccess 校验当前请求的用户是否可以操作 userID 对应的用户：普通用户只能操作自己，管理员可以操作任意用户.
+func (b *userBiz) checkAccess(ctx context.Context, userID string) error {
+	if userID == contextx.UserID(ctx) || b.isAdmin(ctx) {
+		return nil
+	}
+
+	return errorx.ErrPermissionDenied
 }
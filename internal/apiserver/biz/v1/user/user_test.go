@@ -0,0 +1,304 @@
+package user_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/authz"
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userv1 "github.com/loveRyujin/fast_blog/internal/apiserver/biz/v1/user"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/migrations"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	"github.com/loveRyujin/fast_blog/internal/pkg/lockout"
+	"github.com/loveRyujin/fast_blog/internal/pkg/migrate"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+	"github.com/loveRyujin/fast_blog/pkg/token"
+	"github.com/loveRyujin/fast_blog/pkg/totp"
+)
+
+// testEnv 包含基于内存存储的 UserBiz 及其依赖，授权策略来自 SQLite 的数据库迁移，与 store: memory 模式一致.
+type testEnv struct {
+	t      *testing.T
+	biz    userv1.UserBiz
+	store  store.IStore
+	authz  *authz.Authz
+	tokens *token.Manager
+	// users 是已创建的用户数，用于生成不重复的手机号
+	users int
+}
+
+// newTestEnv 使用默认的登录锁定配置创建 testEnv.
+func newTestEnv(t *testing.T, opts userv1.Options) *testEnv {
+	return newTestEnvWithLockout(t, opts, genericoptions.NewLockoutOptions())
+}
+
+func newTestEnvWithLockout(t *testing.T, opts userv1.Options, lockoutOptions *genericoptions.LockoutOptions) *testEnv {
+	t.Helper()
+
+	db, err := genericoptions.NewSQLiteOptions().NewDB()
+	require.NoError(t, err)
+	fsys, err := migrations.FS(genericoptions.DBTypeSQLite)
+	require.NoError(t, err)
+	m, err := migrate.New(db, fsys)
+	require.NoError(t, err)
+	_, err = m.Up(context.Background(), 0)
+	require.NoError(t, err)
+
+	a, err := authz.NewAuthz(db)
+	require.NoError(t, err)
+	t.Cleanup(a.StopAutoLoadPolicy)
+
+	searcher, err := search.New(&genericoptions.SearchOptions{Engine: genericoptions.SearchEngineBleve}, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = searcher.Close() })
+
+	tokens, err := token.NewManager(token.WithHMACKey("test-jwt-key-with-at-least-32-characters"))
+	require.NoError(t, err)
+
+	guard, err := lockout.New(lockoutOptions, genericoptions.NewRedisOptions())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = guard.Close() })
+
+	if opts.RefreshTokenExpiration == 0 {
+		opts.RefreshTokenExpiration = time.Hour
+	}
+	s := store.NewMemoryStore()
+	return &testEnv{t: t, biz: userv1.New(s, a, searcher, tokens, guard, opts), store: s, authz: a, tokens: tokens}
+}
+
+// createUser 创建用户并返回用户 ID，用户的密码为 password123.
+func (e *testEnv) createUser(username string) string {
+	e.t.Helper()
+
+	e.users++
+	rs, err := e.biz.Create(context.Background(), &apiv1.CreateUserRequest{
+		Username: username,
+		Password: "password123",
+		Email:    username + "@example.com",
+		Phone:    fmt.Sprintf("138%08d", e.users),
+	})
+	require.NoError(e.t, err)
+	return rs.UserID
+}
+
+// createAdmin 创建用户并授予管理员角色，返回该用户的请求上下文.
+func (e *testEnv) createAdmin(username string) context.Context {
+	e.t.Helper()
+
+	userID := e.createUser(username)
+	_, err := e.authz.AddGroupingPolicy(userID, known.RoleAdmin)
+	require.NoError(e.t, err)
+	return contextx.WithUserID(context.Background(), userID)
+}
+
+func TestUserListTrashOffset(t *testing.T) {
+	e := newTestEnv(t, userv1.Options{DeletionGracePeriod: time.Hour})
+	ctx := e.createAdmin("admin1")
+
+	userIDs := make([]string, 0, 3)
+	for _, username := range []string{"user1", "user2", "user3"} {
+		userID := e.createUser(username)
+		_, err := e.biz.Delete(ctx, &apiv1.DeleteUserRequest{UserID: userID})
+		require.NoError(t, err)
+		userIDs = append(userIDs, userID)
+	}
+
+	// 回收站按注销顺序倒序返回，offset 表示跳过的记录数
+	rs, err := e.biz.ListTrash(ctx, &apiv1.ListTrashUserRequest{Offset: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, rs.TotalCount)
+	require.Len(t, rs.Users, 1)
+	assert.Equal(t, userIDs[1], rs.Users[0].UserID)
+
+	rs, err = e.biz.ListTrash(ctx, &apiv1.ListTrashUserRequest{Offset: 0, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, rs.Users, 2)
+	assert.Equal(t, userIDs[2], rs.Users[0].UserID)
+}
+
+// login 使用密码登录，返回登录响应.
+func (e *testEnv) login(username string) *apiv1.LoginResponse {
+	e.t.Helper()
+
+	rs, err := e.biz.Login(context.Background(), &apiv1.LoginRequest{Username: username, Password: "password123"})
+	require.NoError(e.t, err)
+	return rs
+}
+
+func TestUserDeleteWithGracePeriod(t *testing.T) {
+	e := newTestEnv(t, userv1.Options{DeletionGracePeriod: time.Hour})
+	adminCtx := e.createAdmin("admin1")
+	alice := e.createUser("alice")
+	login := e.login("alice")
+
+	// 注销后进入冷静期，同时撤销用户的所有会话
+	ctx := contextx.WithUserID(context.Background(), alice)
+	rs, err := e.biz.Delete(ctx, &apiv1.DeleteUserRequest{UserID: alice})
+	require.NoError(t, err)
+	require.NotNil(t, rs.PurgeAt)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), rs.PurgeAt.AsTime(), time.Minute)
+	_, err = e.biz.RefreshToken(context.Background(), &apiv1.RefreshTokenRequest{RefreshToken: login.RefreshToken})
+	assert.ErrorIs(t, err, errorx.ErrRefreshTokenInvalid)
+
+	// 已经注销或不存在的用户不能再次注销
+	_, err = e.biz.Delete(ctx, &apiv1.DeleteUserRequest{UserID: alice})
+	assert.ErrorIs(t, err, errorx.ErrUserNotFound)
+	_, err = e.biz.Delete(adminCtx, &apiv1.DeleteUserRequest{UserID: "user-unknown"})
+	assert.ErrorIs(t, err, errorx.ErrUserNotFound)
+
+	trash, err := e.biz.ListTrash(adminCtx, &apiv1.ListTrashUserRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, trash.TotalCount)
+}
+
+func TestUserRefreshTokenRotation(t *testing.T) {
+	e := newTestEnv(t, userv1.Options{})
+	e.createUser("alice")
+	login := e.login("alice")
+
+	// 每次刷新都签发新的刷新令牌，旧令牌随即失效
+	rotated, err := e.biz.RefreshToken(context.Background(), &apiv1.RefreshTokenRequest{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+	assert.NotEqual(t, login.RefreshToken, rotated.RefreshToken)
+	latest, err := e.biz.RefreshToken(context.Background(), &apiv1.RefreshTokenRequest{RefreshToken: rotated.RefreshToken})
+	require.NoError(t, err)
+
+	tokenM, err := e.store.RefreshToken().Get(context.Background(), where.F("tokenHash", token.HashRefreshToken(latest.RefreshToken)))
+	require.NoError(t, err)
+	revoked, err := e.store.RefreshToken().Revoked(context.Background(), tokenM.FamilyID)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	// 重复使用已轮换的令牌视为令牌泄露，撤销整个令牌家族，最新的令牌也不能再使用
+	_, err = e.biz.RefreshToken(context.Background(), &apiv1.RefreshTokenRequest{RefreshToken: login.RefreshToken})
+	assert.ErrorIs(t, err, errorx.ErrRefreshTokenReused)
+	_, err = e.biz.RefreshToken(context.Background(), &apiv1.RefreshTokenRequest{RefreshToken: latest.RefreshToken})
+	assert.ErrorIs(t, err, errorx.ErrRefreshTokenInvalid)
+	revoked, err = e.store.RefreshToken().Revoked(context.Background(), tokenM.FamilyID)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	// 其他会话不受影响
+	other := e.login("alice")
+	_, err = e.biz.RefreshToken(context.Background(), &apiv1.RefreshTokenRequest{RefreshToken: other.RefreshToken})
+	assert.NoError(t, err)
+}
+
+// assertLocked 断言 err 是登录锁定错误.
+func assertLocked(t *testing.T, err error) {
+	t.Helper()
+
+	require.Error(t, err)
+	assert.Equal(t, errorx.ErrTooManyLoginAttempts.Reason, errorx.FromError(err).Reason)
+}
+
+func TestUserLoginLockout(t *testing.T) {
+	e := newTestEnvWithLockout(t, userv1.Options{}, &genericoptions.LockoutOptions{
+		Store:           genericoptions.LockoutStoreMemory,
+		MaxUserFailures: 3,
+		Window:          time.Minute,
+		BaseDuration:    300 * time.Millisecond,
+		MaxDuration:     time.Second,
+	})
+	e.createUser("alice")
+	login := func(username, password string) error {
+		_, err := e.biz.Login(context.Background(), &apiv1.LoginRequest{Username: username, Password: password})
+		return err
+	}
+
+	// 用户不存在和密码错误返回相同的错误，避免泄露用户名是否存在
+	assert.ErrorIs(t, login("nobody", "password123"), errorx.ErrInvalidCredentials)
+	assert.ErrorIs(t, login("alice", "wrong-password"), errorx.ErrInvalidCredentials)
+	assert.ErrorIs(t, login("alice", "wrong-password"), errorx.ErrInvalidCredentials)
+
+	// 失败次数达到阈值后锁定用户名，锁定期间正确的密码也不能登录
+	assertLocked(t, login("alice", "wrong-password"))
+	assertLocked(t, login("alice", "password123"))
+
+	// 解除锁定后再次失败，锁定时长翻倍
+	time.Sleep(350 * time.Millisecond)
+	assertLocked(t, login("alice", "wrong-password"))
+	time.Sleep(350 * time.Millisecond)
+	assertLocked(t, login("alice", "password123"))
+	time.Sleep(300 * time.Millisecond)
+	require.NoError(t, login("alice", "password123"))
+
+	// 登录成功后清除失败计数
+	assert.ErrorIs(t, login("alice", "wrong-password"), errorx.ErrInvalidCredentials)
+
+	// 每次失败都保存审计记录，锁定期间的请求不校验密码也不记录
+	count, audits, err := e.store.LoginAudit().List(context.Background(), where.F("username", "alice"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, count)
+	for _, audit := range audits {
+		assert.Equal(t, "invalid_password", audit.Reason)
+	}
+	count, _, err = e.store.LoginAudit().List(context.Background(), where.F("username", "nobody", "reason", "user_not_found"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+}
+
+func TestUserLoginTOTP(t *testing.T) {
+	e := newTestEnv(t, userv1.Options{TOTPIssuer: "fast_blog"})
+	alice := e.createUser("alice")
+	ctx := contextx.WithUserID(context.Background(), alice)
+
+	enrolled, err := e.biz.EnrollTOTP(ctx, &apiv1.EnrollTOTPRequest{UserID: alice})
+	require.NoError(t, err)
+	step := totp.Step(time.Now())
+	code := func(step int64) string {
+		code, err := totp.Code(enrolled.Secret, step)
+		require.NoError(t, err)
+		return code
+	}
+
+	// 绑定后未确认之前仍然只需要密码
+	assert.NotEmpty(t, e.login("alice").Token)
+	confirmed, err := e.biz.ConfirmTOTP(ctx, &apiv1.ConfirmTOTPRequest{UserID: alice, Code: code(step)})
+	require.NoError(t, err)
+	require.NotEmpty(t, confirmed.RecoveryCodes)
+
+	// 启用两步验证后密码登录只返回挑战令牌
+	challenge := e.login("alice")
+	assert.True(t, challenge.TotpRequired)
+	assert.Empty(t, challenge.Token)
+	assert.Empty(t, challenge.RefreshToken)
+	require.NotEmpty(t, challenge.ChallengeToken)
+	loginTOTP := func(challengeToken, code string) (*apiv1.LoginResponse, error) {
+		return e.biz.LoginTOTP(context.Background(), &apiv1.LoginTOTPRequest{ChallengeToken: challengeToken, Code: code})
+	}
+
+	_, err = loginTOTP("invalid", code(step+1))
+	assert.ErrorIs(t, err, errorx.ErrChallengeInvalid)
+
+	// 同一个时间步的验证码只能使用一次，早于已使用时间步的验证码也被拒绝
+	_, err = loginTOTP(challenge.ChallengeToken, code(step))
+	assert.ErrorIs(t, err, errorx.ErrTOTPCodeInvalid)
+	rs, err := loginTOTP(challenge.ChallengeToken, code(step+1))
+	require.NoError(t, err)
+	assert.NotEmpty(t, rs.Token)
+	assert.NotEmpty(t, rs.RefreshToken)
+	_, err = loginTOTP(challenge.ChallengeToken, code(step+1))
+	assert.ErrorIs(t, err, errorx.ErrTOTPCodeInvalid)
+	_, err = loginTOTP(challenge.ChallengeToken, code(step-1))
+	assert.ErrorIs(t, err, errorx.ErrTOTPCodeInvalid)
+
+	// 恢复码可以代替验证码登录，但只能使用一次
+	rs, err = loginTOTP(challenge.ChallengeToken, confirmed.RecoveryCodes[0])
+	require.NoError(t, err)
+	assert.NotEmpty(t, rs.Token)
+	_, err = loginTOTP(challenge.ChallengeToken, confirmed.RecoveryCodes[0])
+	assert.ErrorIs(t, err, errorx.ErrTOTPCodeInvalid)
+	_, err = loginTOTP(challenge.ChallengeToken, confirmed.RecoveryCodes[1])
+	assert.NoError(t, err)
+}
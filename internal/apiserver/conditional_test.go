@@ -0,0 +1,55 @@
+package apiserver
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// assertIfMatchUpdate 使用读取到的 ETag 连续更新资源两次，第二次使用的 ETag 已经过期，应该返回 412.
+func assertIfMatchUpdate(t *testing.T, client *testHTTPClient, path, token string, first, second any) {
+	t.Helper()
+
+	etag := client.request(http.MethodGet, path, token, nil, nil).Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	ifMatch := http.Header{"If-Match": []string{etag}}
+	require.Equal(t, http.StatusOK, client.request(http.MethodPut, path, token, ifMatch, first).Code)
+	assert.Equal(t, http.StatusPreconditionFailed, client.request(http.MethodPut, path, token, ifMatch, second).Code)
+
+	// 每次更新后 ETag 都会变化，即使两次更新发生在同一秒内
+	updated := client.request(http.MethodGet, path, token, nil, nil).Header().Get("ETag")
+	assert.NotEqual(t, etag, updated)
+	assert.Equal(t, http.StatusOK, client.request(http.MethodPut, path, token, http.Header{"If-Match": []string{updated}}, second).Code)
+}
+
+func TestUpdateUserIfMatch(t *testing.T) {
+	client := newTestHTTPClient(t, newTestServerConfig(t, 0))
+	alice, token := client.signup("alice", "13800000001")
+
+	assertIfMatchUpdate(t, client, "/v1/users/"+alice, token, map[string]any{"nickname": "first"}, map[string]any{"nickname": "second"})
+
+	var got apiv1.GetUserResponse
+	require.Equal(t, http.StatusOK, client.do(http.MethodGet, "/v1/users/"+alice, token, nil, &got))
+	assert.Equal(t, "second", got.User.Nickname)
+}
+
+func TestUpdatePostIfMatch(t *testing.T) {
+	client := newTestHTTPClient(t, newTestServerConfig(t, 0))
+	_, token := client.signup("alice", "13800000001")
+
+	var created apiv1.CreatePostResponse
+	require.Equal(t, http.StatusOK, client.do(http.MethodPost, "/v1/posts", token, map[string]any{"title": "hello", "content": "v0"}, &created))
+	path := "/v1/posts/" + created.PostID
+
+	assertIfMatchUpdate(t, client, path, token, map[string]any{"content": "v1"}, map[string]any{"content": "v2"})
+
+	// 被拒绝的更新不会记录历史版本
+	var revisions apiv1.ListPostRevisionResponse
+	require.Equal(t, http.StatusOK, client.do(http.MethodGet, path+"/revisions", token, nil, &revisions))
+	assert.EqualValues(t, 2, revisions.TotalCount)
+}
@@ -2,6 +2,7 @@ package apiserver
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	grpchandler "github.com/loveRyujin/fast_blog/internal/apiserver/handler/grpc"
@@ -19,27 +20,19 @@ type GRPCServer struct {
 var _ server.Server = (*GRPCServer)(nil)
 
 // NewGRPCServerOr 启动一个GRPC服务或者GRPC-GATEWAY服务
-func (cfg *Config) NewGRPCServerOr() (*GRPCServer, error) {
-	grpcServerOptions := []grpc.ServerOption{
-		grpc.ChainUnaryInterceptor(
-			// 请求 ID 拦截器
-			mw.RequestIDInterceptor(),
-			// 模拟认证拦截器
-			mw.AuthnBypasswInterceptor(),
-		),
-	}
+func (c *ServerConfig) NewGRPCServerOr() (*GRPCServer, error) {
 	grpcsrv, err := server.NewGRPCServerOr(
-		cfg.GRPCOptions,
-		grpcServerOptions,
+		c.cfg.GRPCOptions,
+		c.grpcServerOptions(),
 		func(sr grpc.ServiceRegistrar) {
-			apiv1.RegisterFastBlogServer(sr, grpchandler.NewHandler())
+			apiv1.RegisterFastBlogServer(sr, grpchandler.NewHandler(c.biz, c.val))
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if cfg.ServerMode == GRPCServerMode {
+	if c.cfg.ServerMode == GRPCServerMode {
 		return &GRPCServer{
 			srv: grpcsrv,
 			stop: func(ctx context.Context) {
@@ -52,9 +45,15 @@ func (cfg *Config) NewGRPCServerOr() (*GRPCServer, error) {
 	go grpcsrv.Run()
 
 	httpsrv, err := server.NewGRPCGatewayServer(
-		cfg.HTTPOptions,
-		cfg.GRPCOptions,
+		c.cfg.HTTPOptions,
+		c.cfg.GRPCOptions,
 		func(mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+			// JWKS 不经过 gRPC 服务，直接由网关返回
+			if err := mux.HandlePath(http.MethodGet, jwksPath, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+				c.serveJWKS(w, r)
+			}); err != nil {
+				return err
+			}
 			return apiv1.RegisterFastBlogHandler(context.Background(), mux, conn)
 		},
 	)
@@ -71,6 +70,42 @@ func (cfg *Config) NewGRPCServerOr() (*GRPCServer, error) {
 	}, nil
 }
 
+// grpcServerOptions 返回 gRPC 服务使用的拦截器，依次进行认证和授权.
+func (c *ServerConfig) grpcServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			// 请求 ID 拦截器
+			mw.RequestIDInterceptor(),
+			// 认证拦截器
+			mw.AuthnInterceptor(c.tokens, c.revoker, c.cfg.authnWhitelist()...),
+			// 授权拦截器
+			mw.AuthzInterceptor(c.authz, c.cfg.authnWhitelist()...),
+		),
+		grpc.ChainStreamInterceptor(
+			// 认证拦截器
+			mw.AuthnStreamInterceptor(c.tokens, c.revoker, c.cfg.authnWhitelist()...),
+			// 授权拦截器
+			mw.AuthzStreamInterceptor(c.authz, c.cfg.authnWhitelist()...),
+		),
+	}
+}
+
+// authnWhitelist 返回无需认证即可访问的 gRPC 方法列表，可通过配置项 authn-whitelist 追加.
+func (cfg *Config) authnWhitelist() []string {
+	whitelist := []string{
+		apiv1.FastBlog_Healthz_FullMethodName,
+		apiv1.FastBlog_Login_FullMethodName,
+		apiv1.FastBlog_LoginTOTP_FullMethodName,
+		apiv1.FastBlog_RefreshToken_FullMethodName,
+		apiv1.FastBlog_CreateUser_FullMethodName,
+		apiv1.FastBlog_ListPublicPost_FullMethodName,
+		apiv1.FastBlog_GetPublicPost_FullMethodName,
+		apiv1.FastBlog_ListPublicComment_FullMethodName,
+		apiv1.FastBlog_SearchPublicPost_FullMethodName,
+	}
+	return append(whitelist, cfg.AuthnWhitelist...)
+}
+
 func (s *GRPCServer) Run() {
 	s.srv.Run()
 }
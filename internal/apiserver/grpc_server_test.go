@@ -0,0 +1,98 @@
+package apiserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+func TestUserRPCs(t *testing.T) {
+	client := newTestGRPCClient(t, newTestServerConfig(t, 0))
+	ctx := context.Background()
+
+	// 请求参数由校验层检查
+	_, err := client.CreateUser(ctx, &apiv1.CreateUserRequest{Username: "a", Password: "password123", Email: "a@example.com", Phone: "13800000001"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := client.CreateUser(ctx, &apiv1.CreateUserRequest{
+		Username: "alice", Password: "password123", Email: "alice@example.com", Phone: "13800000001",
+	})
+	require.NoError(t, err)
+	_, err = client.GetUser(ctx, &apiv1.GetUserRequest{UserID: created.UserID})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	login, err := client.Login(ctx, &apiv1.LoginRequest{Username: "alice", Password: "password123"})
+	require.NoError(t, err)
+	authed := withToken(login.Token)
+
+	nickname := "Alice"
+	_, err = client.UpdateUser(authed, &apiv1.UpdateUserRequest{UserID: created.UserID, Nickname: &nickname})
+	require.NoError(t, err)
+	got, err := client.GetUser(authed, &apiv1.GetUserRequest{UserID: created.UserID})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", got.User.Username)
+	assert.Equal(t, "Alice", got.User.Nickname)
+
+	refreshed, err := client.RefreshToken(ctx, &apiv1.RefreshTokenRequest{RefreshToken: login.RefreshToken})
+	require.NoError(t, err)
+	assert.NotEmpty(t, refreshed.Token)
+
+	// 修改密码后只能使用新密码登录
+	_, err = client.ChangePassword(authed, &apiv1.ChangePasswordRequest{UserID: created.UserID, OldPassword: "password123", NewPassword: "password456"})
+	require.NoError(t, err)
+	_, err = client.Login(ctx, &apiv1.LoginRequest{Username: "alice", Password: "password123"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = client.Login(ctx, &apiv1.LoginRequest{Username: "alice", Password: "password456"})
+	require.NoError(t, err)
+
+	_, err = client.DeleteUser(authed, &apiv1.DeleteUserRequest{UserID: created.UserID})
+	require.NoError(t, err)
+	_, err = client.Login(ctx, &apiv1.LoginRequest{Username: "alice", Password: "password456"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestPostRPCs(t *testing.T) {
+	c := newTestServerConfig(t, 0)
+	_, aliceToken := newTestHTTPClient(t, c).signup("alice", "13800000001")
+	_, bobToken := newTestHTTPClient(t, c).signup("bob1", "13800000002")
+	client := newTestGRPCClient(t, c)
+	ctx := withToken(aliceToken)
+
+	_, err := client.CreatePost(ctx, &apiv1.CreatePostRequest{Title: "hello", Content: "world", Tags: []string{" "}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	created, err := client.CreatePost(ctx, &apiv1.CreatePostRequest{Title: "hello", Content: "world"})
+	require.NoError(t, err)
+	title := "hello, grpc"
+	_, err = client.UpdatePost(ctx, &apiv1.UpdatePostRequest{PostID: created.PostID, Title: &title})
+	require.NoError(t, err)
+
+	got, err := client.GetPost(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+	require.NoError(t, err)
+	assert.Equal(t, title, got.Post.Title)
+	assert.Equal(t, "world", got.Post.Content)
+
+	list, err := client.ListPost(ctx, &apiv1.ListPostRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, list.TotalCount)
+
+	// 其他用户看不到也不能删除该文章
+	_, err = client.GetPost(withToken(bobToken), &apiv1.GetPostRequest{PostID: created.PostID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	list, err = client.ListPost(withToken(bobToken), &apiv1.ListPostRequest{Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, list.TotalCount)
+	_, err = client.DeletePost(withToken(bobToken), &apiv1.DeletePostRequest{PostIDs: []string{created.PostID}})
+	require.NoError(t, err)
+
+	_, err = client.DeletePost(ctx, &apiv1.DeletePostRequest{PostIDs: []string{created.PostID}})
+	require.NoError(t, err)
+	_, err = client.GetPost(ctx, &apiv1.GetPostRequest{PostID: created.PostID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// CreateCategory 创建分类
+func (h *Handler) CreateCategory(c *gin.Context) {
+	log.Infow("Create category function called")
+
+	core.HandleJSONRequest(c, h.biz.CategoryV1().Create, h.validator.ValidateCreateCategoryRequest)
+}
+
+// UpdateCategory 更新分类
+func (h *Handler) UpdateCategory(c *gin.Context) {
+	log.Infow("Update category function called")
+
+	core.HandleJSONRequest(c, h.biz.CategoryV1().Update, h.validator.ValidateUpdateCategoryRequest)
+}
+
+// DeleteCategory 删除分类
+func (h *Handler) DeleteCategory(c *gin.Context) {
+	log.Infow("Delete category function called")
+
+	core.HandleURIRequest(c, h.biz.CategoryV1().Delete, h.validator.ValidateDeleteCategoryRequest)
+}
+
+// GetCategory 获取分类
+func (h *Handler) GetCategory(c *gin.Context) {
+	log.Infow("Get category function called")
+
+	core.HandleURIRequest(c, h.biz.CategoryV1().Get, h.validator.ValidateGetCategoryRequest)
+}
+
+// ListCategory 获取分类列表
+func (h *Handler) ListCategory(c *gin.Context) {
+	log.Infow("List category function called")
+
+	core.HandleQueryRequest(c, h.biz.CategoryV1().List, h.validator.ValidateListCategoryRequest)
+}
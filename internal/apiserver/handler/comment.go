@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// CreateComment 发表评论
+func (h *Handler) CreateComment(c *gin.Context) {
+	log.Infow("Create comment function called")
+
+	core.HandleJSONRequest(c, h.biz.CommentV1().Create, h.validator.ValidateCreateCommentRequest)
+}
+
+// UpdateComment 修改评论
+func (h *Handler) UpdateComment(c *gin.Context) {
+	log.Infow("Update comment function called")
+
+	core.HandleJSONRequest(c, h.biz.CommentV1().Update, h.validator.ValidateUpdateCommentRequest)
+}
+
+// DeleteComment 删除评论
+func (h *Handler) DeleteComment(c *gin.Context) {
+	log.Infow("Delete comment function called")
+
+	core.HandleURIRequest(c, h.biz.CommentV1().Delete, h.validator.ValidateDeleteCommentRequest)
+}
+
+// ModerateComment 审核评论
+func (h *Handler) ModerateComment(c *gin.Context) {
+	log.Infow("Moderate comment function called")
+
+	core.HandleJSONRequest(c, h.biz.CommentV1().Moderate, h.validator.ValidateModerateCommentRequest)
+}
+
+// ListComment 获取文章评论列表
+func (h *Handler) ListComment(c *gin.Context) {
+	log.Infow("List comment function called")
+
+	core.HandleQueryRequest(c, h.biz.CommentV1().List, h.validator.ValidateListCommentRequest)
+}
+
+// ListPublicComment 匿名获取文章评论列表
+func (h *Handler) ListPublicComment(c *gin.Context) {
+	log.Infow("List public comment function called")
+
+	core.HandleQueryRequest(c, h.biz.CommentV1().ListPublic, h.validator.ValidateListPublicCommentRequest)
+}
@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// CreateCategory 创建分类.
+func (h *Handler) CreateCategory(ctx context.Context, rq *apiv1.CreateCategoryRequest) (*apiv1.CreateCategoryResponse, error) {
+	log.With(ctx).Infow("Create category function called")
+
+	return handle(ctx, rq, h.biz.CategoryV1().Create, h.validator.ValidateCreateCategoryRequest)
+}
+
+// UpdateCategory 更新分类.
+func (h *Handler) UpdateCategory(ctx context.Context, rq *apiv1.UpdateCategoryRequest) (*apiv1.UpdateCategoryResponse, error) {
+	log.With(ctx).Infow("Update category function called")
+
+	return handle(ctx, rq, h.biz.CategoryV1().Update, h.validator.ValidateUpdateCategoryRequest)
+}
+
+// DeleteCategory 删除分类.
+func (h *Handler) DeleteCategory(ctx context.Context, rq *apiv1.DeleteCategoryRequest) (*apiv1.DeleteCategoryResponse, error) {
+	log.With(ctx).Infow("Delete category function called")
+
+	return handle(ctx, rq, h.biz.CategoryV1().Delete, h.validator.ValidateDeleteCategoryRequest)
+}
+
+// GetCategory 获取分类.
+func (h *Handler) GetCategory(ctx context.Context, rq *apiv1.GetCategoryRequest) (*apiv1.GetCategoryResponse, error) {
+	log.With(ctx).Infow("Get category function called")
+
+	return handle(ctx, rq, h.biz.CategoryV1().Get, h.validator.ValidateGetCategoryRequest)
+}
+
+// ListCategory 获取分类列表.
+func (h *Handler) ListCategory(ctx context.Context, rq *apiv1.ListCategoryRequest) (*apiv1.ListCategoryResponse, error) {
+	log.With(ctx).Infow("List category function called")
+
+	return handle(ctx, rq, h.biz.CategoryV1().List, h.validator.ValidateListCategoryRequest)
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// CreateComment 发表评论.
+func (h *Handler) CreateComment(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error) {
+	log.With(ctx).Infow("Create comment function called")
+
+	return handle(ctx, rq, h.biz.CommentV1().Create, h.validator.ValidateCreateCommentRequest)
+}
+
+// UpdateComment 修改评论.
+func (h *Handler) UpdateComment(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error) {
+	log.With(ctx).Infow("Update comment function called")
+
+	return handle(ctx, rq, h.biz.CommentV1().Update, h.validator.ValidateUpdateCommentRequest)
+}
+
+// DeleteComment 删除评论.
+func (h *Handler) DeleteComment(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error) {
+	log.With(ctx).Infow("Delete comment function called")
+
+	return handle(ctx, rq, h.biz.CommentV1().Delete, h.validator.ValidateDeleteCommentRequest)
+}
+
+// ModerateComment 审核评论.
+func (h *Handler) ModerateComment(ctx context.Context, rq *apiv1.ModerateCommentRequest) (*apiv1.ModerateCommentResponse, error) {
+	log.With(ctx).Infow("Moderate comment function called")
+
+	return handle(ctx, rq, h.biz.CommentV1().Moderate, h.validator.ValidateModerateCommentRequest)
+}
+
+// ListComment 获取文章评论列表.
+func (h *Handler) ListComment(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error) {
+	log.With(ctx).Infow("List comment function called")
+
+	return handle(ctx, rq, h.biz.CommentV1().List, h.validator.ValidateListCommentRequest)
+}
+
+// ListPublicComment 匿名获取文章评论列表.
+func (h *Handler) ListPublicComment(ctx context.Context, rq *apiv1.ListPublicCommentRequest) (*apiv1.ListPublicCommentResponse, error) {
+	log.With(ctx).Infow("List public comment function called")
+
+	return handle(ctx, rq, h.biz.CommentV1().ListPublic, h.validator.ValidateListPublicCommentRequest)
+}
@@ -1,13 +1,101 @@
 package grpc
 
 import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/biz"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/validation"
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
 	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 type Handler struct {
 	apiv1.UnimplementedFastBlogServer
+
+	biz       biz.IBiz
+	validator *validation.Validator
+}
+
+func NewHandler(biz biz.IBiz, validator *validation.Validator) *Handler {
+	return &Handler{
+		biz:       biz,
+		validator: validator,
+	}
+}
+
+// handle 先校验请求参数，校验通过后再调用业务处理函数，行为与 HTTP 服务的 core.HandleRequest 保持一致.
+func handle[T any, R any](ctx context.Context, rq *T, handler core.Handler[T, R], validator core.Validator[T]) (R, error) {
+	if err := validator(ctx, rq); err != nil {
+		var zero R
+		return zero, errorx.ErrInvalidArugment.WithMessage(err.Error())
+	}
+
+	return handler(ctx, rq)
 }
 
-func NewHandler() *Handler {
-	return &Handler{}
+// withIfMatch 将请求元数据中的 If-Match 存放到上下文中，grpc-gateway 转发的 If-Match 请求头带有 grpcgateway- 前缀.
+func withIfMatch(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{"if-match", runtime.MetadataPrefix + "if-match"} {
+		if values := md.Get(key); len(values) > 0 {
+			return contextx.WithIfMatch(ctx, values[0])
+		}
+	}
+	return ctx
+}
+
+// withClient 将客户端 IP 和 User-Agent 存放到上下文中.
+// 只有对端是本机时（即内置的 grpc-gateway 转发的请求）才信任 x-forwarded-for 中由 grpc-gateway 追加的最后一个地址，
+// 其它情况使用对端地址，避免客户端伪造 IP 绕过登录失败次数限制.
+func withClient(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var ip string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	if addr := net.ParseIP(ip); addr != nil && addr.IsLoopback() {
+		if values := md.Get("x-forwarded-for"); len(values) > 0 {
+			forwarded := strings.Split(values[len(values)-1], ",")
+			ip = strings.TrimSpace(forwarded[len(forwarded)-1])
+		}
+	}
+	ctx = contextx.WithClientIP(ctx, ip)
+
+	for _, key := range []string{runtime.MetadataPrefix + "user-agent", "user-agent"} {
+		if values := md.Get(key); len(values) > 0 {
+			return contextx.WithUserAgent(ctx, values[0])
+		}
+	}
+	return ctx
+}
+
+// setConditionalHeaders 根据资源的最后修改时间设置 ETag、Last-Modified 等响应元数据，
+// grpc-gateway 将它们转换为 HTTP 响应头并处理条件请求.
+func setConditionalHeaders(ctx context.Context, updatedAt time.Time) {
+	header := http.Header{}
+	conditional.SetHeaders(header, updatedAt)
+
+	md := metadata.MD{}
+	for key, values := range header {
+		md.Set(key, values...)
+	}
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		log.With(ctx).Warnw("Failed to set conditional headers", "err", err)
+	}
 }
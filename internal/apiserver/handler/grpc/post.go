@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// CreatePost 创建文章.
+func (h *Handler) CreatePost(ctx context.Context, rq *apiv1.CreatePostRequest) (*apiv1.CreatePostResponse, error) {
+	log.With(ctx).Infow("Create post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Create, h.validator.ValidateCreatePostRequest)
+}
+
+// UpdatePost 更新文章.
+func (h *Handler) UpdatePost(ctx context.Context, rq *apiv1.UpdatePostRequest) (*apiv1.UpdatePostResponse, error) {
+	log.With(ctx).Infow("Update post function called")
+
+	return handle(withIfMatch(ctx), rq, h.biz.PostV1().Update, h.validator.ValidateUpdatePostRequest)
+}
+
+// DeletePost 删除文章.
+func (h *Handler) DeletePost(ctx context.Context, rq *apiv1.DeletePostRequest) (*apiv1.DeletePostResponse, error) {
+	log.With(ctx).Infow("Delete post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Delete, h.validator.ValidateDeletePostRequest)
+}
+
+// GetPost 获取文章.
+func (h *Handler) GetPost(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.GetPostResponse, error) {
+	log.With(ctx).Infow("Get post function called")
+
+	resp, err := handle(ctx, rq, h.biz.PostV1().Get, h.validator.ValidateGetPostRequest)
+	if err != nil {
+		return nil, err
+	}
+	setConditionalHeaders(ctx, resp.GetPost().GetUpdatedAt().AsTime())
+	return resp, nil
+}
+
+// ListPost 获取文章列表.
+func (h *Handler) ListPost(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
+	log.With(ctx).Infow("List post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().List, h.validator.ValidateListPostRequest)
+}
+
+// ListPublicPost 匿名获取公开文章列表.
+func (h *Handler) ListPublicPost(ctx context.Context, rq *apiv1.ListPublicPostRequest) (*apiv1.ListPublicPostResponse, error) {
+	log.With(ctx).Infow("List public post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().ListPublic, h.validator.ValidateListPublicPostRequest)
+}
+
+// GetPublicPost 匿名获取公开文章.
+func (h *Handler) GetPublicPost(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error) {
+	log.With(ctx).Infow("Get public post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().GetPublic, h.validator.ValidateGetPublicPostRequest)
+}
+
+// PublishPost 发布文章，指定发布时间时定时发布.
+func (h *Handler) PublishPost(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error) {
+	log.With(ctx).Infow("Publish post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Publish, h.validator.ValidatePublishPostRequest)
+}
+
+// UnpublishPost 撤回文章为草稿.
+func (h *Handler) UnpublishPost(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error) {
+	log.With(ctx).Infow("Unpublish post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Unpublish, h.validator.ValidateUnpublishPostRequest)
+}
+
+// ArchivePost 归档文章.
+func (h *Handler) ArchivePost(ctx context.Context, rq *apiv1.ArchivePostRequest) (*apiv1.ArchivePostResponse, error) {
+	log.With(ctx).Infow("Archive post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Archive, h.validator.ValidateArchivePostRequest)
+}
+
+// ListTrashPost 获取回收站中的文章列表.
+func (h *Handler) ListTrashPost(ctx context.Context, rq *apiv1.ListTrashPostRequest) (*apiv1.ListTrashPostResponse, error) {
+	log.With(ctx).Infow("List trash post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().ListTrash, h.validator.ValidateListTrashPostRequest)
+}
+
+// RestorePost 从回收站恢复文章.
+func (h *Handler) RestorePost(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error) {
+	log.With(ctx).Infow("Restore post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Restore, h.validator.ValidateRestorePostRequest)
+}
+
+// PurgePost 彻底删除回收站中的文章.
+func (h *Handler) PurgePost(ctx context.Context, rq *apiv1.PurgePostRequest) (*apiv1.PurgePostResponse, error) {
+	log.With(ctx).Infow("Purge post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Purge, h.validator.ValidatePurgePostRequest)
+}
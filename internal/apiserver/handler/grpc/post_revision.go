@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// ListPostRevision 获取文章历史版本列表.
+func (h *Handler) ListPostRevision(ctx context.Context, rq *apiv1.ListPostRevisionRequest) (*apiv1.ListPostRevisionResponse, error) {
+	log.With(ctx).Infow("List post revision function called")
+
+	return handle(ctx, rq, h.biz.PostV1().ListRevision, h.validator.ValidateListPostRevisionRequest)
+}
+
+// GetPostRevision 获取文章历史版本.
+func (h *Handler) GetPostRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error) {
+	log.With(ctx).Infow("Get post revision function called")
+
+	return handle(ctx, rq, h.biz.PostV1().GetRevision, h.validator.ValidateGetPostRevisionRequest)
+}
+
+// DiffPostRevision 比较文章的两个版本.
+func (h *Handler) DiffPostRevision(ctx context.Context, rq *apiv1.DiffPostRevisionRequest) (*apiv1.DiffPostRevisionResponse, error) {
+	log.With(ctx).Infow("Diff post revision function called")
+
+	return handle(ctx, rq, h.biz.PostV1().DiffRevision, h.validator.ValidateDiffPostRevisionRequest)
+}
+
+// RestorePostRevision 将文章恢复到历史版本.
+func (h *Handler) RestorePostRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error) {
+	log.With(ctx).Infow("Restore post revision function called")
+
+	return handle(ctx, rq, h.biz.PostV1().RestoreRevision, h.validator.ValidateRestorePostRevisionRequest)
+}
@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// SearchPost 全文检索当前用户的文章.
+func (h *Handler) SearchPost(ctx context.Context, rq *apiv1.SearchPostRequest) (*apiv1.SearchPostResponse, error) {
+	log.With(ctx).Infow("Search post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().Search, h.validator.ValidateSearchPostRequest)
+}
+
+// SearchPublicPost 匿名全文检索已发布的文章.
+func (h *Handler) SearchPublicPost(ctx context.Context, rq *apiv1.SearchPublicPostRequest) (*apiv1.SearchPublicPostResponse, error) {
+	log.With(ctx).Infow("Search public post function called")
+
+	return handle(ctx, rq, h.biz.PostV1().SearchPublic, h.validator.ValidateSearchPublicPostRequest)
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// CreateTag 创建标签.
+func (h *Handler) CreateTag(ctx context.Context, rq *apiv1.CreateTagRequest) (*apiv1.CreateTagResponse, error) {
+	log.With(ctx).Infow("Create tag function called")
+
+	return handle(ctx, rq, h.biz.TagV1().Create, h.validator.ValidateCreateTagRequest)
+}
+
+// UpdateTag 更新标签.
+func (h *Handler) UpdateTag(ctx context.Context, rq *apiv1.UpdateTagRequest) (*apiv1.UpdateTagResponse, error) {
+	log.With(ctx).Infow("Update tag function called")
+
+	return handle(ctx, rq, h.biz.TagV1().Update, h.validator.ValidateUpdateTagRequest)
+}
+
+// DeleteTag 删除标签.
+func (h *Handler) DeleteTag(ctx context.Context, rq *apiv1.DeleteTagRequest) (*apiv1.DeleteTagResponse, error) {
+	log.With(ctx).Infow("Delete tag function called")
+
+	return handle(ctx, rq, h.biz.TagV1().Delete, h.validator.ValidateDeleteTagRequest)
+}
+
+// GetTag 获取标签.
+func (h *Handler) GetTag(ctx context.Context, rq *apiv1.GetTagRequest) (*apiv1.GetTagResponse, error) {
+	log.With(ctx).Infow("Get tag function called")
+
+	return handle(ctx, rq, h.biz.TagV1().Get, h.validator.ValidateGetTagRequest)
+}
+
+// ListTag 获取标签列表.
+func (h *Handler) ListTag(ctx context.Context, rq *apiv1.ListTagRequest) (*apiv1.ListTagResponse, error) {
+	log.With(ctx).Infow("List tag function called")
+
+	return handle(ctx, rq, h.biz.TagV1().List, h.validator.ValidateListTagRequest)
+}
+
+// GetTagCloud 获取标签云.
+func (h *Handler) GetTagCloud(ctx context.Context, rq *apiv1.GetTagCloudRequest) (*apiv1.GetTagCloudResponse, error) {
+	log.With(ctx).Infow("Get tag cloud function called")
+
+	return handle(ctx, rq, h.biz.TagV1().Cloud, h.validator.ValidateGetTagCloudRequest)
+}
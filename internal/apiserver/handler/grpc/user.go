@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"mime"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// exportChunkSize 是导出用户数据时每个 HttpBody 消息携带的最大字节数.
+const exportChunkSize = 32 * 1024
+
+// Login 用户登录.
+func (h *Handler) Login(ctx context.Context, rq *apiv1.LoginRequest) (*apiv1.LoginResponse, error) {
+	log.With(ctx).Infow("Login function called")
+
+	return handle(withClient(ctx), rq, h.biz.UserV1().Login, h.validator.ValidateLoginRequest)
+}
+
+// LoginTOTP 使用挑战令牌和两步验证码完成登录.
+func (h *Handler) LoginTOTP(ctx context.Context, rq *apiv1.LoginTOTPRequest) (*apiv1.LoginResponse, error) {
+	log.With(ctx).Infow("Login TOTP function called")
+
+	return handle(withClient(ctx), rq, h.biz.UserV1().LoginTOTP, h.validator.ValidateLoginTOTPRequest)
+}
+
+// RefreshToken 刷新 token.
+func (h *Handler) RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error) {
+	log.With(ctx).Infow("Refresh token function called")
+
+	return handle(ctx, rq, h.biz.UserV1().RefreshToken, h.validator.ValidateRefreshTokenRequest)
+}
+
+// Logout 退出登录，撤销当前会话.
+func (h *Handler) Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error) {
+	log.With(ctx).Infow("Logout function called")
+
+	return handle(ctx, rq, h.biz.UserV1().Logout, h.validator.ValidateLogoutRequest)
+}
+
+// ChangePassword 修改用户密码.
+func (h *Handler) ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error) {
+	log.With(ctx).Infow("Change user password function called")
+
+	return handle(ctx, rq, h.biz.UserV1().ChangePassword, h.validator.ValidateChangePasswordRequest)
+}
+
+// EnrollTOTP 绑定两步验证.
+func (h *Handler) EnrollTOTP(ctx context.Context, rq *apiv1.EnrollTOTPRequest) (*apiv1.EnrollTOTPResponse, error) {
+	log.With(ctx).Infow("Enroll TOTP function called")
+
+	return handle(ctx, rq, h.biz.UserV1().EnrollTOTP, h.validator.ValidateEnrollTOTPRequest)
+}
+
+// ConfirmTOTP 确认并启用两步验证.
+func (h *Handler) ConfirmTOTP(ctx context.Context, rq *apiv1.ConfirmTOTPRequest) (*apiv1.ConfirmTOTPResponse, error) {
+	log.With(ctx).Infow("Confirm TOTP function called")
+
+	return handle(withClient(ctx), rq, h.biz.UserV1().ConfirmTOTP, h.validator.ValidateConfirmTOTPRequest)
+}
+
+// DisableTOTP 停用两步验证.
+func (h *Handler) DisableTOTP(ctx context.Context, rq *apiv1.DisableTOTPRequest) (*apiv1.DisableTOTPResponse, error) {
+	log.With(ctx).Infow("Disable TOTP function called")
+
+	return handle(withClient(ctx), rq, h.biz.UserV1().DisableTOTP, h.validator.ValidateDisableTOTPRequest)
+}
+
+// RegenerateRecoveryCodes 重新生成两步验证的恢复码.
+func (h *Handler) RegenerateRecoveryCodes(ctx context.Context, rq *apiv1.RegenerateRecoveryCodesRequest) (*apiv1.RegenerateRecoveryCodesResponse, error) {
+	log.With(ctx).Infow("Regenerate recovery codes function called")
+
+	return handle(withClient(ctx), rq, h.biz.UserV1().RegenerateRecoveryCodes, h.validator.ValidateRegenerateRecoveryCodesRequest)
+}
+
+// CreateUser 创建用户.
+func (h *Handler) CreateUser(ctx context.Context, rq *apiv1.CreateUserRequest) (*apiv1.CreateUserResponse, error) {
+	log.With(ctx).Infow("Create user function called")
+
+	return handle(ctx, rq, h.biz.UserV1().Create, h.validator.ValidateCreateUserRequest)
+}
+
+// UpdateUser 更新用户信息.
+func (h *Handler) UpdateUser(ctx context.Context, rq *apiv1.UpdateUserRequest) (*apiv1.UpdateUserResponse, error) {
+	log.With(ctx).Infow("Update user function called")
+
+	return handle(withIfMatch(ctx), rq, h.biz.UserV1().Update, h.validator.ValidateUpdateUserRequest)
+}
+
+// DeleteUser 删除用户.
+func (h *Handler) DeleteUser(ctx context.Context, rq *apiv1.DeleteUserRequest) (*apiv1.DeleteUserResponse, error) {
+	log.With(ctx).Infow("Delete user function called")
+
+	return handle(ctx, rq, h.biz.UserV1().Delete, h.validator.ValidateDeleteUserRequest)
+}
+
+// GetUser 获取用户信息.
+func (h *Handler) GetUser(ctx context.Context, rq *apiv1.GetUserRequest) (*apiv1.GetUserResponse, error) {
+	log.With(ctx).Infow("Get user function called")
+
+	resp, err := handle(ctx, rq, h.biz.UserV1().Get, h.validator.ValidateGetUserRequest)
+	if err != nil {
+		return nil, err
+	}
+	setConditionalHeaders(ctx, resp.GetUser().GetUpdatedAt().AsTime())
+	return resp, nil
+}
+
+// ListUser 获取用户列表.
+func (h *Handler) ListUser(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.ListUserResponse, error) {
+	log.With(ctx).Infow("List user function called")
+
+	return handle(ctx, rq, h.biz.UserV1().List, h.validator.ValidateListUserRequest)
+}
+
+// ListTrashUser 获取已注销的用户列表.
+func (h *Handler) ListTrashUser(ctx context.Context, rq *apiv1.ListTrashUserRequest) (*apiv1.ListTrashUserResponse, error) {
+	log.With(ctx).Infow("List trash user function called")
+
+	return handle(ctx, rq, h.biz.UserV1().ListTrash, h.validator.ValidateListTrashUserRequest)
+}
+
+// RestoreUser 恢复已注销的用户.
+func (h *Handler) RestoreUser(ctx context.Context, rq *apiv1.RestoreUserRequest) (*apiv1.RestoreUserResponse, error) {
+	log.With(ctx).Infow("Restore user function called")
+
+	return handle(ctx, rq, h.biz.UserV1().Restore, h.validator.ValidateRestoreUserRequest)
+}
+
+// PurgeUser 彻底删除已注销的用户.
+func (h *Handler) PurgeUser(ctx context.Context, rq *apiv1.PurgeUserRequest) (*apiv1.PurgeUserResponse, error) {
+	log.With(ctx).Infow("Purge user function called")
+
+	return handle(ctx, rq, h.biz.UserV1().Purge, h.validator.ValidatePurgeUserRequest)
+}
+
+// ExportUserData 导出用户数据，文件内容按块以 HttpBody 消息流式返回.
+func (h *Handler) ExportUserData(rq *apiv1.ExportUserDataRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	ctx := stream.Context()
+	log.With(ctx).Infow("Export user data function called")
+
+	archive, err := handle(ctx, rq, h.biz.UserV1().Export, h.validator.ValidateExportUserDataRequest)
+	if err != nil {
+		return err
+	}
+
+	// grpc-gateway 会将 content-disposition 元数据转换为同名响应头
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": archive.Filename})
+	if err := stream.SendHeader(metadata.Pairs("content-disposition", disposition)); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&httpBodyWriter{stream: stream, contentType: archive.ContentType}, exportChunkSize)
+	if err := archive.Write(w); err != nil {
+		log.With(ctx).Errorw("Failed to write user data archive", "err", err, "userID", rq.UserID)
+		return err
+	}
+	return w.Flush()
+}
+
+// httpBodyWriter 将写入的数据作为 HttpBody 消息发送到流中.
+type httpBodyWriter struct {
+	stream      grpc.ServerStreamingServer[httpbody.HttpBody]
+	contentType string
+}
+
+// Write 实现 io.Writer 接口.
+func (w *httpBodyWriter) Write(p []byte) (int, error) {
+	// 消息发送后不能再被修改，而调用方会复用 p，因此需要复制一份
+	if err := w.stream.Send(&httpbody.HttpBody{ContentType: w.contentType, Data: bytes.Clone(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
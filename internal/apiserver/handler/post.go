@@ -2,6 +2,7 @@ package handler
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
 	"github.com/loveRyujin/fast_blog/internal/pkg/core"
 	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
 	"github.com/loveRyujin/fast_blog/internal/pkg/log"
@@ -12,49 +13,15 @@ import (
 func (h *Handler) CreatePost(c *gin.Context) {
 	log.Infow("create post function call")
 
-	var rq apiv1.CreatePostRequest
-	if err := c.ShouldBindJSON(&rq); err != nil {
-		core.WriteResponse(c, nil, errorx.ErrBind)
-		return
-	}
-
-	if err := h.validator.ValidateCreatePostRequest(c.Request.Context(), &rq); err != nil {
-		core.WriteResponse(c, nil, errorx.ErrInvalidArugment.WithMessage(err.Error()))
-		return
-	}
-
-	resp, err := h.biz.PostV1().Create(c.Request.Context(), &rq)
-	if err != nil {
-		core.WriteResponse(c, nil, err)
-		return
-	}
-
-	core.WriteResponse(c, resp, nil)
+	core.HandleJSONRequest(c, h.biz.PostV1().Create, h.validator.ValidateCreatePostRequest)
 }
 
 // UpdatePost 更新文章
 func (h *Handler) UpdatePost(c *gin.Context) {
 	log.Infow("update post function call")
 
-	var rq apiv1.UpdatePostRequest
-	if err := c.ShouldBindJSON(&rq); err != nil {
-		core.WriteResponse(c, nil, errorx.ErrBind)
-		return
-	}
-	rq.PostID = c.Param("postID")
-
-	if err := h.validator.ValidateUpdatePostRequest(c.Request.Context(), &rq); err != nil {
-		core.WriteResponse(c, nil, errorx.ErrInvalidArugment.WithMessage(err.Error()))
-		return
-	}
-
-	resp, err := h.biz.PostV1().Update(c.Request.Context(), &rq)
-	if err != nil {
-		core.WriteResponse(c, nil, err)
-		return
-	}
-
-	core.WriteResponse(c, resp, nil)
+	c.Request = c.Request.WithContext(contextx.WithIfMatch(c.Request.Context(), c.GetHeader("If-Match")))
+	core.HandleJSONRequest(c, h.biz.PostV1().Update, h.validator.ValidateUpdatePostRequest)
 }
 
 // DeletePost 删除文章
@@ -86,7 +53,7 @@ func (h *Handler) GetPost(c *gin.Context) {
 	log.Infow("get post function call")
 
 	var rq apiv1.GetPostRequest
-	if err := c.ShouldBindUri(&rq); err != nil {
+	if err := core.BindURI(c)(&rq); err != nil {
 		core.WriteResponse(c, nil, errorx.ErrBind)
 		return
 	}
@@ -97,12 +64,7 @@ func (h *Handler) GetPost(c *gin.Context) {
 	}
 
 	resp, err := h.biz.PostV1().Get(c.Request.Context(), &rq)
-	if err != nil {
-		core.WriteResponse(c, nil, err)
-		return
-	}
-
-	core.WriteResponse(c, resp, nil)
+	core.WriteConditionalResponse(c, resp, resp.GetPost().GetUpdatedAt().AsTime(), err)
 }
 
 // ListPost 获取文章列表
@@ -110,7 +72,7 @@ func (h *Handler) ListPost(c *gin.Context) {
 	log.Infow("list post function call")
 
 	var rq apiv1.ListPostRequest
-	if err := c.ShouldBindQuery(&rq); err != nil {
+	if err := core.BindQuery(c)(&rq); err != nil {
 		core.WriteResponse(c, nil, errorx.ErrBind)
 		return
 	}
@@ -128,3 +90,59 @@ func (h *Handler) ListPost(c *gin.Context) {
 
 	core.WriteResponse(c, resp, nil)
 }
+
+// ListPublicPost 匿名获取公开文章列表
+func (h *Handler) ListPublicPost(c *gin.Context) {
+	log.Infow("List public post function called")
+
+	core.HandleQueryRequest(c, h.biz.PostV1().ListPublic, h.validator.ValidateListPublicPostRequest)
+}
+
+// GetPublicPost 匿名获取公开文章
+func (h *Handler) GetPublicPost(c *gin.Context) {
+	log.Infow("Get public post function called")
+
+	core.HandleURIRequest(c, h.biz.PostV1().GetPublic, h.validator.ValidateGetPublicPostRequest)
+}
+
+// PublishPost 发布文章，指定发布时间时定时发布
+func (h *Handler) PublishPost(c *gin.Context) {
+	log.Infow("Publish post function called")
+
+	core.HandleJSONRequest(c, h.biz.PostV1().Publish, h.validator.ValidatePublishPostRequest)
+}
+
+// UnpublishPost 撤回文章为草稿
+func (h *Handler) UnpublishPost(c *gin.Context) {
+	log.Infow("Unpublish post function called")
+
+	core.HandleJSONRequest(c, h.biz.PostV1().Unpublish, h.validator.ValidateUnpublishPostRequest)
+}
+
+// ArchivePost 归档文章
+func (h *Handler) ArchivePost(c *gin.Context) {
+	log.Infow("Archive post function called")
+
+	core.HandleJSONRequest(c, h.biz.PostV1().Archive, h.validator.ValidateArchivePostRequest)
+}
+
+// ListTrashPost 获取回收站中的文章列表
+func (h *Handler) ListTrashPost(c *gin.Context) {
+	log.Infow("List trash post function called")
+
+	core.HandleQueryRequest(c, h.biz.PostV1().ListTrash, h.validator.ValidateListTrashPostRequest)
+}
+
+// RestorePost 从回收站恢复文章
+func (h *Handler) RestorePost(c *gin.Context) {
+	log.Infow("Restore post function called")
+
+	core.HandleURIRequest(c, h.biz.PostV1().Restore, h.validator.ValidateRestorePostRequest)
+}
+
+// PurgePost 彻底删除回收站中的文章
+func (h *Handler) PurgePost(c *gin.Context) {
+	log.Infow("Purge post function called")
+
+	core.HandleJSONRequest(c, h.biz.PostV1().Purge, h.validator.ValidatePurgePostRequest)
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// ListPostRevision 获取文章历史版本列表
+func (h *Handler) ListPostRevision(c *gin.Context) {
+	log.Infow("List post revision function called")
+
+	core.HandleQueryRequest(c, h.biz.PostV1().ListRevision, h.validator.ValidateListPostRevisionRequest)
+}
+
+// GetPostRevision 获取文章历史版本
+func (h *Handler) GetPostRevision(c *gin.Context) {
+	log.Infow("Get post revision function called")
+
+	core.HandleURIRequest(c, h.biz.PostV1().GetRevision, h.validator.ValidateGetPostRevisionRequest)
+}
+
+// DiffPostRevision 比较文章的两个版本
+func (h *Handler) DiffPostRevision(c *gin.Context) {
+	log.Infow("Diff post revision function called")
+
+	core.HandleQueryRequest(c, h.biz.PostV1().DiffRevision, h.validator.ValidateDiffPostRevisionRequest)
+}
+
+// RestorePostRevision 将文章恢复到历史版本
+func (h *Handler) RestorePostRevision(c *gin.Context) {
+	log.Infow("Restore post revision function called")
+
+	core.HandleJSONRequest(c, h.biz.PostV1().RestoreRevision, h.validator.ValidateRestorePostRevisionRequest)
+}
@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// SearchPost 全文检索当前用户的文章
+func (h *Handler) SearchPost(c *gin.Context) {
+	log.Infow("Search post function called")
+
+	core.HandleQueryRequest(c, h.biz.PostV1().Search, h.validator.ValidateSearchPostRequest)
+}
+
+// SearchPublicPost 匿名全文检索已发布的文章
+func (h *Handler) SearchPublicPost(c *gin.Context) {
+	log.Infow("Search public post function called")
+
+	core.HandleQueryRequest(c, h.biz.PostV1().SearchPublic, h.validator.ValidateSearchPublicPostRequest)
+}
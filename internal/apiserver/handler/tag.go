@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// CreateTag 创建标签
+func (h *Handler) CreateTag(c *gin.Context) {
+	log.Infow("Create tag function called")
+
+	core.HandleJSONRequest(c, h.biz.TagV1().Create, h.validator.ValidateCreateTagRequest)
+}
+
+// UpdateTag 更新标签
+func (h *Handler) UpdateTag(c *gin.Context) {
+	log.Infow("Update tag function called")
+
+	core.HandleJSONRequest(c, h.biz.TagV1().Update, h.validator.ValidateUpdateTagRequest)
+}
+
+// DeleteTag 删除标签
+func (h *Handler) DeleteTag(c *gin.Context) {
+	log.Infow("Delete tag function called")
+
+	core.HandleURIRequest(c, h.biz.TagV1().Delete, h.validator.ValidateDeleteTagRequest)
+}
+
+// GetTag 获取标签
+func (h *Handler) GetTag(c *gin.Context) {
+	log.Infow("Get tag function called")
+
+	core.HandleURIRequest(c, h.biz.TagV1().Get, h.validator.ValidateGetTagRequest)
+}
+
+// ListTag 获取标签列表
+func (h *Handler) ListTag(c *gin.Context) {
+	log.Infow("List tag function called")
+
+	core.HandleQueryRequest(c, h.biz.TagV1().List, h.validator.ValidateListTagRequest)
+}
+
+// GetTagCloud 获取标签云
+func (h *Handler) GetTagCloud(c *gin.Context) {
+	log.Infow("Get tag cloud function called")
+
+	core.HandleQueryRequest(c, h.biz.TagV1().Cloud, h.validator.ValidateGetTagCloudRequest)
+}
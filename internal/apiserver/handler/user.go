@@ -1,18 +1,39 @@
 package handler
 
 import (
+	"mime"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
 	"github.com/loveRyujin/fast_blog/internal/pkg/core"
 	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
 )
 
 // Login 用户登录
 func (h *Handler) Login(c *gin.Context) {
 	log.Infow("Login function called")
 
+	withClient(c)
 	core.HandleJSONRequest(c, h.biz.UserV1().Login, h.validator.ValidateLoginRequest)
 }
 
+// LoginTOTP 使用挑战令牌和两步验证码完成登录
+func (h *Handler) LoginTOTP(c *gin.Context) {
+	log.Infow("Login TOTP function called")
+
+	withClient(c)
+	core.HandleJSONRequest(c, h.biz.UserV1().LoginTOTP, h.validator.ValidateLoginTOTPRequest)
+}
+
+// withClient 将客户端 IP 和 User-Agent 存放到请求的上下文中.
+// 使用对端地址而不是 X-Forwarded-For 作为客户端 IP，X-Forwarded-For 可以由客户端任意伪造
+func withClient(c *gin.Context) {
+	ctx := contextx.WithClientIP(c.Request.Context(), c.RemoteIP())
+	c.Request = c.Request.WithContext(contextx.WithUserAgent(ctx, c.Request.UserAgent()))
+}
+
 // RefreshToken 刷新token
 func (h *Handler) RefreshToken(c *gin.Context) {
 	log.Infow("Refresh token function called")
@@ -20,12 +41,50 @@ func (h *Handler) RefreshToken(c *gin.Context) {
 	core.HandleJSONRequest(c, h.biz.UserV1().RefreshToken, h.validator.ValidateRefreshTokenRequest)
 }
 
+// Logout 退出登录，撤销当前会话
+func (h *Handler) Logout(c *gin.Context) {
+	log.Infow("Logout function called")
+
+	core.HandleJSONRequest(c, h.biz.UserV1().Logout, h.validator.ValidateLogoutRequest)
+}
+
 func (h *Handler) ChangePassword(c *gin.Context) {
 	log.Infow("Change user password function call")
 
 	core.HandleJSONRequest(c, h.biz.UserV1().ChangePassword, h.validator.ValidateChangePasswordRequest)
 }
 
+// EnrollTOTP 绑定两步验证
+func (h *Handler) EnrollTOTP(c *gin.Context) {
+	log.Infow("Enroll TOTP function called")
+
+	core.HandleJSONRequest(c, h.biz.UserV1().EnrollTOTP, h.validator.ValidateEnrollTOTPRequest)
+}
+
+// ConfirmTOTP 确认并启用两步验证
+func (h *Handler) ConfirmTOTP(c *gin.Context) {
+	log.Infow("Confirm TOTP function called")
+
+	withClient(c)
+	core.HandleJSONRequest(c, h.biz.UserV1().ConfirmTOTP, h.validator.ValidateConfirmTOTPRequest)
+}
+
+// DisableTOTP 停用两步验证
+func (h *Handler) DisableTOTP(c *gin.Context) {
+	log.Infow("Disable TOTP function called")
+
+	withClient(c)
+	core.HandleJSONRequest(c, h.biz.UserV1().DisableTOTP, h.validator.ValidateDisableTOTPRequest)
+}
+
+// RegenerateRecoveryCodes 重新生成两步验证的恢复码
+func (h *Handler) RegenerateRecoveryCodes(c *gin.Context) {
+	log.Infow("Regenerate recovery codes function called")
+
+	withClient(c)
+	core.HandleJSONRequest(c, h.biz.UserV1().RegenerateRecoveryCodes, h.validator.ValidateRegenerateRecoveryCodesRequest)
+}
+
 // CreateUser 创建用户
 func (h *Handler) CreateUser(c *gin.Context) {
 	log.Infow("Create user function call")
@@ -37,6 +96,7 @@ func (h *Handler) CreateUser(c *gin.Context) {
 func (h *Handler) UpdateUser(c *gin.Context) {
 	log.Infow("Update user function called")
 
+	c.Request = c.Request.WithContext(contextx.WithIfMatch(c.Request.Context(), c.GetHeader("If-Match")))
 	core.HandleJSONRequest(c, h.biz.UserV1().Update, h.validator.ValidateUpdateUserRequest)
 }
 
@@ -51,7 +111,39 @@ func (h *Handler) DeleteUser(c *gin.Context) {
 func (h *Handler) GetUser(c *gin.Context) {
 	log.Infow("Get user function called")
 
-	core.HandleURIRequest(c, h.biz.UserV1().Get, h.validator.ValidateGetUserRequest)
+	var rq apiv1.GetUserRequest
+	if err := core.ReadRequest(c, core.BindURI(c), &rq, h.validator.ValidateGetUserRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	resp, err := h.biz.UserV1().Get(c.Request.Context(), &rq)
+	core.WriteConditionalResponse(c, resp, resp.GetUser().GetUpdatedAt().AsTime(), err)
+}
+
+// ExportUserData 导出用户数据，文件内容直接写入响应.
+func (h *Handler) ExportUserData(c *gin.Context) {
+	log.Infow("Export user data function called")
+
+	var rq apiv1.ExportUserDataRequest
+	if err := core.ReadRequest(c, core.BindQueryAndURI(c), &rq, h.validator.ValidateExportUserDataRequest); err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	archive, err := h.biz.UserV1().Export(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	c.Header("Content-Type", archive.ContentType)
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": archive.Filename}))
+	c.Status(http.StatusOK)
+	if err := archive.Write(c.Writer); err != nil {
+		// 响应头已经发送，无法再返回错误响应，客户端会收到不完整的文件
+		log.Errorw("Failed to write user data archive", "err", err, "userID", rq.UserID)
+	}
 }
 
 // ListUser 获取用户列表.
@@ -60,3 +152,24 @@ func (h *Handler) ListUser(c *gin.Context) {
 
 	core.HandleQueryRequest(c, h.biz.UserV1().List, h.validator.ValidateListUserRequest)
 }
+
+// ListTrashUser 获取已注销的用户列表.
+func (h *Handler) ListTrashUser(c *gin.Context) {
+	log.Infow("List trash user function called")
+
+	core.HandleQueryRequest(c, h.biz.UserV1().ListTrash, h.validator.ValidateListTrashUserRequest)
+}
+
+// RestoreUser 恢复已注销的用户.
+func (h *Handler) RestoreUser(c *gin.Context) {
+	log.Infow("Restore user function called")
+
+	core.HandleURIRequest(c, h.biz.UserV1().Restore, h.validator.ValidateRestoreUserRequest)
+}
+
+// PurgeUser 彻底删除已注销的用户.
+func (h *Handler) PurgeUser(c *gin.Context) {
+	log.Infow("Purge user function called")
+
+	core.HandleURIRequest(c, h.biz.UserV1().Purge, h.validator.ValidatePurgeUserRequest)
+}
@@ -5,17 +5,12 @@ import (
 
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
-	"github.com/loveRyujin/fast_blog/internal/apiserver/biz"
 	"github.com/loveRyujin/fast_blog/internal/apiserver/handler"
-	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/validation"
-	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
 	"github.com/loveRyujin/fast_blog/internal/pkg/core"
 	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
-	"github.com/loveRyujin/fast_blog/internal/pkg/known"
 	"github.com/loveRyujin/fast_blog/internal/pkg/log"
 	mw "github.com/loveRyujin/fast_blog/internal/pkg/middleware/http"
 	"github.com/loveRyujin/fast_blog/internal/pkg/server"
-	"github.com/loveRyujin/fast_blog/pkg/token"
 )
 
 type HttpServer struct {
@@ -24,9 +19,7 @@ type HttpServer struct {
 
 var _ server.Server = (*HttpServer)(nil)
 
-func (cfg *Config) NewHTTPServer() (*HttpServer, error) {
-	// 初始化 JWT token
-	token.Init(cfg.JWTKey, known.XUserID, cfg.Expiration)
+func (c *ServerConfig) NewHTTPServer() (*HttpServer, error) {
 	// 创建gin引擎
 	engine := gin.New()
 
@@ -34,23 +27,16 @@ func (cfg *Config) NewHTTPServer() (*HttpServer, error) {
 	middlewares := []gin.HandlerFunc{gin.Recovery(), mw.NoCache(), mw.Cors(), mw.Secure(), mw.RequestID()}
 	engine.Use(middlewares...)
 
-	// 初始化数据库连接
-	db, err := cfg.MysqlOptions.NewDB()
-	if err != nil {
-		return nil, err
-	}
-	store := store.NewStore(db)
-
-	cfg.SetupRouter(engine, store)
+	c.SetupRouter(engine)
 
 	// 创建httpServer实例
-	httpServer := server.NewHTTPServer(cfg.HTTPOptions, engine)
+	httpServer := server.NewHTTPServer(c.cfg.HTTPOptions, engine)
 
 	return &HttpServer{srv: httpServer}, nil
 }
 
 // 注册 API 路由。路由的路径和 HTTP 方法，严格遵循 REST 规范.
-func (cfg *Config) SetupRouter(engine *gin.Engine, store store.IStore) {
+func (c *ServerConfig) SetupRouter(engine *gin.Engine) {
 	// 注册pprof路由
 	pprof.Register(engine)
 
@@ -65,13 +51,18 @@ func (cfg *Config) SetupRouter(engine *gin.Engine, store store.IStore) {
 		core.WriteResponse(c, gin.H{"status": "ok"}, nil)
 	})
 
+	// 注册 JWKS，供其他服务验证本服务签发的 token
+	engine.GET(jwksPath, gin.WrapF(c.serveJWKS))
+
 	// 创建核心业务处理器
-	handler := handler.NewHandler(biz.NewBiz(store), validation.NewValidator(store))
+	handler := handler.NewHandler(c.biz, c.val)
 
 	engine.POST("/login", handler.Login)
-	engine.POST("/refresh-token", mw.Authn(), handler.RefreshToken)
+	engine.POST("/login/totp", handler.LoginTOTP)
+	engine.POST("/refresh-token", handler.RefreshToken)
+	engine.POST("/logout", mw.Authn(c.tokens, c.revoker), handler.Logout)
 
-	authMiddlewares := []gin.HandlerFunc{mw.Authn()}
+	authMiddlewares := []gin.HandlerFunc{mw.Authn(c.tokens, c.revoker), mw.Authz(c.authz)}
 
 	// 注册 v1 版本 API 路由分组
 	v1 := engine.Group("/v1")
@@ -82,21 +73,90 @@ func (cfg *Config) SetupRouter(engine *gin.Engine, store store.IStore) {
 			// 创建用户。这里要注意：创建用户是不用进行认证和授权的
 			userv1.POST("", handler.CreateUser)
 			userv1.Use(authMiddlewares...)
-			userv1.PUT(":userID/change-password", handler.ChangePassword) // 修改用户密码
-			userv1.PUT(":userID", handler.UpdateUser)                     // 更新用户信息
-			userv1.DELETE(":userID", handler.DeleteUser)                  // 删除用户
-			userv1.GET(":userID", handler.GetUser)                        // 查询用户详情
-			userv1.GET("", handler.ListUser)                              // 查询用户列表
+			userv1.PUT(":userID/change-password", handler.ChangePassword)               // 修改用户密码
+			userv1.PUT(":userID", handler.UpdateUser)                                   // 更新用户信息
+			userv1.DELETE(":userID", handler.DeleteUser)                                // 删除用户
+			userv1.GET(":userID", handler.GetUser)                                      // 查询用户详情
+			userv1.GET(":userID/export", handler.ExportUserData)                        // 导出用户数据
+			userv1.POST(":userID/totp", handler.EnrollTOTP)                             // 绑定两步验证
+			userv1.POST(":userID/totp/confirm", handler.ConfirmTOTP)                    // 启用两步验证
+			userv1.POST(":userID/totp/disable", handler.DisableTOTP)                    // 停用两步验证
+			userv1.POST(":userID/totp/recovery-codes", handler.RegenerateRecoveryCodes) // 重新生成恢复码
+			userv1.GET("", handler.ListUser)                                            // 查询用户列表
 		}
 
 		// 博客相关路由
 		postv1 := v1.Group("/posts", authMiddlewares...)
 		{
-			postv1.POST("", handler.CreatePost)       // 创建博客
-			postv1.PUT(":postID", handler.UpdatePost) // 更新博客
-			postv1.DELETE("", handler.DeletePost)     // 删除博客
-			postv1.GET(":postID", handler.GetPost)    // 查询博客详情
-			postv1.GET("", handler.ListPost)          // 查询博客列表
+			postv1.POST("", handler.CreatePost)                     // 创建博客
+			postv1.PUT(":postID", handler.UpdatePost)               // 更新博客
+			postv1.DELETE("", handler.DeletePost)                   // 删除博客
+			postv1.GET(":postID", handler.GetPost)                  // 查询博客详情
+			postv1.GET("", handler.ListPost)                        // 查询博客列表
+			postv1.POST(":postID/publish", handler.PublishPost)     // 发布或定时发布博客
+			postv1.POST(":postID/unpublish", handler.UnpublishPost) // 撤回博客为草稿
+			postv1.POST(":postID/archive", handler.ArchivePost)     // 归档博客
+
+			postv1.GET(":postID/revisions", handler.ListPostRevision)                      // 查询博客历史版本列表
+			postv1.GET(":postID/revisions/:version", handler.GetPostRevision)              // 查询博客历史版本
+			postv1.POST(":postID/revisions/:version/restore", handler.RestorePostRevision) // 恢复博客历史版本
+			postv1.GET(":postID/diff", handler.DiffPostRevision)                           // 比较博客的两个版本
+
+			postv1.POST(":postID/comments", handler.CreateComment) // 发表评论
+			postv1.GET(":postID/comments", handler.ListComment)    // 查询博客评论列表
+		}
+
+		// 全文检索相关路由
+		v1.Group("/search", authMiddlewares...).GET("/posts", handler.SearchPost) // 检索博客
+
+		// 标签相关路由
+		tagv1 := v1.Group("/tags", authMiddlewares...)
+		{
+			tagv1.POST("", handler.CreateTag)         // 创建标签
+			tagv1.PUT(":tagID", handler.UpdateTag)    // 更新标签
+			tagv1.DELETE(":tagID", handler.DeleteTag) // 删除标签
+			tagv1.GET(":tagID", handler.GetTag)       // 查询标签详情
+			tagv1.GET("", handler.ListTag)            // 查询标签列表
+		}
+		v1.Group("/tag-cloud", authMiddlewares...).GET("", handler.GetTagCloud) // 查询标签云
+
+		// 分类相关路由
+		categoryv1 := v1.Group("/categories", authMiddlewares...)
+		{
+			categoryv1.POST("", handler.CreateCategory)              // 创建分类
+			categoryv1.PUT(":categoryID", handler.UpdateCategory)    // 更新分类
+			categoryv1.DELETE(":categoryID", handler.DeleteCategory) // 删除分类
+			categoryv1.GET(":categoryID", handler.GetCategory)       // 查询分类详情
+			categoryv1.GET("", handler.ListCategory)                 // 查询分类列表
+		}
+
+		// 评论相关路由
+		commentv1 := v1.Group("/comments", authMiddlewares...)
+		{
+			commentv1.PUT(":commentID", handler.UpdateComment)             // 修改评论
+			commentv1.DELETE(":commentID", handler.DeleteComment)          // 删除评论
+			commentv1.POST(":commentID/moderate", handler.ModerateComment) // 审核评论
+		}
+
+		// 回收站相关路由
+		trashv1 := v1.Group("/trash", authMiddlewares...)
+		{
+			trashv1.GET("/posts", handler.ListTrashPost)                // 查询回收站中的博客列表
+			trashv1.POST("/posts/:postID/restore", handler.RestorePost) // 从回收站恢复博客
+			trashv1.DELETE("/posts", handler.PurgePost)                 // 彻底删除回收站中的博客
+			trashv1.GET("/users", handler.ListTrashUser)                // 查询已注销的用户列表
+			trashv1.POST("/users/:userID/restore", handler.RestoreUser) // 恢复已注销的用户
+			trashv1.DELETE("/users/:userID", handler.PurgeUser)         // 彻底删除已注销的用户
+		}
+
+		// 公开博客相关路由，匿名读者无需认证即可访问
+		publicv1 := v1.Group("/public")
+		{
+			publicv1.GET("/posts", handler.ListPublicPost)                     // 查询所有作者的博客列表
+			publicv1.GET("/posts/:postID", handler.GetPublicPost)              // 查询博客详情
+			publicv1.GET("/posts/:postID/comments", handler.ListPublicComment) // 查询博客已通过审核的评论
+			publicv1.GET("/users/:userID/posts", handler.ListPublicPost)       // 查询指定作者的博客列表
+			publicv1.GET("/search/posts", handler.SearchPublicPost)            // 检索已发布的博客
 		}
 	}
 }
@@ -0,0 +1,69 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	"github.com/loveRyujin/fast_blog/internal/pkg/server"
+)
+
+// NewJobServers 创建随 API 服务器一同运行的后台任务.
+func (c *ServerConfig) NewJobServers() []server.Server {
+	return []server.Server{
+		// 定时发布到达发布时间的博客
+		server.NewJobServer("publish-scheduled-posts", c.cfg.SchedulerInterval, c.publishScheduledPosts),
+		// 彻底删除注销冷静期已结束的用户、回收站中过期的博客、过期的刷新令牌和登录审计记录
+		server.NewJobServer("purge-trash", c.cfg.PurgeInterval, c.purgeTrash),
+	}
+}
+
+// publishScheduledPosts 发布所有到达发布时间的定时发布博客.
+func (c *ServerConfig) publishScheduledPosts(ctx context.Context) {
+	count, err := c.biz.PostV1().PublishScheduled(ctx)
+	if err != nil {
+		log.Errorw("Failed to publish scheduled posts", "err", err)
+		return
+	}
+
+	if count > 0 {
+		log.Infow("Published scheduled posts", "count", count)
+	}
+}
+
+// purgeTrash 彻底删除注销冷静期已结束的用户及其拥有的所有资源、在回收站中超过保留时长的博客、过期的刷新令牌以及超过保留时长的登录审计记录.
+func (c *ServerConfig) purgeTrash(ctx context.Context) {
+	count, err := c.biz.UserV1().PurgeDeleted(ctx)
+	if count > 0 {
+		log.Infow("Purged deleted users", "count", count)
+	}
+	if err != nil {
+		log.Errorw("Failed to purge deleted users", "err", err)
+	}
+
+	count, err = c.biz.PostV1().PurgeTrash(ctx)
+	if count > 0 {
+		log.Infow("Purged posts from trash", "count", count)
+	}
+	if err != nil {
+		log.Errorw("Failed to purge posts from trash", "err", err)
+	}
+
+	if err := c.biz.UserV1().PurgeExpiredTokens(ctx); err != nil {
+		log.Errorw("Failed to purge expired refresh tokens", "err", err)
+	}
+
+	if err := c.biz.UserV1().PurgeLoginAudits(ctx); err != nil {
+		log.Errorw("Failed to purge login audits", "err", err)
+	}
+}
+
+// reindexPosts 根据数据库中的所有文章重建全文索引.
+func (c *ServerConfig) reindexPosts(ctx context.Context) {
+	count, err := c.biz.PostV1().Reindex(ctx)
+	if err != nil {
+		log.Errorw("Failed to rebuild search index", "err", err)
+		return
+	}
+
+	log.Infow("Rebuilt search index", "count", count)
+}
@@ -0,0 +1,22 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// jwksPath 是公开验证 token 所需公钥的路径，其他服务可以通过该路径获取公钥验证本服务签发的 token.
+const jwksPath = "/.well-known/jwks.json"
+
+// serveJWKS 返回验证 token 使用的公钥集合，http 和 grpc-gateway 模式共用.
+// 允许客户端缓存一段时间，轮换密钥时新的公钥需要在签发前发布.
+func (c *ServerConfig) serveJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Header().Del("Expires")
+	if err := json.NewEncoder(w).Encode(c.tokens.JWKS()); err != nil {
+		log.Errorw("Failed to write JWKS response", "err", err)
+	}
+}
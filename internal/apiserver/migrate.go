@@ -0,0 +1,54 @@
+package apiserver
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/migrations"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	"github.com/loveRyujin/fast_blog/internal/pkg/migrate"
+	genericclioptions "github.com/loveRyujin/fast_blog/pkg/options"
+	"gorm.io/gorm"
+)
+
+// NewMigrator 创建当前存储后端的数据库迁移器.
+func (cfg *Config) NewMigrator(db *gorm.DB) (*migrate.Migrator, error) {
+	fsys, err := migrations.FS(cfg.DBOptions.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.New(db, fsys)
+}
+
+// migrate 执行所有未执行的数据库迁移.
+func (cfg *Config) migrate(db *gorm.DB) error {
+	migrator, err := cfg.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	return up(migrator)
+}
+
+// migrateAuthzDB 在内存存储模式下为保存授权策略的内存 SQLite 数据库执行迁移，写入默认的授权策略.
+func migrateAuthzDB(db *gorm.DB) error {
+	fsys, err := migrations.FS(genericclioptions.DBTypeSQLite)
+	if err != nil {
+		return err
+	}
+	migrator, err := migrate.New(db, fsys)
+	if err != nil {
+		return err
+	}
+
+	return up(migrator)
+}
+
+// up 执行所有未执行的迁移并记录日志.
+func up(migrator *migrate.Migrator) error {
+	done, err := migrator.Up(context.Background(), 0)
+	for _, m := range done {
+		log.Infow("Applied database migration", "version", m.Version, "name", m.Name)
+	}
+	return err
+}
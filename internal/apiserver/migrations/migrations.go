@@ -0,0 +1,23 @@
+// Package migrations 内嵌了 fb-apiserver 各存储后端的数据库迁移文件.
+//
+// 每个存储后端对应一个目录，新增迁移时需要为所有存储后端提供相同版本号的迁移文件，
+// 可以使用 fb-apiserver migrate create <name> 生成.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+//go:embed mysql sqlite postgresql
+var files embed.FS
+
+// FS 返回存储后端 dbType 对应的迁移文件.
+func FS(dbType string) (fs.FS, error) {
+	if _, err := fs.Stat(files, dbType); err != nil {
+		return nil, fmt.Errorf("no migrations for db type %s", dbType)
+	}
+
+	return fs.Sub(files, dbType)
+}
@@ -0,0 +1,133 @@
+-- 初始化 fast_blog 的 MySQL 表结构和初始数据，与原先的 MariaDB 导出文件 configs/fast_blog.sql 一致
+-- 使用 IF NOT EXISTS 和 INSERT IGNORE，已经导入过该导出文件的数据库也可以直接执行
+
+CREATE TABLE IF NOT EXISTS `casbin_rule` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `ptype` varchar(100) DEFAULT NULL,
+  `v0` varchar(100) DEFAULT NULL,
+  `v1` varchar(100) DEFAULT NULL,
+  `v2` varchar(100) DEFAULT NULL,
+  `v3` varchar(100) DEFAULT NULL,
+  `v4` varchar(100) DEFAULT NULL,
+  `v5` varchar(100) DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_casbin_rule` (`ptype`,`v0`,`v1`,`v2`,`v3`,`v4`,`v5`)
+) ENGINE=InnoDB DEFAULT CHARSET=latin1 COLLATE=latin1_swedish_ci;
+
+INSERT IGNORE INTO `casbin_rule` VALUES
+(18,'g','user-000000','role::admin',NULL,NULL,'',''),
+(21,'p','role::admin','*','*','allow','',''),
+(7,'p','role::user','/v1.FastBlog/DeleteUser','CALL','deny','',''),
+(8,'p','role::user','/v1.FastBlog/ListUser','CALL','deny','',''),
+(9,'p','role::user','/v1/users','GET','deny','',''),
+(10,'p','role::user','/v1/users/*','DELETE','deny','','');
+
+CREATE TABLE IF NOT EXISTS `category` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `categoryID` varchar(39) NOT NULL DEFAULT '' COMMENT '分类唯一 ID',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '分类名称',
+  `description` varchar(256) NOT NULL DEFAULT '' COMMENT '分类描述',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '分类创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '分类最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `category.categoryID` (`categoryID`),
+  UNIQUE KEY `category.userID.name` (`userID`,`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='分类表';
+
+CREATE TABLE IF NOT EXISTS `comment` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `commentID` varchar(38) NOT NULL DEFAULT '' COMMENT '评论唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '评论作者的用户唯一 ID',
+  `parentID` varchar(38) NOT NULL DEFAULT '' COMMENT '回复的评论 ID，为空表示直接评论博文',
+  `rootID` varchar(38) NOT NULL DEFAULT '' COMMENT '所属顶层评论 ID，顶层评论为空',
+  `content` text NOT NULL DEFAULT '' COMMENT '评论内容',
+  `status` tinyint(4) NOT NULL DEFAULT 0 COMMENT '审核状态：0-待审核，1-已通过，2-垃圾评论',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '评论创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '评论最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `comment.commentID` (`commentID`),
+  KEY `idx.comment.postID.rootID.status` (`postID`,`rootID`,`status`),
+  KEY `idx.comment.rootID` (`rootID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='评论表';
+
+CREATE TABLE IF NOT EXISTS `post` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '博文标题',
+  `content` longtext NOT NULL DEFAULT '' COMMENT '博文内容',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '博文创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '博文最后修改时间',
+  `status` tinyint(4) NOT NULL DEFAULT 0 COMMENT '博文状态：0-草稿，1-定时发布，2-已发布，3-已归档',
+  `publishedAt` datetime DEFAULT NULL COMMENT '博文发布时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post.postID` (`postID`),
+  KEY `idx.post.userID` (`userID`),
+  KEY `idx.post.status.publishedAt` (`status`,`publishedAt`),
+  FULLTEXT KEY `ft.post.title.content` (`title`,`content`) WITH PARSER ngram
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文表';
+
+CREATE TABLE IF NOT EXISTS `post_category` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `categoryID` varchar(39) NOT NULL DEFAULT '' COMMENT '分类唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '关联创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_category.postID.categoryID` (`postID`,`categoryID`),
+  KEY `idx.post_category.categoryID` (`categoryID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文分类关联表';
+
+CREATE TABLE IF NOT EXISTS `post_revision` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `version` bigint(20) NOT NULL DEFAULT 0 COMMENT '版本号，同一篇博文从 1 开始递增',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '该版本的博文标题',
+  `content` longtext NOT NULL DEFAULT '' COMMENT '该版本的博文内容',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '版本创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_revision.postID.version` (`postID`,`version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文历史版本表';
+
+CREATE TABLE IF NOT EXISTS `post_tag` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `tagID` varchar(34) NOT NULL DEFAULT '' COMMENT '标签唯一 ID',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '关联创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `post_tag.postID.tagID` (`postID`,`tagID`),
+  KEY `idx.post_tag.tagID` (`tagID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='博文标签关联表';
+
+CREATE TABLE IF NOT EXISTS `tag` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `tagID` varchar(34) NOT NULL DEFAULT '' COMMENT '标签唯一 ID',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '标签名称',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '标签创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '标签最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `tag.tagID` (`tagID`),
+  UNIQUE KEY `tag.userID.name` (`userID`,`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='标签表';
+
+CREATE TABLE IF NOT EXISTS `user` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `username` varchar(255) NOT NULL DEFAULT '' COMMENT '用户名（唯一）',
+  `password` varchar(255) NOT NULL DEFAULT '' COMMENT '用户密码（加密后）',
+  `nickname` varchar(30) NOT NULL DEFAULT '' COMMENT '用户昵称',
+  `email` varchar(256) NOT NULL DEFAULT '' COMMENT '用户电子邮箱地址',
+  `phone` varchar(16) NOT NULL DEFAULT '' COMMENT '用户手机号',
+  `disabled` tinyint(1) NOT NULL DEFAULT 0 COMMENT '用户是否被禁用',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '用户创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '用户最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user.userID` (`userID`),
+  UNIQUE KEY `user.username` (`username`),
+  UNIQUE KEY `user.phone` (`phone`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='用户表';
+
+INSERT IGNORE INTO `user` VALUES
+(96,'user-000000','root','$2a$10$ctsFXEUAMd7rXXpmccNlO.ZRiYGYz0eOfj8EicPGWqiz64YBBgR1y','colin404','colin404@foxmail.com','18110000000',0,'2024-12-12 03:55:25','2024-12-12 03:55:25');
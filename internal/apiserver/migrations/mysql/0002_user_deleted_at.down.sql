@@ -0,0 +1,4 @@
+-- 0002_user_deleted_at down
+ALTER TABLE `user`
+  DROP KEY `idx.user.deletedAt`,
+  DROP COLUMN `deletedAt`;
@@ -0,0 +1,5 @@
+-- 0002_user_deleted_at up
+-- 用户注销后先标记删除时间，冷静期结束后由后台任务彻底删除
+ALTER TABLE `user`
+  ADD COLUMN `deletedAt` datetime DEFAULT NULL COMMENT '用户注销时间，不为空表示已注销、等待彻底删除' AFTER `updatedAt`,
+  ADD KEY `idx.user.deletedAt` (`deletedAt`);
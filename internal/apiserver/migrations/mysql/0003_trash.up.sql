@@ -0,0 +1,14 @@
+-- 0003_trash up
+-- 删除的博文先移入回收站，可以恢复，超过保留期限后由后台任务彻底删除
+ALTER TABLE `post`
+  ADD COLUMN `deletedAt` datetime DEFAULT NULL COMMENT '博文删除时间，不为空表示已移入回收站' AFTER `publishedAt`,
+  ADD KEY `idx.post.deletedAt` (`deletedAt`);
+
+-- 只有管理员可以管理已注销的用户
+INSERT IGNORE INTO `casbin_rule` (`ptype`,`v0`,`v1`,`v2`,`v3`,`v4`,`v5`) VALUES
+('p','role::user','/v1.FastBlog/ListTrashUser','CALL','deny','',''),
+('p','role::user','/v1.FastBlog/RestoreUser','CALL','deny','',''),
+('p','role::user','/v1.FastBlog/PurgeUser','CALL','deny','',''),
+('p','role::user','/v1/trash/users','GET','deny','',''),
+('p','role::user','/v1/trash/users/*','POST','deny','',''),
+('p','role::user','/v1/trash/users/*','DELETE','deny','','');
@@ -0,0 +1,3 @@
+-- 0004_keyset_pagination down
+ALTER TABLE `user` DROP KEY `idx.user.createdAt`;
+ALTER TABLE `post` DROP KEY `idx.post.userID.createdAt`;
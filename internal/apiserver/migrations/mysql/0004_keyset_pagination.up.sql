@@ -0,0 +1,4 @@
+-- 0004_keyset_pagination up
+-- 按 (createdAt, id) 倒序的键集分页使用的索引
+ALTER TABLE `post` ADD KEY `idx.post.userID.createdAt` (`userID`,`createdAt`,`id`);
+ALTER TABLE `user` ADD KEY `idx.user.createdAt` (`createdAt`,`id`);
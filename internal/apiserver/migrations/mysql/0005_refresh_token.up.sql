@@ -0,0 +1,17 @@
+-- 0005_refresh_token up
+-- 刷新令牌只保存摘要，使用后轮换，同一家族的令牌被重复使用时撤销整个家族
+CREATE TABLE IF NOT EXISTS `refresh_token` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `familyID` varchar(36) NOT NULL DEFAULT '' COMMENT '令牌家族 ID，同一次登录轮换出的令牌属于同一家族',
+  `tokenHash` char(64) NOT NULL DEFAULT '' COMMENT '令牌的 SHA-256 摘要',
+  `expiresAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '令牌过期时间',
+  `usedAt` datetime DEFAULT NULL COMMENT '令牌被轮换的时间，不为空表示已使用',
+  `revokedAt` datetime DEFAULT NULL COMMENT '令牌被撤销的时间，不为空表示已撤销',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '令牌签发时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `refresh_token.tokenHash` (`tokenHash`),
+  KEY `idx.refresh_token.familyID` (`familyID`),
+  KEY `idx.refresh_token.userID` (`userID`),
+  KEY `idx.refresh_token.expiresAt` (`expiresAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='刷新令牌表';
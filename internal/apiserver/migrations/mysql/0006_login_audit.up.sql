@@ -0,0 +1,15 @@
+-- 0006_login_audit up
+-- 记录失败的登录尝试，用于审计暴力破解和撞库行为
+CREATE TABLE IF NOT EXISTS `login_audit` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `username` varchar(255) NOT NULL DEFAULT '' COMMENT '登录时提交的用户名',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID，用户不存在时为空',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT '客户端 IP',
+  `userAgent` varchar(512) NOT NULL DEFAULT '' COMMENT '客户端 User-Agent',
+  `reason` varchar(32) NOT NULL DEFAULT '' COMMENT '登录失败的原因',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '登录尝试时间',
+  PRIMARY KEY (`id`),
+  KEY `idx.login_audit.username` (`username`),
+  KEY `idx.login_audit.ip` (`ip`),
+  KEY `idx.login_audit.createdAt` (`createdAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='登录失败审计表';
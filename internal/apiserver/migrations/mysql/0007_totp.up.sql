@@ -0,0 +1,23 @@
+-- 0007_totp up
+-- 两步验证：每个用户最多一个 TOTP 密钥，确认后启用；恢复码只保存摘要，每个只能使用一次
+CREATE TABLE IF NOT EXISTS `user_totp` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `secret` varchar(64) NOT NULL DEFAULT '' COMMENT 'base32 编码的 TOTP 密钥',
+  `enabled` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否已确认并启用两步验证',
+  `lastUsedStep` bigint(20) NOT NULL DEFAULT 0 COMMENT '最后一次使用的验证码时间步，用于拒绝重复使用的验证码',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `user_totp.userID` (`userID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='两步验证表';
+
+CREATE TABLE IF NOT EXISTS `recovery_code` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `codeHash` char(64) NOT NULL DEFAULT '' COMMENT '恢复码的 SHA-256 摘要',
+  `usedAt` datetime DEFAULT NULL COMMENT '恢复码被使用的时间，不为空表示已使用',
+  `createdAt` datetime NOT NULL DEFAULT current_timestamp() COMMENT '创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `recovery_code.userID.codeHash` (`userID`,`codeHash`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb3 COLLATE=utf8mb3_general_ci COMMENT='两步验证恢复码表';
@@ -0,0 +1,4 @@
+-- 0008_self_delete_user down
+INSERT IGNORE INTO `casbin_rule` (`ptype`,`v0`,`v1`,`v2`,`v3`,`v4`,`v5`) VALUES
+('p','role::user','/v1.FastBlog/DeleteUser','CALL','deny','',''),
+('p','role::user','/v1/users/*','DELETE','deny','','');
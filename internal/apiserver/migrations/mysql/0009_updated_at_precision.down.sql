@@ -0,0 +1,5 @@
+-- 0009_updated_at_precision down
+ALTER TABLE `user`
+  MODIFY `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '用户最后修改时间';
+ALTER TABLE `post`
+  MODIFY `updatedAt` datetime NOT NULL DEFAULT current_timestamp() ON UPDATE current_timestamp() COMMENT '博文最后修改时间';
@@ -0,0 +1,6 @@
+-- 0009_updated_at_precision up
+-- 文章和用户的 ETag 根据最后修改时间生成，保存到微秒才能保证每次修改后 ETag 都会变化
+ALTER TABLE `post`
+  MODIFY `updatedAt` datetime(6) NOT NULL DEFAULT current_timestamp(6) ON UPDATE current_timestamp(6) COMMENT '博文最后修改时间';
+ALTER TABLE `user`
+  MODIFY `updatedAt` datetime(6) NOT NULL DEFAULT current_timestamp(6) ON UPDATE current_timestamp(6) COMMENT '用户最后修改时间';
@@ -0,0 +1,4 @@
+-- 0010_admin_list_users down
+INSERT IGNORE INTO `casbin_rule` (`ptype`,`v0`,`v1`,`v2`,`v3`,`v4`,`v5`) VALUES
+('p','role::user','/v1.FastBlog/ListUser','CALL','deny','',''),
+('p','role::user','/v1/users','GET','deny','','');
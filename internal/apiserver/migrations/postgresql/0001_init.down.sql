@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS casbin_rule;
+DROP TABLE IF EXISTS category;
+DROP TABLE IF EXISTS comment;
+DROP TABLE IF EXISTS post;
+DROP TABLE IF EXISTS post_category;
+DROP TABLE IF EXISTS post_revision;
+DROP TABLE IF EXISTS post_tag;
+DROP TABLE IF EXISTS tag;
+DROP TABLE IF EXISTS "user";
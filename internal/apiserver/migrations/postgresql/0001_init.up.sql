@@ -0,0 +1,136 @@
+-- 初始化 fast_blog 的 PostgreSQL 表结构和初始数据
+-- 驼峰命名的列名需要使用双引号，否则 PostgreSQL 会将其转换为小写
+
+CREATE TABLE IF NOT EXISTS casbin_rule (
+  id bigserial PRIMARY KEY,
+  ptype varchar(100) DEFAULT NULL,
+  v0 varchar(100) DEFAULT NULL,
+  v1 varchar(100) DEFAULT NULL,
+  v2 varchar(100) DEFAULT NULL,
+  v3 varchar(100) DEFAULT NULL,
+  v4 varchar(100) DEFAULT NULL,
+  v5 varchar(100) DEFAULT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_casbin_rule ON casbin_rule (ptype,v0,v1,v2,v3,v4,v5);
+
+INSERT INTO casbin_rule VALUES
+(18,'g','user-000000','role::admin',NULL,NULL,'',''),
+(21,'p','role::admin','*','*','allow','',''),
+(7,'p','role::user','/v1.FastBlog/DeleteUser','CALL','deny','',''),
+(8,'p','role::user','/v1.FastBlog/ListUser','CALL','deny','',''),
+(9,'p','role::user','/v1/users','GET','deny','',''),
+(10,'p','role::user','/v1/users/*','DELETE','deny','','')
+ON CONFLICT DO NOTHING;
+SELECT setval(pg_get_serial_sequence('casbin_rule', 'id'), (SELECT MAX(id) FROM casbin_rule));
+
+CREATE TABLE IF NOT EXISTS category (
+  id bigserial PRIMARY KEY,
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  "categoryID" varchar(39) NOT NULL DEFAULT '',
+  name varchar(64) NOT NULL DEFAULT '',
+  description varchar(256) NOT NULL DEFAULT '',
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updatedAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "category.categoryID" ON category ("categoryID");
+CREATE UNIQUE INDEX IF NOT EXISTS "category.userID.name" ON category ("userID",name);
+COMMENT ON TABLE category IS '分类表';
+
+CREATE TABLE IF NOT EXISTS comment (
+  id bigserial PRIMARY KEY,
+  "commentID" varchar(38) NOT NULL DEFAULT '',
+  "postID" varchar(35) NOT NULL DEFAULT '',
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  "parentID" varchar(38) NOT NULL DEFAULT '',
+  "rootID" varchar(38) NOT NULL DEFAULT '',
+  content text NOT NULL DEFAULT '',
+  status smallint NOT NULL DEFAULT 0,
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updatedAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "comment.commentID" ON comment ("commentID");
+CREATE INDEX IF NOT EXISTS "idx.comment.postID.rootID.status" ON comment ("postID","rootID",status);
+CREATE INDEX IF NOT EXISTS "idx.comment.rootID" ON comment ("rootID");
+COMMENT ON TABLE comment IS '评论表';
+
+CREATE TABLE IF NOT EXISTS post (
+  id bigserial PRIMARY KEY,
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  "postID" varchar(35) NOT NULL DEFAULT '',
+  title varchar(256) NOT NULL DEFAULT '',
+  content text NOT NULL DEFAULT '',
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updatedAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  status smallint NOT NULL DEFAULT 0,
+  "publishedAt" timestamp DEFAULT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "post.postID" ON post ("postID");
+CREATE INDEX IF NOT EXISTS "idx.post.userID" ON post ("userID");
+CREATE INDEX IF NOT EXISTS "idx.post.status.publishedAt" ON post (status,"publishedAt");
+COMMENT ON TABLE post IS '博文表';
+
+CREATE TABLE IF NOT EXISTS post_category (
+  id bigserial PRIMARY KEY,
+  "postID" varchar(35) NOT NULL DEFAULT '',
+  "categoryID" varchar(39) NOT NULL DEFAULT '',
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "post_category.postID.categoryID" ON post_category ("postID","categoryID");
+CREATE INDEX IF NOT EXISTS "idx.post_category.categoryID" ON post_category ("categoryID");
+COMMENT ON TABLE post_category IS '博文分类关联表';
+
+CREATE TABLE IF NOT EXISTS post_revision (
+  id bigserial PRIMARY KEY,
+  "postID" varchar(35) NOT NULL DEFAULT '',
+  version bigint NOT NULL DEFAULT 0,
+  title varchar(256) NOT NULL DEFAULT '',
+  content text NOT NULL DEFAULT '',
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "post_revision.postID.version" ON post_revision ("postID",version);
+COMMENT ON TABLE post_revision IS '博文历史版本表';
+
+CREATE TABLE IF NOT EXISTS post_tag (
+  id bigserial PRIMARY KEY,
+  "postID" varchar(35) NOT NULL DEFAULT '',
+  "tagID" varchar(34) NOT NULL DEFAULT '',
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "post_tag.postID.tagID" ON post_tag ("postID","tagID");
+CREATE INDEX IF NOT EXISTS "idx.post_tag.tagID" ON post_tag ("tagID");
+COMMENT ON TABLE post_tag IS '博文标签关联表';
+
+CREATE TABLE IF NOT EXISTS tag (
+  id bigserial PRIMARY KEY,
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  "tagID" varchar(34) NOT NULL DEFAULT '',
+  name varchar(64) NOT NULL DEFAULT '',
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updatedAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "tag.tagID" ON tag ("tagID");
+CREATE UNIQUE INDEX IF NOT EXISTS "tag.userID.name" ON tag ("userID",name);
+COMMENT ON TABLE tag IS '标签表';
+
+-- user 是 PostgreSQL 的保留字，表名需要使用双引号
+CREATE TABLE IF NOT EXISTS "user" (
+  id bigserial PRIMARY KEY,
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  username varchar(255) NOT NULL DEFAULT '',
+  password varchar(255) NOT NULL DEFAULT '',
+  nickname varchar(30) NOT NULL DEFAULT '',
+  email varchar(256) NOT NULL DEFAULT '',
+  phone varchar(16) NOT NULL DEFAULT '',
+  disabled boolean NOT NULL DEFAULT false,
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updatedAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "user.userID" ON "user" ("userID");
+CREATE UNIQUE INDEX IF NOT EXISTS "user.username" ON "user" (username);
+CREATE UNIQUE INDEX IF NOT EXISTS "user.phone" ON "user" (phone);
+COMMENT ON TABLE "user" IS '用户表';
+
+INSERT INTO "user" VALUES
+(96,'user-000000','root','$2a$10$ctsFXEUAMd7rXXpmccNlO.ZRiYGYz0eOfj8EicPGWqiz64YBBgR1y','colin404','colin404@foxmail.com','18110000000',false,'2024-12-12 03:55:25','2024-12-12 03:55:25')
+ON CONFLICT DO NOTHING;
+SELECT setval(pg_get_serial_sequence('"user"', 'id'), (SELECT MAX(id) FROM "user"));
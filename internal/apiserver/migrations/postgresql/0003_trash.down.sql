@@ -0,0 +1,5 @@
+-- 0003_trash down
+DELETE FROM casbin_rule WHERE ptype = 'p' AND v0 = 'role::user' AND (v1 IN ('/v1.FastBlog/ListTrashUser','/v1.FastBlog/RestoreUser','/v1.FastBlog/PurgeUser') OR v1 LIKE '/v1/trash/users%');
+
+DROP INDEX IF EXISTS "idx.post.deletedAt";
+ALTER TABLE post DROP COLUMN IF EXISTS "deletedAt";
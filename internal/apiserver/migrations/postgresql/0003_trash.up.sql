@@ -0,0 +1,14 @@
+-- 0003_trash up
+-- 删除的博文先移入回收站，可以恢复，超过保留期限后由后台任务彻底删除
+ALTER TABLE post ADD COLUMN IF NOT EXISTS "deletedAt" timestamp DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS "idx.post.deletedAt" ON post ("deletedAt");
+
+-- 只有管理员可以管理已注销的用户
+INSERT INTO casbin_rule (ptype, v0, v1, v2, v3, v4, v5) VALUES
+('p','role::user','/v1.FastBlog/ListTrashUser','CALL','deny','',''),
+('p','role::user','/v1.FastBlog/RestoreUser','CALL','deny','',''),
+('p','role::user','/v1.FastBlog/PurgeUser','CALL','deny','',''),
+('p','role::user','/v1/trash/users','GET','deny','',''),
+('p','role::user','/v1/trash/users/*','POST','deny','',''),
+('p','role::user','/v1/trash/users/*','DELETE','deny','','')
+ON CONFLICT DO NOTHING;
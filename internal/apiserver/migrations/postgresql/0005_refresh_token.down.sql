@@ -0,0 +1,2 @@
+-- 0005_refresh_token down
+DROP TABLE IF EXISTS refresh_token;
@@ -0,0 +1,17 @@
+-- 0005_refresh_token up
+-- 刷新令牌只保存摘要，使用后轮换，同一家族的令牌被重复使用时撤销整个家族
+CREATE TABLE IF NOT EXISTS refresh_token (
+  id bigserial PRIMARY KEY,
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  "familyID" varchar(36) NOT NULL DEFAULT '',
+  "tokenHash" char(64) NOT NULL DEFAULT '',
+  "expiresAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "usedAt" timestamp DEFAULT NULL,
+  "revokedAt" timestamp DEFAULT NULL,
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "refresh_token.tokenHash" ON refresh_token ("tokenHash");
+CREATE INDEX IF NOT EXISTS "idx.refresh_token.familyID" ON refresh_token ("familyID");
+CREATE INDEX IF NOT EXISTS "idx.refresh_token.userID" ON refresh_token ("userID");
+CREATE INDEX IF NOT EXISTS "idx.refresh_token.expiresAt" ON refresh_token ("expiresAt");
+COMMENT ON TABLE refresh_token IS '刷新令牌表';
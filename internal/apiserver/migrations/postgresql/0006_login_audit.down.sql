@@ -0,0 +1,2 @@
+-- 0006_login_audit down
+DROP TABLE IF EXISTS login_audit;
@@ -0,0 +1,23 @@
+-- 0007_totp up
+-- 两步验证：每个用户最多一个 TOTP 密钥，确认后启用；恢复码只保存摘要，每个只能使用一次
+CREATE TABLE IF NOT EXISTS user_totp (
+  id bigserial PRIMARY KEY,
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  secret varchar(64) NOT NULL DEFAULT '',
+  enabled boolean NOT NULL DEFAULT false,
+  "lastUsedStep" bigint NOT NULL DEFAULT 0,
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "updatedAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "user_totp.userID" ON user_totp ("userID");
+COMMENT ON TABLE user_totp IS '两步验证表';
+
+CREATE TABLE IF NOT EXISTS recovery_code (
+  id bigserial PRIMARY KEY,
+  "userID" varchar(36) NOT NULL DEFAULT '',
+  "codeHash" char(64) NOT NULL DEFAULT '',
+  "usedAt" timestamp DEFAULT NULL,
+  "createdAt" timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "recovery_code.userID.codeHash" ON recovery_code ("userID", "codeHash");
+COMMENT ON TABLE recovery_code IS '两步验证恢复码表';
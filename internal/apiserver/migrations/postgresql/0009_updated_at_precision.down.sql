@@ -0,0 +1,2 @@
+-- 0009_updated_at_precision down
+-- 与 MySQL 的迁移版本保持一致，不需要修改
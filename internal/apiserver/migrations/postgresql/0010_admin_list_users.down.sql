@@ -0,0 +1,5 @@
+-- 0010_admin_list_users down
+INSERT INTO casbin_rule (ptype, v0, v1, v2, v3, v4, v5) VALUES
+('p','role::user','/v1.FastBlog/ListUser','CALL','deny','',''),
+('p','role::user','/v1/users','GET','deny','','')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,9 @@
+-- 0011_admin_trash_users down
+INSERT INTO casbin_rule (ptype, v0, v1, v2, v3, v4, v5) VALUES
+('p','role::user','/v1.FastBlog/ListTrashUser','CALL','deny','',''),
+('p','role::user','/v1.FastBlog/RestoreUser','CALL','deny','',''),
+('p','role::user','/v1.FastBlog/PurgeUser','CALL','deny','',''),
+('p','role::user','/v1/trash/users','GET','deny','',''),
+('p','role::user','/v1/trash/users/*','POST','deny','',''),
+('p','role::user','/v1/trash/users/*','DELETE','deny','','')
+ON CONFLICT DO NOTHING;
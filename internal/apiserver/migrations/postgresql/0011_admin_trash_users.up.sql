@@ -0,0 +1,3 @@
+-- 0011_admin_trash_users up
+-- 任意拥有管理员角色的用户都可以管理已注销的用户，普通用户由业务层拒绝
+DELETE FROM casbin_rule WHERE ptype = 'p' AND v0 = 'role::user' AND (v1 IN ('/v1.FastBlog/ListTrashUser','/v1.FastBlog/RestoreUser','/v1.FastBlog/PurgeUser') OR v1 LIKE '/v1/trash/users%');
@@ -0,0 +1,123 @@
+-- 初始化 fast_blog 的 SQLite 表结构和初始数据
+
+-- casbin_rule 的列类型与 gorm-adapter 在 SQLite 上自动创建的表保持一致，避免其启动时修改表结构
+CREATE TABLE IF NOT EXISTS `casbin_rule` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `ptype` text,
+  `v0` text,
+  `v1` text,
+  `v2` text,
+  `v3` text,
+  `v4` text,
+  `v5` text
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_casbin_rule` ON `casbin_rule` (`ptype`,`v0`,`v1`,`v2`,`v3`,`v4`,`v5`);
+
+INSERT OR IGNORE INTO `casbin_rule` VALUES
+(18,'g','user-000000','role::admin',NULL,NULL,'',''),
+(21,'p','role::admin','*','*','allow','',''),
+(7,'p','role::user','/v1.FastBlog/DeleteUser','CALL','deny','',''),
+(8,'p','role::user','/v1.FastBlog/ListUser','CALL','deny','',''),
+(9,'p','role::user','/v1/users','GET','deny','',''),
+(10,'p','role::user','/v1/users/*','DELETE','deny','','');
+
+CREATE TABLE IF NOT EXISTS `category` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '',
+  `categoryID` varchar(39) NOT NULL DEFAULT '',
+  `name` varchar(64) NOT NULL DEFAULT '',
+  `description` varchar(256) NOT NULL DEFAULT '',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `category.categoryID` ON `category` (`categoryID`);
+CREATE UNIQUE INDEX IF NOT EXISTS `category.userID.name` ON `category` (`userID`,`name`);
+
+CREATE TABLE IF NOT EXISTS `comment` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `commentID` varchar(38) NOT NULL DEFAULT '',
+  `postID` varchar(35) NOT NULL DEFAULT '',
+  `userID` varchar(36) NOT NULL DEFAULT '',
+  `parentID` varchar(38) NOT NULL DEFAULT '',
+  `rootID` varchar(38) NOT NULL DEFAULT '',
+  `content` text NOT NULL DEFAULT '',
+  `status` tinyint NOT NULL DEFAULT 0,
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `comment.commentID` ON `comment` (`commentID`);
+CREATE INDEX IF NOT EXISTS `idx.comment.postID.rootID.status` ON `comment` (`postID`,`rootID`,`status`);
+CREATE INDEX IF NOT EXISTS `idx.comment.rootID` ON `comment` (`rootID`);
+
+CREATE TABLE IF NOT EXISTS `post` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '',
+  `postID` varchar(35) NOT NULL DEFAULT '',
+  `title` varchar(256) NOT NULL DEFAULT '',
+  `content` text NOT NULL DEFAULT '',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `status` tinyint NOT NULL DEFAULT 0,
+  `publishedAt` datetime DEFAULT NULL
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `post.postID` ON `post` (`postID`);
+CREATE INDEX IF NOT EXISTS `idx.post.userID` ON `post` (`userID`);
+CREATE INDEX IF NOT EXISTS `idx.post.status.publishedAt` ON `post` (`status`,`publishedAt`);
+
+CREATE TABLE IF NOT EXISTS `post_category` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '',
+  `categoryID` varchar(39) NOT NULL DEFAULT '',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `post_category.postID.categoryID` ON `post_category` (`postID`,`categoryID`);
+CREATE INDEX IF NOT EXISTS `idx.post_category.categoryID` ON `post_category` (`categoryID`);
+
+CREATE TABLE IF NOT EXISTS `post_revision` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '',
+  `version` bigint NOT NULL DEFAULT 0,
+  `title` varchar(256) NOT NULL DEFAULT '',
+  `content` text NOT NULL DEFAULT '',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `post_revision.postID.version` ON `post_revision` (`postID`,`version`);
+
+CREATE TABLE IF NOT EXISTS `post_tag` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '',
+  `tagID` varchar(34) NOT NULL DEFAULT '',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `post_tag.postID.tagID` ON `post_tag` (`postID`,`tagID`);
+CREATE INDEX IF NOT EXISTS `idx.post_tag.tagID` ON `post_tag` (`tagID`);
+
+CREATE TABLE IF NOT EXISTS `tag` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '',
+  `tagID` varchar(34) NOT NULL DEFAULT '',
+  `name` varchar(64) NOT NULL DEFAULT '',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `tag.tagID` ON `tag` (`tagID`);
+CREATE UNIQUE INDEX IF NOT EXISTS `tag.userID.name` ON `tag` (`userID`,`name`);
+
+CREATE TABLE IF NOT EXISTS `user` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '',
+  `username` varchar(255) NOT NULL DEFAULT '',
+  `password` varchar(255) NOT NULL DEFAULT '',
+  `nickname` varchar(30) NOT NULL DEFAULT '',
+  `email` varchar(256) NOT NULL DEFAULT '',
+  `phone` varchar(16) NOT NULL DEFAULT '',
+  `disabled` boolean NOT NULL DEFAULT 0,
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `user.userID` ON `user` (`userID`);
+CREATE UNIQUE INDEX IF NOT EXISTS `user.username` ON `user` (`username`);
+CREATE UNIQUE INDEX IF NOT EXISTS `user.phone` ON `user` (`phone`);
+
+INSERT OR IGNORE INTO `user` VALUES
+(96,'user-000000','root','$2a$10$ctsFXEUAMd7rXXpmccNlO.ZRiYGYz0eOfj8EicPGWqiz64YBBgR1y','colin404','colin404@foxmail.com','18110000000',0,'2024-12-12 03:55:25','2024-12-12 03:55:25');
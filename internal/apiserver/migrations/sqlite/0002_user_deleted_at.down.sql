@@ -0,0 +1,3 @@
+-- 0002_user_deleted_at down
+DROP INDEX IF EXISTS `idx.user.deletedAt`;
+ALTER TABLE `user` DROP COLUMN `deletedAt`;
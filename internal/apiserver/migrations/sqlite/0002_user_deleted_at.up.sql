@@ -0,0 +1,4 @@
+-- 0002_user_deleted_at up
+-- 用户注销后先标记删除时间，冷静期结束后由后台任务彻底删除
+ALTER TABLE `user` ADD COLUMN `deletedAt` datetime DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS `idx.user.deletedAt` ON `user` (`deletedAt`);
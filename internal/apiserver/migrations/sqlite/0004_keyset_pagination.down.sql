@@ -0,0 +1,3 @@
+-- 0004_keyset_pagination down
+DROP INDEX IF EXISTS `idx.user.createdAt`;
+DROP INDEX IF EXISTS `idx.post.userID.createdAt`;
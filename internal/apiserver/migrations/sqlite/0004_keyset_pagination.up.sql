@@ -0,0 +1,4 @@
+-- 0004_keyset_pagination up
+-- 按 (createdAt, id) 倒序的键集分页使用的索引
+CREATE INDEX IF NOT EXISTS `idx.post.userID.createdAt` ON `post` (`userID`,`createdAt`,`id`);
+CREATE INDEX IF NOT EXISTS `idx.user.createdAt` ON `user` (`createdAt`,`id`);
@@ -0,0 +1,14 @@
+-- 0006_login_audit up
+-- 记录失败的登录尝试，用于审计暴力破解和撞库行为
+CREATE TABLE IF NOT EXISTS `login_audit` (
+  `id` integer PRIMARY KEY AUTOINCREMENT,
+  `username` varchar(255) NOT NULL DEFAULT '',
+  `userID` varchar(36) NOT NULL DEFAULT '',
+  `ip` varchar(64) NOT NULL DEFAULT '',
+  `userAgent` varchar(512) NOT NULL DEFAULT '',
+  `reason` varchar(32) NOT NULL DEFAULT '',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS `idx.login_audit.username` ON `login_audit` (`username`);
+CREATE INDEX IF NOT EXISTS `idx.login_audit.ip` ON `login_audit` (`ip`);
+CREATE INDEX IF NOT EXISTS `idx.login_audit.createdAt` ON `login_audit` (`createdAt`);
@@ -0,0 +1,3 @@
+-- 0007_totp down
+DROP TABLE IF EXISTS `recovery_code`;
+DROP TABLE IF EXISTS `user_totp`;
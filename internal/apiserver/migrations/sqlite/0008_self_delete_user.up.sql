@@ -0,0 +1,5 @@
+-- 0008_self_delete_user up
+-- 普通用户可以注销自己的账号，不能操作其他用户由业务层校验
+DELETE FROM `casbin_rule` WHERE `ptype` = 'p' AND `v0` = 'role::user' AND (
+  (`v1` = '/v1.FastBlog/DeleteUser' AND `v2` = 'CALL') OR (`v1` = '/v1/users/*' AND `v2` = 'DELETE')
+);
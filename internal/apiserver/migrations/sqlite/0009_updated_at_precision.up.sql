@@ -0,0 +1,2 @@
+-- 0009_updated_at_precision up
+-- 与 MySQL 的迁移版本保持一致，该存储的最后修改时间至少精确到微秒，不需要修改
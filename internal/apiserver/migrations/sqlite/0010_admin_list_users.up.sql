@@ -0,0 +1,6 @@
+-- 0010_admin_list_users up
+-- 任意拥有管理员角色的用户都可以查询用户列表，普通用户由业务层拒绝
+-- 所有用户都拥有普通用户角色，按角色拒绝会让新授予的管理员也无法访问
+DELETE FROM `casbin_rule` WHERE `ptype` = 'p' AND `v0` = 'role::user' AND (
+  (`v1` = '/v1.FastBlog/ListUser' AND `v2` = 'CALL') OR (`v1` = '/v1/users' AND `v2` = 'GET')
+);
@@ -19,3 +19,24 @@ func (m *User) AfterCreate(tx *gorm.DB) error {
 
 	return tx.Save(m).Error
 }
+
+// AfterCreate 在创建数据库记录之后生成 tagID.
+func (m *Tag) AfterCreate(tx *gorm.DB) error {
+	m.TagID = rid.TagID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 categoryID.
+func (m *Category) AfterCreate(tx *gorm.DB) error {
+	m.CategoryID = rid.CategoryID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 commentID.
+func (m *Comment) AfterCreate(tx *gorm.DB) error {
+	m.CommentID = rid.CommentID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
@@ -6,19 +6,24 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 const TableNamePost = "post"
 
 // Post 博文表
 type Post struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	UserID    string    `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                    // 用户唯一 ID
-	PostID    string    `gorm:"column:postID;not null;comment:博文唯一 ID" json:"postID"`                                    // 博文唯一 ID
-	Title     string    `gorm:"column:title;not null;comment:博文标题" json:"title"`                                         // 博文标题
-	Content   string    `gorm:"column:content;not null;comment:博文内容" json:"content"`                                     // 博文内容
-	CreatedAt time.Time `gorm:"column:createdAt;not null;default:current_timestamp();comment:博文创建时间" json:"createdAt"`   // 博文创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;not null;default:current_timestamp();comment:博文最后修改时间" json:"updatedAt"` // 博文最后修改时间
+	ID          int64          `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	UserID      string         `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                    // 用户唯一 ID
+	PostID      string         `gorm:"column:postID;not null;comment:博文唯一 ID" json:"postID"`                                    // 博文唯一 ID
+	Title       string         `gorm:"column:title;not null;comment:博文标题" json:"title"`                                         // 博文标题
+	Content     string         `gorm:"column:content;not null;comment:博文内容" json:"content"`                                     // 博文内容
+	CreatedAt   time.Time      `gorm:"column:createdAt;not null;default:current_timestamp();comment:博文创建时间" json:"createdAt"`   // 博文创建时间
+	UpdatedAt   time.Time      `gorm:"column:updatedAt;not null;default:current_timestamp();comment:博文最后修改时间" json:"updatedAt"` // 博文最后修改时间
+	Status      int32          `gorm:"column:status;not null;comment:博文状态：0-草稿，1-定时发布，2-已发布，3-已归档" json:"status"`               // 博文状态：0-草稿，1-定时发布，2-已发布，3-已归档
+	PublishedAt *time.Time     `gorm:"column:publishedAt;comment:博文发布时间" json:"publishedAt"`                                    // 博文发布时间
+	DeletedAt   gorm.DeletedAt `gorm:"column:deletedAt;comment:博文删除时间，不为空表示已移入回收站" json:"deletedAt"`                            // 博文删除时间，不为空表示已移入回收站
 }
 
 // TableName Post's table name
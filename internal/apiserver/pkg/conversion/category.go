@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// CategoryodelToCategoryV1 将模型层的 Category（分类模型对象）转换为 Protobuf 层的 Category（v1 分类对象）.
+func CategoryodelToCategoryV1(categoryModel *model.Category) *apiv1.Category {
+	var protoCategory apiv1.Category
+	_ = core.CopyWithConverters(&protoCategory, categoryModel)
+	return &protoCategory
+}
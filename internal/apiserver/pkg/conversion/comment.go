@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// CommentodelToCommentV1 将模型层的 Comment（评论模型对象）转换为 Protobuf 层的 Comment（v1 评论对象）.
+func CommentodelToCommentV1(commentModel *model.Comment) *apiv1.Comment {
+	var protoComment apiv1.Comment
+	_ = core.CopyWithConverters(&protoComment, commentModel)
+	return &protoComment
+}
@@ -3,6 +3,8 @@ package conversion
 import (
 	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
 	"github.com/onexstack/onexstack/pkg/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 
 	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
 )
@@ -11,6 +13,14 @@ import (
 func PostodelToPostV1(postModel *model.Post) *apiv1.Post {
 	var protoPost apiv1.Post
 	_ = core.CopyWithConverters(&protoPost, postModel)
+	// copier 无法转换 *time.Time 和 gorm.DeletedAt 类型的字段，需要单独处理
+	if postModel.PublishedAt != nil {
+		protoPost.PublishedAt = timestamppb.New(*postModel.PublishedAt)
+	}
+	protoPost.DeletedAt = nil
+	if postModel.DeletedAt.Valid {
+		protoPost.DeletedAt = timestamppb.New(postModel.DeletedAt.Time)
+	}
 	return &protoPost
 }
 
@@ -18,5 +28,13 @@ func PostodelToPostV1(postModel *model.Post) *apiv1.Post {
 func PostV1ToPostodel(protoPost *apiv1.Post) *model.Post {
 	var postModel model.Post
 	_ = core.CopyWithConverters(&postModel, protoPost)
+	if protoPost.PublishedAt != nil {
+		publishedAt := protoPost.PublishedAt.AsTime()
+		postModel.PublishedAt = &publishedAt
+	}
+	postModel.DeletedAt = gorm.DeletedAt{}
+	if protoPost.DeletedAt != nil {
+		postModel.DeletedAt = gorm.DeletedAt{Time: protoPost.DeletedAt.AsTime(), Valid: true}
+	}
 	return &postModel
 }
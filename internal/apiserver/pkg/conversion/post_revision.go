@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// PostRevisionodelToPostRevisionV1 将模型层的 PostRevision（博客历史版本模型对象）转换为 Protobuf 层的 PostRevision（v1 博客历史版本对象）.
+func PostRevisionodelToPostRevisionV1(revisionModel *model.PostRevision) *apiv1.PostRevision {
+	var protoRevision apiv1.PostRevision
+	_ = core.CopyWithConverters(&protoRevision, revisionModel)
+	return &protoRevision
+}
@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/onexstack/onexstack/pkg/core"
+
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+// TagodelToTagV1 将模型层的 Tag（标签模型对象）转换为 Protobuf 层的 Tag（v1 标签对象）.
+func TagodelToTagV1(tagModel *model.Tag) *apiv1.Tag {
+	var protoTag apiv1.Tag
+	_ = core.CopyWithConverters(&protoTag, tagModel)
+	return &protoTag
+}
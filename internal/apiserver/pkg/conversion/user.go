@@ -3,6 +3,8 @@ package conversion
 import (
 	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
 	"github.com/onexstack/onexstack/pkg/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 
 	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
 )
@@ -11,6 +13,11 @@ import (
 func UserodelToUserV1(userModel *model.User) *apiv1.User {
 	var protoUser apiv1.User
 	_ = core.CopyWithConverters(&protoUser, userModel)
+	// copier 无法转换 gorm.DeletedAt 类型的字段，需要单独处理
+	protoUser.DeletedAt = nil
+	if userModel.DeletedAt.Valid {
+		protoUser.DeletedAt = timestamppb.New(userModel.DeletedAt.Time)
+	}
 	return &protoUser
 }
 
@@ -18,5 +25,9 @@ func UserodelToUserV1(userModel *model.User) *apiv1.User {
 func UserV1ToUserodel(protoUser *apiv1.User) *model.User {
 	var userModel model.User
 	_ = core.CopyWithConverters(&userModel, protoUser)
+	userModel.DeletedAt = gorm.DeletedAt{}
+	if protoUser.DeletedAt != nil {
+		userModel.DeletedAt = gorm.DeletedAt{Time: protoUser.DeletedAt.AsTime(), Valid: true}
+	}
 	return &userModel
 }
@@ -0,0 +1,364 @@
+// Package query 将列表接口中的过滤表达式和排序参数解析为 gorm 子句，只允许使用白名单中的字段.
+//
+// 过滤表达式由比较条件以及 AND、OR、NOT 和括号组成，相邻的条件之间省略 AND 时按 AND 处理，例如：
+//
+//	title:go AND createdAt>2025-01-01
+//	(status=Published OR status=Scheduled) NOT title:"draft copy"
+//
+// 比较运算符为 :、=、!=、<、<=、>、>=，其中 : 对字符串字段表示包含，对其它字段与 = 相同.
+// 包含空格或括号的值需要使用双引号包裹，时间支持 2006-01-02 和 RFC3339 两种格式.
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"gorm.io/gorm/clause"
+)
+
+// FieldType 定义字段值的类型，决定如何解析比较条件中的值.
+type FieldType int
+
+const (
+	// String 是字符串字段，: 表示包含
+	String FieldType = iota
+	// Time 是时间字段
+	Time
+	// Bool 是布尔字段
+	Bool
+	// Enum 是以整数保存的枚举字段，值可以是枚举名称或整数
+	Enum
+)
+
+// Field 定义允许过滤或排序的字段.
+type Field struct {
+	// Column 是字段对应的数据库列名
+	Column string
+	// Type 是字段值的类型
+	Type FieldType
+	// Values 是枚举字段中枚举名称到值的映射
+	Values map[string]int32
+}
+
+// Fields 是允许使用的字段，键为请求中使用的字段名.
+type Fields map[string]Field
+
+// ParseFilter 将过滤表达式解析为查询条件，表达式为空时返回 nil.
+func ParseFilter(filter string, fields Fields) (clause.Expression, error) {
+	p := &parser{input: filter, fields: fields}
+	if p.skipSpace(); p.eof() {
+		return nil, nil
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); !p.eof() {
+		return nil, p.errorf("unexpected %q", p.input[p.pos:])
+	}
+	return expr, nil
+}
+
+// ParseOrderBy 解析排序参数，格式为 "字段 [asc|desc]"，多个字段以逗号分隔，参数为空时返回 nil.
+func ParseOrderBy(orderBy string, fields Fields) ([]clause.OrderByColumn, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+
+	var columns []clause.OrderByColumn
+	for item := range strings.SplitSeq(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("invalid order by %q", strings.TrimSpace(item))
+		}
+
+		field, ok := fields[parts[0]]
+		if !ok {
+			return nil, fmt.Errorf("cannot order by field %q", parts[0])
+		}
+		desc := false
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, fmt.Errorf("invalid order direction %q", parts[1])
+			}
+		}
+		columns = append(columns, clause.OrderByColumn{Column: clause.Column{Name: field.Column}, Desc: desc})
+	}
+	return columns, nil
+}
+
+// parser 是过滤表达式的递归下降解析器.
+type parser struct {
+	input  string
+	pos    int
+	fields Fields
+}
+
+// parseOr 解析以 OR 连接的条件.
+func (p *parser) parseOr() (clause.Expression, error) {
+	exprs, err := p.parseList(p.parseAnd, func() bool { return p.keyword("OR") })
+	if err != nil || len(exprs) == 1 {
+		return first(exprs), err
+	}
+	return clause.Or(exprs...), nil
+}
+
+// parseAnd 解析以 AND 连接或直接相邻的条件.
+func (p *parser) parseAnd() (clause.Expression, error) {
+	exprs, err := p.parseList(p.parseNot, func() bool {
+		if p.keyword("AND") {
+			return true
+		}
+		// 省略 AND 的相邻条件，遇到 OR、右括号或表达式结束时停止
+		return !p.eof() && p.peek() != ')' && !p.peekKeyword("OR")
+	})
+	if err != nil || len(exprs) == 1 {
+		return first(exprs), err
+	}
+	return clause.And(exprs...), nil
+}
+
+// parseList 解析由 next 分隔的一组子表达式.
+func (p *parser) parseList(parse func() (clause.Expression, error), next func() bool) ([]clause.Expression, error) {
+	var exprs []clause.Expression
+	for {
+		expr, err := parse()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+
+		if p.skipSpace(); !next() {
+			return exprs, nil
+		}
+	}
+}
+
+// parseNot 解析以 NOT 开头的取反条件.
+func (p *parser) parseNot() (clause.Expression, error) {
+	if p.skipSpace(); p.keyword("NOT") {
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return clause.Not(expr), nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary 解析括号中的表达式或单个比较条件.
+func (p *parser) parsePrimary() (clause.Expression, error) {
+	if p.skipSpace(); p.eof() {
+		return nil, p.errorf("unexpected end of filter")
+	}
+	if p.peek() != '(' {
+		return p.parseComparison()
+	}
+
+	p.pos++
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.eof() || p.peek() != ')' {
+		return nil, p.errorf("missing closing parenthesis")
+	}
+	p.pos++
+	return expr, nil
+}
+
+// parseComparison 解析 "字段 运算符 值" 形式的比较条件.
+func (p *parser) parseComparison() (clause.Expression, error) {
+	start := p.pos
+	for !p.eof() && (isLetter(p.peek()) || p.pos > start && unicode.IsDigit(rune(p.peek()))) {
+		p.pos++
+	}
+	name := p.input[start:p.pos]
+	if name == "" {
+		return nil, p.errorf("expected field name")
+	}
+	field, ok := p.fields[name]
+	if !ok {
+		return nil, fmt.Errorf("cannot filter by field %q", name)
+	}
+
+	p.skipSpace()
+	op := p.operator()
+	if op == "" {
+		return nil, p.errorf("expected operator after %q", name)
+	}
+	p.skipSpace()
+	raw, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := field.compare(op, raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition on %q: %w", name, err)
+	}
+	return expr, nil
+}
+
+// operator 读取比较运算符，不是运算符时返回空字符串.
+func (p *parser) operator() string {
+	for _, op := range []string{"<=", ">=", "!=", ":", "=", "<", ">"} {
+		if strings.HasPrefix(p.input[p.pos:], op) {
+			p.pos += len(op)
+			return op
+		}
+	}
+	return ""
+}
+
+// value 读取双引号包裹的值，或者到空白字符或括号为止的值.
+func (p *parser) value() (string, error) {
+	if p.eof() {
+		return "", p.errorf("expected value")
+	}
+
+	if p.peek() == '"' {
+		var b strings.Builder
+		for p.pos++; !p.eof(); p.pos++ {
+			switch c := p.peek(); {
+			case c == '"':
+				p.pos++
+				return b.String(), nil
+			case c == '\\' && p.pos+1 < len(p.input):
+				p.pos++
+				b.WriteByte(p.peek())
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return "", p.errorf("unterminated string")
+	}
+
+	start := p.pos
+	for !p.eof() && !unicode.IsSpace(rune(p.peek())) && p.peek() != '(' && p.peek() != ')' {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", p.errorf("expected value")
+	}
+	return p.input[start:p.pos], nil
+}
+
+// keyword 在当前位置是关键字 word 时跳过它.
+func (p *parser) keyword(word string) bool {
+	if !p.peekKeyword(word) {
+		return false
+	}
+	p.pos += len(word)
+	return true
+}
+
+// peekKeyword 判断当前位置是否为关键字 word，关键字区分大小写，之后必须是空白字符或括号.
+func (p *parser) peekKeyword(word string) bool {
+	rest := p.input[p.pos:]
+	if !strings.HasPrefix(rest, word) {
+		return false
+	}
+	return len(rest) == len(word) || unicode.IsSpace(rune(rest[len(word)])) || rest[len(word)] == '('
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && unicode.IsSpace(rune(p.peek())) {
+		p.pos++
+	}
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) peek() byte {
+	return p.input[p.pos]
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid filter at position %d: %s", p.pos+1, fmt.Sprintf(format, args...))
+}
+
+// compare 根据字段类型将比较条件转换为子句.
+func (f Field) compare(op, raw string) (clause.Expression, error) {
+	column := clause.Column{Name: f.Column}
+	if op == ":" {
+		if f.Type == String {
+			return clause.Like{Column: column, Value: "%" + raw + "%"}, nil
+		}
+		op = "="
+	}
+
+	value, err := f.parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if (f.Type == Bool || f.Type == Enum) && op != "=" && op != "!=" {
+		return nil, fmt.Errorf("operator %s is not supported", op)
+	}
+
+	switch op {
+	case "=":
+		return clause.Eq{Column: column, Value: value}, nil
+	case "!=":
+		return clause.Neq{Column: column, Value: value}, nil
+	case "<":
+		return clause.Lt{Column: column, Value: value}, nil
+	case "<=":
+		return clause.Lte{Column: column, Value: value}, nil
+	case ">":
+		return clause.Gt{Column: column, Value: value}, nil
+	default:
+		return clause.Gte{Column: column, Value: value}, nil
+	}
+}
+
+// parse 将值解析为字段类型对应的 Go 类型.
+func (f Field) parse(raw string) (any, error) {
+	switch f.Type {
+	case Time:
+		for _, layout := range []string{time.DateOnly, time.RFC3339} {
+			if t, err := time.Parse(layout, raw); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("invalid time %q, expected 2006-01-02 or RFC3339", raw)
+	case Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid boolean %q", raw)
+		}
+		return b, nil
+	case Enum:
+		if v, ok := f.Values[raw]; ok {
+			return v, nil
+		}
+		n, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", raw)
+		}
+		return int32(n), nil
+	default:
+		return raw, nil
+	}
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func first(exprs []clause.Expression) clause.Expression {
+	if len(exprs) == 0 {
+		return nil
+	}
+	return exprs[0]
+}
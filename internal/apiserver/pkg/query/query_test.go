@@ -0,0 +1,87 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/query"
+)
+
+var fields = query.Fields{
+	"title":     {Column: "title", Type: query.String},
+	"createdAt": {Column: "createdAt", Type: query.Time},
+	"disabled":  {Column: "disabled", Type: query.Bool},
+	"status":    {Column: "status", Type: query.Enum, Values: map[string]int32{"Draft": 0, "Published": 2}},
+}
+
+func column(name string) clause.Column {
+	return clause.Column{Name: name}
+}
+
+func TestParseFilter(t *testing.T) {
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		filter string
+		want   clause.Expression
+	}{
+		{"", nil},
+		{"title:go", clause.Like{Column: column("title"), Value: "%go%"}},
+		{`title = "hello world"`, clause.Eq{Column: column("title"), Value: "hello world"}},
+		{"title:go AND createdAt>2025-01-01", clause.And(
+			clause.Like{Column: column("title"), Value: "%go%"},
+			clause.Gt{Column: column("createdAt"), Value: day},
+		)},
+		{"createdAt>=2025-01-01T00:00:00Z createdAt<2025-01-01", clause.And(
+			clause.Gte{Column: column("createdAt"), Value: day},
+			clause.Lt{Column: column("createdAt"), Value: day},
+		)},
+		{"(status=Published OR status:0) NOT disabled:true", clause.And(
+			clause.Or(clause.Eq{Column: column("status"), Value: int32(2)}, clause.Eq{Column: column("status"), Value: int32(0)}),
+			clause.Not(clause.Eq{Column: column("disabled"), Value: true}),
+		)},
+	}
+	for _, tt := range tests {
+		got, err := query.ParseFilter(tt.filter, fields)
+		require.NoError(t, err, tt.filter)
+		assert.Equal(t, tt.want, got, tt.filter)
+	}
+
+	for _, filter := range []string{
+		"password:x",             // 不在白名单中的字段
+		"title",                  // 缺少运算符
+		"title:",                 // 缺少值
+		"(title:go",              // 缺少右括号
+		"title:go)",              // 多余的右括号
+		"title:go AND",           // 缺少右侧条件
+		`title:"go`,              // 未闭合的引号
+		"createdAt>yesterday",    // 无效的时间
+		"status>Draft",           // 枚举不支持大小比较
+		"disabled:maybe",         // 无效的布尔值
+		"title:go OR OR title:x", // 连续的关键字
+	} {
+		_, err := query.ParseFilter(filter, fields)
+		assert.Error(t, err, filter)
+	}
+}
+
+func TestParseOrderBy(t *testing.T) {
+	got, err := query.ParseOrderBy("createdAt desc, title", fields)
+	require.NoError(t, err)
+	assert.Equal(t, []clause.OrderByColumn{
+		{Column: column("createdAt"), Desc: true},
+		{Column: column("title")},
+	}, got)
+
+	got, err = query.ParseOrderBy(" ", fields)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	for _, orderBy := range []string{"password", "title up", "title,", "title asc desc"} {
+		_, err := query.ParseOrderBy(orderBy, fields)
+		assert.Error(t, err, orderBy)
+	}
+}
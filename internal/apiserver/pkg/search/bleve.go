@@ -0,0 +1,142 @@
+package search
+
+import (
+	"context"
+	"errors"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+)
+
+// bleveSearcher 是基于内嵌 bleve 索引的 Searcher 实现，索引需要由业务层在文章变更时同步.
+type bleveSearcher struct {
+	index bleve.Index
+}
+
+// 确保 bleveSearcher 实现了 Searcher 接口.
+var _ Searcher = (*bleveSearcher)(nil)
+
+// NewBleveSearcher 创建基于 bleve 索引的 Searcher 实例.
+// path 为空时索引只保存在内存中，否则打开 path 处的索引，索引不存在时自动创建.
+func NewBleveSearcher(path string) (*bleveSearcher, error) {
+	if path == "" {
+		index, err := bleve.NewMemOnly(newIndexMapping())
+		if err != nil {
+			return nil, err
+		}
+		return &bleveSearcher{index: index}, nil
+	}
+
+	index, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		index, err = bleve.New(path, newIndexMapping())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &bleveSearcher{index: index}, nil
+}
+
+// newIndexMapping 创建文章索引的映射，标题和正文使用支持中日韩文字的 cjk 分词器.
+func newIndexMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = cjk.AnalyzerName
+
+	keyword := bleve.NewKeywordFieldMapping()
+	keyword.Store = false
+
+	numeric := bleve.NewNumericFieldMapping()
+	numeric.Store = false
+
+	doc := bleve.NewDocumentMapping()
+	doc.AddFieldMappingsAt("title", text)
+	doc.AddFieldMappingsAt("content", text)
+	doc.AddFieldMappingsAt("userID", keyword)
+	doc.AddFieldMappingsAt("status", numeric)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	m.DefaultAnalyzer = cjk.AnalyzerName
+	return m
+}
+
+// Index 实现 Searcher 接口中的 Index 方法.
+func (s *bleveSearcher) Index(ctx context.Context, docs ...*Document) error {
+	batch := s.index.NewBatch()
+	for _, doc := range docs {
+		err := batch.Index(doc.PostID, map[string]any{
+			"title":   doc.Title,
+			"content": doc.Content,
+			"userID":  doc.UserID,
+			"status":  float64(doc.Status),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.index.Batch(batch)
+}
+
+// Delete 实现 Searcher 接口中的 Delete 方法.
+func (s *bleveSearcher) Delete(ctx context.Context, postIDs ...string) error {
+	batch := s.index.NewBatch()
+	for _, postID := range postIDs {
+		batch.Delete(postID)
+	}
+
+	return s.index.Batch(batch)
+}
+
+// Search 实现 Searcher 接口中的 Search 方法，标题命中的权重高于正文.
+func (s *bleveSearcher) Search(ctx context.Context, q *Query) (*Result, error) {
+	title := bleve.NewMatchQuery(q.Keyword)
+	title.SetField("title")
+	title.SetBoost(2)
+	content := bleve.NewMatchQuery(q.Keyword)
+	content.SetField("content")
+
+	conjuncts := []query.Query{bleve.NewDisjunctionQuery(title, content)}
+	if q.UserID != "" {
+		userID := bleve.NewTermQuery(q.UserID)
+		userID.SetField("userID")
+		conjuncts = append(conjuncts, userID)
+	}
+	if q.Status != nil {
+		status, inclusive := float64(*q.Status), true
+		statusQuery := bleve.NewNumericRangeInclusiveQuery(&status, &status, &inclusive, &inclusive)
+		statusQuery.SetField("status")
+		conjuncts = append(conjuncts, statusQuery)
+	}
+
+	rq := bleve.NewSearchRequestOptions(bleve.NewConjunctionQuery(conjuncts...), q.Limit, q.Offset, false)
+	rq.Fields = []string{"title", "content"}
+	rq.SortBy([]string{"-_score", "-_id"})
+	res, err := s.index.SearchInContext(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := Terms(q.Keyword)
+	hits := make([]*Hit, 0, len(res.Hits))
+	for _, match := range res.Hits {
+		title, _ := match.Fields["title"].(string)
+		content, _ := match.Fields["content"].(string)
+		hits = append(hits, &Hit{
+			PostID:         match.ID,
+			Score:          match.Score,
+			TitleSnippet:   Highlight(title, terms),
+			ContentSnippet: Snippet(content, terms, DefaultSnippetLength),
+		})
+	}
+
+	return &Result{TotalCount: int64(res.Total), Hits: hits}, nil
+}
+
+// Close 实现 Searcher 接口中的 Close 方法.
+func (s *bleveSearcher) Close() error {
+	return s.index.Close()
+}
@@ -0,0 +1,135 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultSnippetLength 是正文摘要默认包含的字符数.
+const DefaultSnippetLength = 160
+
+// Terms 将检索关键词按空白字符拆分为需要高亮的词，结果已转为小写并去重，较长的词排在前面.
+func Terms(keyword string) []string {
+	seen := make(map[string]struct{})
+	terms := make([]string, 0)
+	for _, term := range strings.Fields(strings.ToLower(keyword)) {
+		if _, ok := seen[term]; ok {
+			continue
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, term)
+	}
+
+	sort.SliceStable(terms, func(i, j int) bool {
+		return len([]rune(terms[i])) > len([]rune(terms[j]))
+	})
+	return terms
+}
+
+// Highlight 对文本进行 HTML 转义，并使用 <mark> 标签包裹命中的词，匹配时不区分大小写.
+func Highlight(text string, terms []string) string {
+	runes := []rune(text)
+	return render(runes, matches(runes, terms), 0, len(runes))
+}
+
+// Snippet 截取文本中第一个命中词附近最多 length 个字符作为摘要并高亮，
+// 没有命中词时截取文本开头，被截断的一端使用省略号表示.
+func Snippet(text string, terms []string, length int) string {
+	runes := []rune(text)
+	ranges := matches(runes, terms)
+
+	start := 0
+	if len(ranges) > 0 {
+		// 命中词前保留四分之一的上下文
+		start = max(ranges[0][0]-length/4, 0)
+	}
+	end := min(start+length, len(runes))
+	start = max(end-length, 0)
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	b.WriteString(render(runes, ranges, start, end))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String()
+}
+
+// matches 返回文本中所有命中词的位置区间 [start, end)，区间之间互不重叠.
+// 英文等以空格分词的文字只匹配完整的单词，中日韩文字可以在任意位置匹配.
+func matches(runes []rune, terms []string) [][2]int {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	termRunes := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if term != "" {
+			termRunes = append(termRunes, []rune(term))
+		}
+	}
+
+	var ranges [][2]int
+	for i := 0; i < len(lower); i++ {
+		for _, term := range termRunes {
+			if hasPrefix(lower[i:], term) && isBoundary(lower, i) && isBoundary(lower, i+len(term)) {
+				ranges = append(ranges, [2]int{i, i + len(term)})
+				i += len(term) - 1
+				break
+			}
+		}
+	}
+	return ranges
+}
+
+// render 对 runes[start:end] 进行 HTML 转义，并高亮其中的命中区间.
+func render(runes []rune, ranges [][2]int, start, end int) string {
+	var b strings.Builder
+	pos := start
+	for _, r := range ranges {
+		if r[1] <= start || r[0] >= end {
+			continue
+		}
+		s, e := max(r[0], start), min(r[1], end)
+		b.WriteString(html.EscapeString(string(runes[pos:s])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(runes[s:e])))
+		b.WriteString("</mark>")
+		pos = e
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	return b.String()
+}
+
+// isBoundary 判断 pos 处是否为单词边界，即 pos 两侧的字符不同时属于同一个单词.
+func isBoundary(runes []rune, pos int) bool {
+	if pos == 0 || pos == len(runes) {
+		return true
+	}
+	return !isWordRune(runes[pos-1]) || !isWordRune(runes[pos])
+}
+
+// isWordRune 判断字符是否属于以空格分词的单词.
+func isWordRune(r rune) bool {
+	if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+		return false
+	}
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func hasPrefix(s, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+package search
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"gorm.io/gorm"
+)
+
+// matchExpr 是基于 post 表 FULLTEXT 索引 `ft.post.title.content` 的匹配表达式.
+const matchExpr = "MATCH(title, content) AGAINST(? IN NATURAL LANGUAGE MODE)"
+
+// mysqlSearcher 是基于 MySQL FULLTEXT 索引的 Searcher 实现.
+type mysqlSearcher struct {
+	db *gorm.DB
+}
+
+// 确保 mysqlSearcher 实现了 Searcher 接口.
+var _ Searcher = (*mysqlSearcher)(nil)
+
+// NewMySQLSearcher 创建基于 MySQL FULLTEXT 索引的 Searcher 实例.
+func NewMySQLSearcher(db *gorm.DB) *mysqlSearcher {
+	return &mysqlSearcher{db: db}
+}
+
+// Index 实现 Searcher 接口中的 Index 方法，FULLTEXT 索引由 MySQL 自动维护，无需额外操作.
+func (s *mysqlSearcher) Index(ctx context.Context, docs ...*Document) error {
+	return nil
+}
+
+// Delete 实现 Searcher 接口中的 Delete 方法，FULLTEXT 索引由 MySQL 自动维护，无需额外操作.
+func (s *mysqlSearcher) Delete(ctx context.Context, postIDs ...string) error {
+	return nil
+}
+
+// Search 实现 Searcher 接口中的 Search 方法.
+func (s *mysqlSearcher) Search(ctx context.Context, q *Query) (*Result, error) {
+	scope := func() *gorm.DB {
+		db := s.db.WithContext(ctx).Model(&model.Post{}).Where(matchExpr, q.Keyword)
+		if q.UserID != "" {
+			db = db.Where("userID = ?", q.UserID)
+		}
+		if q.Status != nil {
+			db = db.Where("status = ?", *q.Status)
+		}
+		return db
+	}
+
+	var count int64
+	if err := scope().Count(&count).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		PostID  string  `gorm:"column:postID"`
+		Title   string  `gorm:"column:title"`
+		Content string  `gorm:"column:content"`
+		Score   float64 `gorm:"column:score"`
+	}
+	err := scope().
+		Select("postID, title, content, "+matchExpr+" AS score", q.Keyword).
+		Order("score DESC").Order("id DESC").
+		Offset(q.Offset).Limit(q.Limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	terms := Terms(q.Keyword)
+	hits := make([]*Hit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, &Hit{
+			PostID:         row.PostID,
+			Score:          row.Score,
+			TitleSnippet:   Highlight(row.Title, terms),
+			ContentSnippet: Snippet(row.Content, terms, DefaultSnippetLength),
+		})
+	}
+
+	return &Result{TotalCount: count, Hits: hits}, nil
+}
+
+// Close 实现 Searcher 接口中的 Close 方法，数据库连接由 store 管理.
+func (s *mysqlSearcher) Close() error {
+	return nil
+}
@@ -0,0 +1,81 @@
+// Package search 提供博客文章的全文检索能力，支持基于 MySQL FULLTEXT 索引和内嵌 bleve 索引两种实现.
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+	"gorm.io/gorm"
+)
+
+// Document 表示一篇被索引的文章.
+type Document struct {
+	PostID  string
+	UserID  string
+	Title   string
+	Content string
+	Status  int32
+}
+
+// Query 表示一次检索请求.
+type Query struct {
+	// Keyword 表示检索关键词，多个关键词使用空格分隔
+	Keyword string
+	// UserID 不为空时只检索该用户的文章
+	UserID string
+	// Status 不为空时只检索指定状态的文章
+	Status *int32
+	Offset int
+	Limit  int
+}
+
+// Hit 表示一条检索结果.
+type Hit struct {
+	PostID         string
+	Score          float64
+	TitleSnippet   string
+	ContentSnippet string
+}
+
+// Result 表示检索结果，Hits 按相关度从高到低排序.
+type Result struct {
+	TotalCount int64
+	Hits       []*Hit
+}
+
+// Searcher 定义了全文检索需要实现的方法.
+type Searcher interface {
+	// Index 新增或更新文章的索引
+	Index(ctx context.Context, docs ...*Document) error
+	// Delete 删除文章的索引
+	Delete(ctx context.Context, postIDs ...string) error
+	// Search 根据关键词检索文章
+	Search(ctx context.Context, q *Query) (*Result, error)
+	// Close 释放索引占用的资源
+	Close() error
+}
+
+// New 根据配置创建 Searcher 实例.
+func New(opts *genericoptions.SearchOptions, db *gorm.DB) (Searcher, error) {
+	switch opts.Engine {
+	case genericoptions.SearchEngineMySQL:
+		return NewMySQLSearcher(db), nil
+	case genericoptions.SearchEngineBleve:
+		return NewBleveSearcher(opts.IndexPath)
+	default:
+		return nil, fmt.Errorf("unsupported search engine: %s", opts.Engine)
+	}
+}
+
+// NewDocument 将文章模型转换为索引文档.
+func NewDocument(postM *model.Post) *Document {
+	return &Document{
+		PostID:  postM.PostID,
+		UserID:  postM.UserID,
+		Title:   postM.Title,
+		Content: postM.Content,
+		Status:  postM.Status,
+	}
+}
@@ -0,0 +1,61 @@
+package search_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHighlight(t *testing.T) {
+	terms := search.Terms("go  GO <b>")
+	assert.Equal(t, []string{"<b>", "go"}, terms)
+	assert.Equal(t, "Learn <mark>Go</mark> &amp; <mark>&lt;b&gt;</mark>", search.Highlight("Learn Go & <b>", terms))
+	assert.Equal(t, "快速<mark>博客</mark>系统", search.Highlight("快速博客系统", search.Terms("博客")))
+}
+
+func TestSnippet(t *testing.T) {
+	text := strings.Repeat("a ", 25) + "keyword " + strings.Repeat("b ", 25)
+	assert.Equal(t, "… a a <mark>keyword</mark> b b b b…", search.Snippet(text, search.Terms("keyword"), 20))
+	assert.Equal(t, "a a a…", search.Snippet(text, search.Terms("missing"), 5))
+	assert.Equal(t, "gorm", search.Snippet("gorm", search.Terms("go"), 20))
+	assert.Equal(t, "short", search.Snippet("short", nil, 20))
+}
+
+func TestBleveSearcher(t *testing.T) {
+	ctx := context.Background()
+	s, err := search.NewBleveSearcher("")
+	require.NoError(t, err)
+	defer s.Close()
+
+	require.NoError(t, s.Index(ctx,
+		&search.Document{PostID: "post-1", UserID: "user-1", Title: "Go 入门", Content: "介绍 golang 的基础语法", Status: 2},
+		&search.Document{PostID: "post-2", UserID: "user-1", Title: "数据库", Content: "在 Go 中使用 gorm 访问数据库", Status: 0},
+		&search.Document{PostID: "post-3", UserID: "user-2", Title: "Rust", Content: "所有权与借用", Status: 2},
+	))
+
+	res, err := s.Search(ctx, &search.Query{Keyword: "go", Limit: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.TotalCount)
+	assert.Equal(t, "post-1", res.Hits[0].PostID, "title matches rank first")
+	assert.Equal(t, "<mark>Go</mark> 入门", res.Hits[0].TitleSnippet)
+	assert.Equal(t, "在 <mark>Go</mark> 中使用 gorm 访问数据库", res.Hits[1].ContentSnippet)
+
+	published := int32(2)
+	res, err = s.Search(ctx, &search.Query{Keyword: "go 所有权", Status: &published, Limit: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, res.TotalCount)
+
+	res, err = s.Search(ctx, &search.Query{Keyword: "数据库", UserID: "user-1", Limit: 1, Offset: 0})
+	require.NoError(t, err)
+	require.Len(t, res.Hits, 1)
+	assert.Equal(t, "post-2", res.Hits[0].PostID)
+
+	require.NoError(t, s.Delete(ctx, "post-1"))
+	res, err = s.Search(ctx, &search.Query{Keyword: "go", Limit: 10})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, res.TotalCount)
+}
@@ -0,0 +1,48 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateCreateCategoryRequest(ctx context.Context, rq *v1.CreateCategoryRequest) error {
+	return validateTermName(rq.Name)
+}
+
+func (v *Validator) ValidateUpdateCategoryRequest(ctx context.Context, rq *v1.UpdateCategoryRequest) error {
+	if rq.CategoryID == "" {
+		return errors.New("category ID cannot be empty")
+	}
+
+	if rq.Name != nil {
+		return validateTermName(*rq.Name)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateDeleteCategoryRequest(ctx context.Context, rq *v1.DeleteCategoryRequest) error {
+	if rq.CategoryID == "" {
+		return errors.New("category ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateGetCategoryRequest(ctx context.Context, rq *v1.GetCategoryRequest) error {
+	if rq.CategoryID == "" {
+		return errors.New("category ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateListCategoryRequest(ctx context.Context, rq *v1.ListCategoryRequest) error {
+	if rq.Offset < 0 || rq.Limit < 0 {
+		return errors.New("offset and limit cannot be negative")
+	}
+
+	return nil
+}
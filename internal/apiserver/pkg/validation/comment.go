@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	v1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateCreateCommentRequest(ctx context.Context, rq *v1.CreateCommentRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return validateCommentContent(rq.Content)
+}
+
+func (v *Validator) ValidateUpdateCommentRequest(ctx context.Context, rq *v1.UpdateCommentRequest) error {
+	if rq.CommentID == "" {
+		return errors.New("comment ID cannot be empty")
+	}
+
+	return validateCommentContent(rq.Content)
+}
+
+func (v *Validator) ValidateDeleteCommentRequest(ctx context.Context, rq *v1.DeleteCommentRequest) error {
+	if rq.CommentID == "" {
+		return errors.New("comment ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateModerateCommentRequest(ctx context.Context, rq *v1.ModerateCommentRequest) error {
+	if rq.CommentID == "" {
+		return errors.New("comment ID cannot be empty")
+	}
+
+	if _, ok := v1.CommentStatus_name[int32(rq.Status)]; !ok {
+		return fmt.Errorf("invalid comment status: %d", rq.Status)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateListCommentRequest(ctx context.Context, rq *v1.ListCommentRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return validatePage(rq.Page, rq.PageSize)
+}
+
+func (v *Validator) ValidateListPublicCommentRequest(ctx context.Context, rq *v1.ListPublicCommentRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return validatePage(rq.Page, rq.PageSize)
+}
+
+// validateCommentContent 校验评论内容.
+func validateCommentContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return errors.New("content cannot be empty")
+	}
+
+	if len([]rune(content)) > known.MaxCommentLength {
+		return fmt.Errorf("content cannot be longer than %d characters", known.MaxCommentLength)
+	}
+
+	return nil
+}
+
+// validatePage 校验分页参数.
+func validatePage(page, pageSize int64) error {
+	if page < 0 {
+		return errors.New("page cannot be negative")
+	}
+
+	if pageSize < 0 || pageSize > known.MaxPageSize {
+		return fmt.Errorf("page size must be between 0 and %d", known.MaxPageSize)
+	}
+
+	return nil
+}
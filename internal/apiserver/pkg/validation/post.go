@@ -2,15 +2,39 @@ package validation
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	v1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func (v *Validator) ValidateCreatePostRequest(ctx context.Context, rq *v1.CreatePostRequest) error {
+	for _, name := range rq.Tags {
+		if err := validateTermName(name); err != nil {
+			return fmt.Errorf("invalid tag: %w", err)
+		}
+	}
+
 	return nil
 }
 
 func (v *Validator) ValidateUpdatePostRequest(ctx context.Context, rq *v1.UpdatePostRequest) error {
+	for _, value := range rq.Tags.GetValues() {
+		if _, ok := value.GetKind().(*structpb.Value_StringValue); !ok {
+			return errors.New("tags must be an array of strings")
+		}
+		if err := validateTermName(value.GetStringValue()); err != nil {
+			return fmt.Errorf("invalid tag: %w", err)
+		}
+	}
+
+	for _, value := range rq.CategoryIDs.GetValues() {
+		if _, ok := value.GetKind().(*structpb.Value_StringValue); !ok {
+			return errors.New("categoryIDs must be an array of strings")
+		}
+	}
+
 	return nil
 }
 
@@ -23,5 +47,69 @@ func (v *Validator) ValidateGetPostRequest(ctx context.Context, rq *v1.GetPostRe
 }
 
 func (v *Validator) ValidateListPostRequest(ctx context.Context, rq *v1.ListPostRequest) error {
+	if err := validateListQuery(rq.PageToken, rq.OrderBy, rq.Filter, rq.CreatedAfter, rq.CreatedBefore); err != nil {
+		return err
+	}
+
+	return validatePageToken(rq.GetPageToken())
+}
+
+func (v *Validator) ValidateListPublicPostRequest(ctx context.Context, rq *v1.ListPublicPostRequest) error {
+	return validatePage(rq.Page, rq.PageSize)
+}
+
+func (v *Validator) ValidateGetPublicPostRequest(ctx context.Context, rq *v1.GetPublicPostRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidatePublishPostRequest(ctx context.Context, rq *v1.PublishPostRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateUnpublishPostRequest(ctx context.Context, rq *v1.UnpublishPostRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateArchivePostRequest(ctx context.Context, rq *v1.ArchivePostRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateListTrashPostRequest(ctx context.Context, rq *v1.ListTrashPostRequest) error {
+	if rq.Offset < 0 || rq.Limit < 0 {
+		return errors.New("offset and limit cannot be negative")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateRestorePostRequest(ctx context.Context, rq *v1.RestorePostRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidatePurgePostRequest(ctx context.Context, rq *v1.PurgePostRequest) error {
+	if len(rq.PostIDs) == 0 {
+		return errors.New("post IDs cannot be empty")
+	}
+
 	return nil
 }
@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateListPostRevisionRequest(ctx context.Context, rq *v1.ListPostRevisionRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	if rq.Offset < 0 || rq.Limit < 0 {
+		return errors.New("offset and limit cannot be negative")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateGetPostRevisionRequest(ctx context.Context, rq *v1.GetPostRevisionRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	if rq.Version <= 0 {
+		return errors.New("version must be greater than 0")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateDiffPostRevisionRequest(ctx context.Context, rq *v1.DiffPostRevisionRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	if rq.From <= 0 {
+		return errors.New("from version must be greater than 0")
+	}
+
+	if rq.To < 0 {
+		return errors.New("to version cannot be negative")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateRestorePostRevisionRequest(ctx context.Context, rq *v1.RestorePostRevisionRequest) error {
+	if rq.PostID == "" {
+		return errors.New("post ID cannot be empty")
+	}
+
+	if rq.Version <= 0 {
+		return errors.New("version must be greater than 0")
+	}
+
+	return nil
+}
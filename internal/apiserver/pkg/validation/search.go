@@ -0,0 +1,41 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	v1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateSearchPostRequest(ctx context.Context, rq *v1.SearchPostRequest) error {
+	if err := validateKeyword(rq.Q); err != nil {
+		return err
+	}
+
+	return validatePage(rq.Page, rq.PageSize)
+}
+
+func (v *Validator) ValidateSearchPublicPostRequest(ctx context.Context, rq *v1.SearchPublicPostRequest) error {
+	if err := validateKeyword(rq.Q); err != nil {
+		return err
+	}
+
+	return validatePage(rq.Page, rq.PageSize)
+}
+
+// validateKeyword 校验全文检索关键词.
+func validateKeyword(q string) error {
+	if strings.TrimSpace(q) == "" {
+		return errors.New("search keyword cannot be empty")
+	}
+
+	if utf8.RuneCountInString(q) > known.MaxSearchKeywordLength {
+		return fmt.Errorf("search keyword cannot be longer than %d characters", known.MaxSearchKeywordLength)
+	}
+
+	return nil
+}
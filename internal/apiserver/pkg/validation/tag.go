@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	v1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateCreateTagRequest(ctx context.Context, rq *v1.CreateTagRequest) error {
+	return validateTermName(rq.Name)
+}
+
+func (v *Validator) ValidateUpdateTagRequest(ctx context.Context, rq *v1.UpdateTagRequest) error {
+	if rq.TagID == "" {
+		return errors.New("tag ID cannot be empty")
+	}
+
+	if rq.Name != nil {
+		return validateTermName(*rq.Name)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateDeleteTagRequest(ctx context.Context, rq *v1.DeleteTagRequest) error {
+	if rq.TagID == "" {
+		return errors.New("tag ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateGetTagRequest(ctx context.Context, rq *v1.GetTagRequest) error {
+	if rq.TagID == "" {
+		return errors.New("tag ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateListTagRequest(ctx context.Context, rq *v1.ListTagRequest) error {
+	if rq.Offset < 0 || rq.Limit < 0 {
+		return errors.New("offset and limit cannot be negative")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateGetTagCloudRequest(ctx context.Context, rq *v1.GetTagCloudRequest) error {
+	return nil
+}
+
+// validateTermName 校验标签或分类名称.
+func validateTermName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("name cannot be empty")
+	}
+
+	if len([]rune(name)) > known.MaxTermNameLength {
+		return fmt.Errorf("name cannot be longer than %d characters", known.MaxTermNameLength)
+	}
+
+	return nil
+}
@@ -3,8 +3,10 @@ package validation
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
 	v1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
 )
 
@@ -28,26 +30,93 @@ func (v *Validator) ValidateLoginRequest(ctx context.Context, rq *v1.LoginReques
 	return nil
 }
 
-func (v *Validator) ValidateRefreshTokenRequest(ctx context.Context, rq *v1.RefreshTokenRequest) error {
-	userID := contextx.UserID(ctx)
-	if userID == "" {
+func (v *Validator) ValidateLoginTOTPRequest(ctx context.Context, rq *v1.LoginTOTPRequest) error {
+	if rq.ChallengeToken == "" {
+		return errors.New("challenge token cannot be empty")
+	}
+
+	return validateTOTPCode(rq.Code)
+}
+
+func (v *Validator) ValidateEnrollTOTPRequest(ctx context.Context, rq *v1.EnrollTOTPRequest) error {
+	if rq.UserID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateConfirmTOTPRequest(ctx context.Context, rq *v1.ConfirmTOTPRequest) error {
+	if rq.UserID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+
+	return validateTOTPCode(rq.Code)
+}
+
+func (v *Validator) ValidateDisableTOTPRequest(ctx context.Context, rq *v1.DisableTOTPRequest) error {
+	if rq.UserID == "" {
 		return errors.New("user ID cannot be empty")
 	}
 
+	// 停用自己的两步验证时需要提供验证码，管理员停用其他用户的两步验证时无需提供
+	if rq.UserID == contextx.UserID(ctx) {
+		return validateTOTPCode(rq.Code)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateRegenerateRecoveryCodesRequest(ctx context.Context, rq *v1.RegenerateRecoveryCodesRequest) error {
+	if rq.UserID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+
+	return validateTOTPCode(rq.Code)
+}
+
+// validateTOTPCode 校验两步验证码或恢复码的长度.
+func validateTOTPCode(code string) error {
+	if code == "" {
+		return errors.New("code cannot be empty")
+	}
+	if len(code) > 32 {
+		return errors.New("code must not be longer than 32 characters")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateRefreshTokenRequest(ctx context.Context, rq *v1.RefreshTokenRequest) error {
+	if rq.RefreshToken == "" {
+		return errors.New("refresh token cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateLogoutRequest(ctx context.Context, rq *v1.LogoutRequest) error {
+	if contextx.SessionID(ctx) == "" {
+		return errors.New("session ID cannot be empty")
+	}
+
 	return nil
 }
 
 func (v *Validator) ValidateChangePasswordRequest(ctx context.Context, rq *v1.ChangePasswordRequest) error {
 	userID := contextx.UserID(ctx)
-	if userID == "" {
+	if userID == "" || rq.UserID == "" {
 		return errors.New("user ID cannot be empty")
 	}
 
-	if rq.OldPassword == "" {
-		return errors.New("old password cannot be empty")
-	}
-	if len(rq.OldPassword) < 8 || len(rq.OldPassword) > 64 {
-		return errors.New("password must be between 8 and 64 characters")
+	// 修改自己的密码时需要提供旧密码，管理员重置其他用户的密码时无需提供
+	if rq.UserID == userID {
+		if rq.OldPassword == "" {
+			return errors.New("old password cannot be empty")
+		}
+		if len(rq.OldPassword) < 8 || len(rq.OldPassword) > 64 {
+			return errors.New("password must be between 8 and 64 characters")
+		}
 	}
 
 	if rq.NewPassword == "" {
@@ -98,6 +167,10 @@ func (v *Validator) ValidateCreateUserRequest(ctx context.Context, rq *v1.Create
 }
 
 func (v *Validator) ValidateUpdateUserRequest(ctx context.Context, rq *v1.UpdateUserRequest) error {
+	if rq.UserID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+
 	if rq.Username != nil && (len(*rq.Username) < 4 || len(*rq.Username) > 32) {
 		return errors.New("username must be between 4 and 32 characters")
 	}
@@ -121,7 +194,7 @@ func (v *Validator) ValidateUpdateUserRequest(ctx context.Context, rq *v1.Update
 
 func (v *Validator) ValidateDeleteUserRequest(ctx context.Context, rq *v1.DeleteUserRequest) error {
 	userID := contextx.UserID(ctx)
-	if userID == "" {
+	if userID == "" || rq.UserID == "" {
 		return errors.New("user ID cannot be empty")
 	}
 
@@ -130,13 +203,26 @@ func (v *Validator) ValidateDeleteUserRequest(ctx context.Context, rq *v1.Delete
 
 func (v *Validator) ValidateGetUserRequest(ctx context.Context, rq *v1.GetUserRequest) error {
 	userID := contextx.UserID(ctx)
-	if userID == "" {
+	if userID == "" || rq.UserID == "" {
 		return errors.New("user ID cannot be empty")
 	}
 
 	return nil
 }
 
+func (v *Validator) ValidateExportUserDataRequest(ctx context.Context, rq *v1.ExportUserDataRequest) error {
+	userID := contextx.UserID(ctx)
+	if userID == "" || rq.UserID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+
+	if rq.Format != nil && *rq.Format != known.ExportFormatZIP && *rq.Format != known.ExportFormatJSON {
+		return fmt.Errorf("format must be %s or %s", known.ExportFormatZIP, known.ExportFormatJSON)
+	}
+
+	return nil
+}
+
 func (v *Validator) ValidateListUserRequest(ctx context.Context, rq *v1.ListUserRequest) error {
 	if rq.Offset < 0 {
 		return errors.New("offset cannot be negative")
@@ -145,5 +231,34 @@ func (v *Validator) ValidateListUserRequest(ctx context.Context, rq *v1.ListUser
 	if rq.Limit <= 0 {
 		return errors.New("limit must be greater than 0")
 	}
+
+	if err := validateListQuery(rq.PageToken, rq.OrderBy, rq.Filter, rq.CreatedAfter, rq.CreatedBefore); err != nil {
+		return err
+	}
+
+	return validatePageToken(rq.GetPageToken())
+}
+
+func (v *Validator) ValidateListTrashUserRequest(ctx context.Context, rq *v1.ListTrashUserRequest) error {
+	if rq.Offset < 0 || rq.Limit < 0 {
+		return errors.New("offset and limit cannot be negative")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateRestoreUserRequest(ctx context.Context, rq *v1.RestoreUserRequest) error {
+	if rq.UserID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidatePurgeUserRequest(ctx context.Context, rq *v1.PurgeUserRequest) error {
+	if rq.UserID == "" {
+		return errors.New("user ID cannot be empty")
+	}
+
 	return nil
 }
@@ -1,6 +1,14 @@
 package validation
 
-import "github.com/loveRyujin/fast_blog/internal/apiserver/store"
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+)
 
 type Validator struct {
 	// 有些复杂的验证逻辑，可能需要直接查询数据库
@@ -13,3 +21,38 @@ type Validator struct {
 func NewValidator(store store.IStore) *Validator {
 	return &Validator{store: store}
 }
+
+// validatePageToken 校验键集分页的令牌，空令牌表示第一页.
+func validatePageToken(token string) error {
+	if token == "" {
+		return nil
+	}
+
+	_, err := store.ParseCursor(token)
+	return err
+}
+
+// validateListQuery 校验列表接口的排序方式、创建时间范围和过滤表达式，
+// 键集分页固定按创建时间倒序排列，因此不能与 order_by 同时使用.
+func validateListQuery(pageToken *string, orderBy, filter string, createdAfter, createdBefore *timestamppb.Timestamp) error {
+	if pageToken != nil && orderBy != "" {
+		return errors.New("order_by cannot be used with page_token")
+	}
+
+	if len(filter) > known.MaxFilterLength {
+		return fmt.Errorf("filter cannot exceed %d characters", known.MaxFilterLength)
+	}
+
+	for _, ts := range []*timestamppb.Timestamp{createdAfter, createdBefore} {
+		if ts != nil {
+			if err := ts.CheckValid(); err != nil {
+				return fmt.Errorf("invalid created time: %w", err)
+			}
+		}
+	}
+	if createdAfter != nil && createdBefore != nil && !createdAfter.AsTime().Before(createdBefore.AsTime()) {
+		return errors.New("created_after must be before created_before")
+	}
+
+	return nil
+}
@@ -2,14 +2,24 @@ package apiserver
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/loveRyujin/fast_blog/internal/apiserver/biz"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/search"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/pkg/validation"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/cache"
+	"github.com/loveRyujin/fast_blog/internal/pkg/lockout"
 	"github.com/loveRyujin/fast_blog/internal/pkg/log"
 	"github.com/loveRyujin/fast_blog/internal/pkg/server"
 	genericclioptions "github.com/loveRyujin/fast_blog/pkg/options"
+	"github.com/loveRyujin/fast_blog/pkg/token"
+	"github.com/onexstack/onexstack/pkg/authz"
+	"gorm.io/gorm"
 )
 
 const (
@@ -18,44 +28,268 @@ const (
 	GRPCGatewayServerMode = "grpc-gateway"
 )
 
+const (
+	// DBStore 将数据保存在 db.type 指定的数据库中
+	DBStore = "db"
+	// MemoryStore 将数据保存在内存中，服务退出后数据丢失，适用于测试和演示
+	MemoryStore = "memory"
+)
+
 // Config存储应用配置
 type Config struct {
-	ServerMode   string
-	MysqlOptions *genericclioptions.MysqlOptions
-	HTTPOptions  *genericclioptions.HTTPOptions
-	GRPCOptions  *genericclioptions.GRPCOptions
-	JWTKey       string
-	Expiration   time.Duration
+	ServerMode              string
+	Store                   string
+	DBOptions               *genericclioptions.DBOptions
+	MysqlOptions            *genericclioptions.MysqlOptions
+	SQLiteOptions           *genericclioptions.SQLiteOptions
+	PostgreSQLOptions       *genericclioptions.PostgreSQLOptions
+	AutoMigrate             bool
+	HTTPOptions             *genericclioptions.HTTPOptions
+	GRPCOptions             *genericclioptions.GRPCOptions
+	SearchOptions           *genericclioptions.SearchOptions
+	CacheOptions            *genericclioptions.CacheOptions
+	RedisOptions            *genericclioptions.RedisOptions
+	LockoutOptions          *genericclioptions.LockoutOptions
+	JWTKey                  string
+	JWTSigningKeyFile       string
+	JWTVerificationKeyFiles []string
+	JWTIssuer               string
+	JWTAudience             string
+	Expiration              time.Duration
+	RefreshTokenExpiration  time.Duration
+	TOTPIssuer              string
+	TOTPChallengeExpiration time.Duration
+	AuthnWhitelist          []string
+	PolicyReloadInterval    time.Duration
+	SchedulerInterval       time.Duration
+	UserDeletionGracePeriod time.Duration
+	PurgeInterval           time.Duration
+	TrashRetention          time.Duration
+	LoginAuditRetention     time.Duration
 }
 
 // UnionServer是一个服务器结构体类型
 type UnionServer struct {
 	srv server.Server
+	// jobs 是随服务器一同运行的后台任务
+	jobs []server.Server
+	// searcher 是全文检索索引，服务器退出时需要关闭
+	searcher search.Searcher
+	// cache 是存储层使用的缓存，未启用缓存时为 nil
+	cache cache.Cache
+	// guard 是登录失败次数限制，服务器退出时需要关闭
+	guard *lockout.LoginGuard
+}
+
+// ServerConfig 包含服务器运行所需的核心依赖，由所有服务模式共享.
+type ServerConfig struct {
+	cfg      *Config
+	biz      biz.IBiz
+	val      *validation.Validator
+	authz    *authz.Authz
+	searcher search.Searcher
+	cache    cache.Cache
+	guard    *lockout.LoginGuard
+	// revoker 用于认证时检查访问令牌所属的会话是否已被撤销
+	revoker store.RefreshTokenStore
+	// tokens 用于签发和解析访问令牌
+	tokens *token.Manager
 }
 
 func (cfg *Config) NewUnionServer() (*UnionServer, error) {
 	log.Infow("Initializing UnionServer", "server-mode", cfg.ServerMode)
 
+	serverConfig, err := cfg.NewServerConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	var srv server.Server
-	var err error
 	switch cfg.ServerMode {
 	case HTTPServerMode:
-		srv, err = cfg.NewHTTPServer()
+		srv, err = serverConfig.NewHTTPServer()
 	default:
-		srv, err = cfg.NewGRPCServerOr()
+		srv, err = serverConfig.NewGRPCServerOr()
 	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &UnionServer{
-		srv: srv,
+		srv:      srv,
+		jobs:     serverConfig.NewJobServers(),
+		searcher: serverConfig.searcher,
+		cache:    serverConfig.cache,
+		guard:    serverConfig.guard,
 	}, nil
 }
 
+// NewTokenManager 根据 JWT 配置创建 token.Manager，密钥不安全时返回错误，服务拒绝启动.
+func (cfg *Config) NewTokenManager() (*token.Manager, error) {
+	keys, err := cfg.loadJWTKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := token.NewManager(
+		token.WithHMACKey(cfg.JWTKey),
+		token.WithKeys(keys...),
+		token.WithIssuer(cfg.JWTIssuer),
+		token.WithAudience(cfg.JWTAudience),
+		token.WithExpiration(cfg.Expiration),
+		token.WithChallengeExpiration(cfg.TOTPChallengeExpiration),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwt configuration: %w", err)
+	}
+	return tokens, nil
+}
+
+// loadJWTKeys 加载签发和验证 token 使用的非对称密钥，签发密钥排在第一个，没有配置签发密钥时返回空.
+func (cfg *Config) loadJWTKeys() ([]*token.Key, error) {
+	if cfg.JWTSigningKeyFile == "" {
+		return nil, nil
+	}
+
+	signingKey, err := token.LoadKeyFile(cfg.JWTSigningKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []*token.Key{signingKey}
+	for _, path := range cfg.JWTVerificationKeyFiles {
+		key, err := token.LoadKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	log.Infow("Loaded JWT keys", "kid", signingKey.ID, "count", len(keys))
+	return keys, nil
+}
+
+// NewServerConfig 初始化数据库连接和授权器，并创建服务器依赖的业务层和校验层实例.
+func (cfg *Config) NewServerConfig() (*ServerConfig, error) {
+	// 初始化 token 管理器，使用内置的默认密钥等不安全的配置时拒绝启动
+	tokens, err := cfg.NewTokenManager()
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化存储层，所有服务模式共用同一个 store
+	store, db, err := cfg.NewStore()
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化缓存，在文章和用户的查询外包装读穿缓存
+	c, store, err := cfg.NewCache(store)
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化基于 casbin_rule 表的授权器，并定期从数据库重新加载策略
+	authz, err := authz.NewAuthz(db, authz.WithAutoLoadPolicyTime(cfg.PolicyReloadInterval))
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化全文检索，bleve 索引需要在启动时根据数据库中的文章重建
+	searcher, err := search.New(cfg.SearchOptions, db)
+	if err != nil {
+		return nil, err
+	}
+
+	// 初始化登录失败次数限制，多实例部署时应使用 redis 共享计数
+	guard, err := lockout.New(cfg.LockoutOptions, cfg.RedisOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	bizOptions := biz.Options{
+		TOTPIssuer:              cfg.TOTPIssuer,
+		UserDeletionGracePeriod: cfg.UserDeletionGracePeriod,
+		TrashRetention:          cfg.TrashRetention,
+		RefreshTokenExpiration:  cfg.RefreshTokenExpiration,
+		LoginAuditRetention:     cfg.LoginAuditRetention,
+	}
+	serverConfig := &ServerConfig{
+		cfg:      cfg,
+		biz:      biz.NewBiz(store, authz, searcher, tokens, guard, bizOptions),
+		val:      validation.NewValidator(store),
+		authz:    authz,
+		searcher: searcher,
+		cache:    c,
+		guard:    guard,
+		revoker:  store.RefreshToken(),
+		tokens:   tokens,
+	}
+	if cfg.SearchOptions.Engine == genericclioptions.SearchEngineBleve {
+		go serverConfig.reindexPosts(context.Background())
+	}
+
+	return serverConfig, nil
+}
+
+// NewStore 根据 store 配置创建存储层，同时返回授权器和全文检索使用的数据库连接.
+// 内存存储模式下，授权策略保存在内存 SQLite 数据库中.
+func (cfg *Config) NewStore() (store.IStore, *gorm.DB, error) {
+	if cfg.Store == MemoryStore {
+		db, err := genericclioptions.NewSQLiteOptions().NewDB()
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := migrateAuthzDB(db); err != nil {
+			return nil, nil, err
+		}
+		return store.NewMemoryStore(), db, nil
+	}
+
+	db, err := cfg.NewDB()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 启动时执行未执行的数据库迁移
+	if cfg.AutoMigrate {
+		if err := cfg.migrate(db); err != nil {
+			return nil, nil, err
+		}
+	}
+	return store.NewStore(db), db, nil
+}
+
+// NewCache 根据 cache 配置创建缓存，并返回在文章和用户查询外包装了读穿缓存的存储层.
+// 未启用缓存时返回 nil 和原来的存储层.
+func (cfg *Config) NewCache(s store.IStore) (cache.Cache, store.IStore, error) {
+	c, err := cache.New(cfg.CacheOptions, cfg.RedisOptions)
+	if err != nil || c == nil {
+		return nil, s, err
+	}
+	return c, store.WithCache(s, c, cfg.CacheOptions.PostTTL, cfg.CacheOptions.UserTTL), nil
+}
+
+// NewDB 根据 db.type 配置创建对应存储后端的数据库连接.
+func (cfg *Config) NewDB() (*gorm.DB, error) {
+	switch cfg.DBOptions.Type {
+	case genericclioptions.DBTypeSQLite:
+		return cfg.SQLiteOptions.NewDB()
+	case genericclioptions.DBTypePostgreSQL:
+		return cfg.PostgreSQLOptions.NewDB()
+	default:
+		return cfg.MysqlOptions.NewDB()
+	}
+}
+
 func (s *UnionServer) Run() error {
 	go s.srv.Run()
 
+	// 启动后台任务，如定时发布博客
+	for _, job := range s.jobs {
+		go job.Run()
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -68,6 +302,21 @@ func (s *UnionServer) Run() error {
 	// 先关闭依赖的服务，再关闭被依赖的服务
 	// 10s内关闭所有服务，超过10s就超时退出
 	s.srv.GracefulStop(ctx)
+	for _, job := range s.jobs {
+		job.GracefulStop(ctx)
+	}
+	if err := s.searcher.Close(); err != nil {
+		log.Errorw("Failed to close search index", "err", err)
+	}
+	if s.cache != nil {
+		if err := s.cache.Close(); err != nil {
+			log.Errorw("Failed to close cache", "err", err)
+		}
+	}
+
+	if err := s.guard.Close(); err != nil {
+		log.Errorw("Failed to close login lockout store", "err", err)
+	}
 
 	log.Infow("Server exited")
 
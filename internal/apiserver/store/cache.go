@@ -0,0 +1,308 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/cache"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// cachedStore 在 PostStore 和 UserStore 的 Get 方法外包装读穿缓存，其它方法直接使用被包装的 IStore.
+type cachedStore struct {
+	IStore
+	cache cache.Cache
+	posts *readThrough[model.Post]
+	users *readThrough[model.User]
+}
+
+// _ 确保cachedStore实现了IStore接口
+var _ IStore = (*cachedStore)(nil)
+
+// WithCache 返回使用缓存 c 加速文章和用户查询的 IStore，postTTL 或 userTTL 为 0 时对应资源不使用缓存.
+// 文章和用户只会通过被包装的 store 修改，Update、Updates、Delete 和 Purge 之后会删除对应的缓存.
+func WithCache(s IStore, c cache.Cache, postTTL, userTTL time.Duration) IStore {
+	return &cachedStore{
+		IStore: s,
+		cache:  c,
+		posts:  &readThrough[model.Post]{cache: c, ttl: postTTL, prefix: "post:", column: "postID"},
+		users:  &readThrough[model.User]{cache: c, ttl: userTTL, prefix: "user:", column: "userID"},
+	}
+}
+
+// pendingKey 是上下文中保存事务内已失效缓存键的键.
+type pendingKey struct{}
+
+// pendingKeys 记录事务中修改过的记录的缓存键.
+type pendingKeys struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+// TX 在事务中执行 fn，事务中的查询不读写缓存.
+// 事务提交前其它请求可能把旧数据重新写入缓存，因此事务结束后会再次删除事务中修改过的记录的缓存.
+func (s *cachedStore) TX(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(pendingKey{}).(*pendingKeys); ok {
+		return s.IStore.TX(ctx, fn)
+	}
+
+	pending := &pendingKeys{}
+	err := s.IStore.TX(context.WithValue(ctx, pendingKey{}, pending), fn)
+	if len(pending.keys) > 0 {
+		if err := s.cache.Delete(context.WithoutCancel(ctx), pending.keys...); err != nil {
+			log.With(ctx).Warnw("Failed to invalidate cache after transaction", "err", err, "keys", pending.keys)
+		}
+	}
+	return err
+}
+
+// Post 返回带缓存的 PostStore.
+func (s *cachedStore) Post() PostStore {
+	if s.posts.ttl <= 0 {
+		return s.IStore.Post()
+	}
+	return &cachedPostStore{PostStore: s.IStore.Post(), cache: s.posts}
+}
+
+// User 返回带缓存的 UserStore.
+func (s *cachedStore) User() UserStore {
+	if s.users.ttl <= 0 {
+		return s.IStore.User()
+	}
+	return &cachedUserStore{UserStore: s.IStore.User(), cache: s.users}
+}
+
+// cachedPostStore 是带读穿缓存的 PostStore.
+type cachedPostStore struct {
+	PostStore
+	cache *readThrough[model.Post]
+}
+
+// Get 优先从缓存中查询文章，缓存未命中时查询数据库并写入缓存.
+func (s *cachedPostStore) Get(ctx context.Context, opts *where.Options) (*model.Post, error) {
+	return s.cache.get(ctx, opts, s.PostStore.Get)
+}
+
+// Update 更新文章并删除文章的缓存.
+func (s *cachedPostStore) Update(ctx context.Context, obj *model.Post) error {
+	err := s.PostStore.Update(ctx, obj)
+	s.cache.invalidate(ctx, obj.PostID)
+	return err
+}
+
+// Updates 更新文章的指定列并删除文章的缓存.
+func (s *cachedPostStore) Updates(ctx context.Context, opts *where.Options, values map[string]any) (int64, error) {
+	ids, err := s.cache.affected(ctx, opts, s.PostStore.List)
+	if err != nil {
+		return 0, err
+	}
+	n, err := s.PostStore.Updates(ctx, opts, values)
+	s.cache.invalidate(ctx, ids...)
+	return n, err
+}
+
+// Delete 软删除文章并删除文章的缓存.
+func (s *cachedPostStore) Delete(ctx context.Context, opts *where.Options) error {
+	ids, err := s.cache.affected(ctx, opts, s.PostStore.List)
+	if err != nil {
+		return err
+	}
+	err = s.PostStore.Delete(ctx, opts)
+	s.cache.invalidate(ctx, ids...)
+	return err
+}
+
+// Purge 彻底删除文章并删除文章的缓存.
+func (s *cachedPostStore) Purge(ctx context.Context, opts *where.Options) error {
+	ids, err := s.cache.affected(ctx, opts, s.PostStore.List)
+	if err != nil {
+		return err
+	}
+	err = s.PostStore.Purge(ctx, opts)
+	s.cache.invalidate(ctx, ids...)
+	return err
+}
+
+// cachedUserStore 是带读穿缓存的 UserStore.
+type cachedUserStore struct {
+	UserStore
+	cache *readThrough[model.User]
+}
+
+// Get 优先从缓存中查询用户，缓存未命中时查询数据库并写入缓存.
+func (s *cachedUserStore) Get(ctx context.Context, opts *where.Options) (*model.User, error) {
+	return s.cache.get(ctx, opts, s.UserStore.Get)
+}
+
+// Update 更新用户并删除用户的缓存.
+func (s *cachedUserStore) Update(ctx context.Context, obj *model.User) error {
+	err := s.UserStore.Update(ctx, obj)
+	s.cache.invalidate(ctx, obj.UserID)
+	return err
+}
+
+// Updates 更新用户的指定列并删除用户的缓存.
+func (s *cachedUserStore) Updates(ctx context.Context, opts *where.Options, values map[string]any) (int64, error) {
+	ids, err := s.cache.affected(ctx, opts, s.UserStore.List)
+	if err != nil {
+		return 0, err
+	}
+	n, err := s.UserStore.Updates(ctx, opts, values)
+	s.cache.invalidate(ctx, ids...)
+	return n, err
+}
+
+// Delete 软删除用户并删除用户的缓存.
+func (s *cachedUserStore) Delete(ctx context.Context, opts *where.Options) error {
+	ids, err := s.cache.affected(ctx, opts, s.UserStore.List)
+	if err != nil {
+		return err
+	}
+	err = s.UserStore.Delete(ctx, opts)
+	s.cache.invalidate(ctx, ids...)
+	return err
+}
+
+// Purge 彻底删除用户并删除用户的缓存.
+func (s *cachedUserStore) Purge(ctx context.Context, opts *where.Options) error {
+	ids, err := s.cache.affected(ctx, opts, s.UserStore.List)
+	if err != nil {
+		return err
+	}
+	err = s.UserStore.Purge(ctx, opts)
+	s.cache.invalidate(ctx, ids...)
+	return err
+}
+
+// readThrough 以记录的唯一 ID 为键缓存一种资源，值为 JSON 编码的记录.
+type readThrough[T any] struct {
+	cache cache.Cache
+	ttl   time.Duration
+	// prefix 是缓存键的前缀，缓存键为前缀加记录的唯一 ID
+	prefix string
+	// column 是唯一 ID 所在的列
+	column string
+}
+
+// get 查询条件中包含唯一 ID 时读穿缓存，缓存命中后仍需满足其它查询条件，否则回源查询.
+// 缓存读写失败不影响查询，只记录日志.
+func (r *readThrough[T]) get(ctx context.Context, opts *where.Options, load func(context.Context, *where.Options) (*T, error)) (*T, error) {
+	key, ok := r.key(ctx, opts)
+	if !ok {
+		return load(ctx, opts)
+	}
+
+	if obj, ok := r.lookup(ctx, key, opts); ok {
+		return obj, nil
+	}
+
+	obj, err := load(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if data, err := json.Marshal(obj); err == nil {
+		if err := r.cache.Set(ctx, key, data, r.ttl); err != nil {
+			log.With(ctx).Warnw("Failed to write cache", "err", err, "key", key)
+		}
+	}
+	return obj, nil
+}
+
+// key 返回查询对应的缓存键.
+// 只有按唯一 ID 相等查询时才使用缓存，事务中的查询需要读取未提交的数据，也不使用缓存.
+func (r *readThrough[T]) key(ctx context.Context, opts *where.Options) (string, bool) {
+	if opts == nil || len(opts.Clauses) > 0 || len(opts.Queries) > 0 {
+		return "", false
+	}
+	if _, ok := ctx.Value(pendingKey{}).(*pendingKeys); ok {
+		return "", false
+	}
+
+	id, ok := opts.Filters[r.column].(string)
+	if !ok || id == "" {
+		return "", false
+	}
+	return r.prefix + id, true
+}
+
+// lookup 从缓存中读取记录，并检查记录是否满足查询条件.
+func (r *readThrough[T]) lookup(ctx context.Context, key string, opts *where.Options) (*T, bool) {
+	data, err := r.cache.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, cache.ErrMiss) {
+			log.With(ctx).Warnw("Failed to read cache", "err", err, "key", key)
+		}
+		return nil, false
+	}
+
+	var obj T
+	if err := json.Unmarshal(data, &obj); err != nil {
+		log.With(ctx).Warnw("Failed to decode cached record", "err", err, "key", key)
+		return nil, false
+	}
+	match, err := compileWhere(opts)
+	if err != nil {
+		return nil, false
+	}
+	ok, err := match(reflect.ValueOf(&obj).Elem())
+	return &obj, err == nil && ok
+}
+
+// affected 返回 opts 会修改的记录的唯一 ID.
+// 查询条件中包含唯一 ID 时直接使用，否则先查询出满足条件的记录.
+func (r *readThrough[T]) affected(ctx context.Context, opts *where.Options, list func(context.Context, *where.Options) (int64, []*T, error)) ([]string, error) {
+	if opts != nil {
+		if value, ok := opts.Filters[r.column]; ok {
+			values, ok := sliceValues(value)
+			if !ok {
+				values = []any{value}
+			}
+			ids := make([]string, 0, len(values))
+			for _, v := range values {
+				if id, ok := v.(string); ok {
+					ids = append(ids, id)
+				}
+			}
+			return ids, nil
+		}
+	}
+
+	_, rows, err := list(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if id, ok := columnValue(reflect.ValueOf(row).Elem(), r.column).(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// invalidate 删除记录的缓存，在事务中时同时记录缓存键，事务结束后再次删除.
+func (r *readThrough[T]) invalidate(ctx context.Context, ids ...string) {
+	if len(ids) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, r.prefix+id)
+	}
+	if pending, ok := ctx.Value(pendingKey{}).(*pendingKeys); ok {
+		pending.mu.Lock()
+		pending.keys = append(pending.keys, keys...)
+		pending.mu.Unlock()
+	}
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		log.With(ctx).Warnw("Failed to invalidate cache", "err", err, "keys", keys)
+	}
+}
@@ -0,0 +1,74 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/cache"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+)
+
+func TestCachedStore(t *testing.T) {
+	ctx := context.Background()
+	inner := store.NewMemoryStore()
+	s := store.WithCache(inner, cache.NewMemory(100), time.Minute, 0)
+
+	postM := &model.Post{UserID: "user-1", Title: "hello", Content: "world"}
+	require.NoError(t, s.Post().Create(ctx, postM))
+	whr := func() *where.Options { return where.F("userID", "user-1", "postID", postM.PostID) }
+
+	got, err := s.Post().Get(ctx, whr())
+	require.NoError(t, err)
+	assert.Equal(t, "hello", got.Title)
+
+	// 绕过缓存直接修改存储层时，仍然读到缓存中的旧数据
+	stale := *postM
+	stale.Title = "stale"
+	require.NoError(t, inner.Post().Update(ctx, &stale))
+	got, err = s.Post().Get(ctx, whr())
+	require.NoError(t, err)
+	assert.Equal(t, "hello", got.Title)
+
+	// 缓存命中后仍然校验其它查询条件
+	_, err = s.Post().Get(ctx, where.F("userID", "user-2", "postID", postM.PostID))
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+
+	// 事务中的查询不使用缓存
+	require.NoError(t, s.TX(ctx, func(ctx context.Context) error {
+		got, err := s.Post().Get(ctx, whr())
+		require.NoError(t, err)
+		assert.Equal(t, "stale", got.Title)
+		return nil
+	}))
+
+	// 通过带缓存的 store 更新后缓存失效
+	postM.Title = "updated"
+	require.NoError(t, s.Post().Update(ctx, postM))
+	got, err = s.Post().Get(ctx, whr())
+	require.NoError(t, err)
+	assert.Equal(t, "updated", got.Title)
+
+	// 事务回滚后缓存同样失效
+	errRollback := errors.New("rollback")
+	assert.ErrorIs(t, s.TX(ctx, func(ctx context.Context) error {
+		postM.Title = "rolled back"
+		require.NoError(t, s.Post().Update(ctx, postM))
+		return errRollback
+	}), errRollback)
+	got, err = s.Post().Get(ctx, whr())
+	require.NoError(t, err)
+	assert.Equal(t, "updated", got.Title)
+
+	// 删除后不再返回缓存中的文章
+	require.NoError(t, s.Post().Delete(ctx, where.F("userID", "user-1")))
+	_, err = s.Post().Get(ctx, whr())
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+}
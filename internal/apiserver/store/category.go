@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// CategoryStore 定义了 category 模块在 store 层所实现的方法.
+type CategoryStore interface {
+	Create(ctx context.Context, obj *model.Category) error
+	Update(ctx context.Context, obj *model.Category) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.Category, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.Category, error)
+
+	CategoryExpansion
+}
+
+// CategoryExpansion 定义了分类操作的附加方法.
+type CategoryExpansion interface{}
+
+// categoryStore 是 CategoryStore 接口的实现.
+type categoryStore struct {
+	store *dataStore
+}
+
+// 确保 categoryStore 实现了 CategoryStore 接口.
+var _ CategoryStore = (*categoryStore)(nil)
+
+// newCategoryStore 创建 categoryStore 的实例.
+func newCategoryStore(store *dataStore) *categoryStore {
+	return &categoryStore{store: store}
+}
+
+// Create 插入一条分类记录.
+func (s *categoryStore) Create(ctx context.Context, obj *model.Category) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert category into database", "err", err, "category", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新分类数据库记录.
+func (s *categoryStore) Update(ctx context.Context, obj *model.Category) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to update category in database", "err", err, "category", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除分类记录.
+func (s *categoryStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.Category)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete category from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询分类记录.
+func (s *categoryStore) Get(ctx context.Context, opts *where.Options) (*model.Category, error) {
+	var obj model.Category
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to retrieve category from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrCategoryNotFound
+		}
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回分类列表和总数.
+func (s *categoryStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Category, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list categories from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// CommentStore 定义了 comment 模块在 store 层所实现的方法.
+type CommentStore interface {
+	Create(ctx context.Context, obj *model.Comment) error
+	Update(ctx context.Context, obj *model.Comment) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.Comment, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.Comment, error)
+
+	CommentExpansion
+}
+
+// CommentExpansion 定义了评论操作的附加方法.
+type CommentExpansion interface{}
+
+// commentStore 是 CommentStore 接口的实现.
+type commentStore struct {
+	store *dataStore
+}
+
+// 确保 commentStore 实现了 CommentStore 接口.
+var _ CommentStore = (*commentStore)(nil)
+
+// newCommentStore 创建 commentStore 的实例.
+func newCommentStore(store *dataStore) *commentStore {
+	return &commentStore{store: store}
+}
+
+// Create 插入一条评论记录.
+func (s *commentStore) Create(ctx context.Context, obj *model.Comment) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert comment into database", "err", err, "comment", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新评论数据库记录.
+func (s *commentStore) Update(ctx context.Context, obj *model.Comment) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to update comment in database", "err", err, "comment", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除评论记录.
+func (s *commentStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.Comment)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete comment from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询评论记录.
+func (s *commentStore) Get(ctx context.Context, opts *where.Options) (*model.Comment, error) {
+	var obj model.Comment
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to retrieve comment from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrCommentNotFound
+		}
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回评论列表和总数.
+func (s *commentStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Comment, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list comments from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
@@ -0,0 +1,55 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// Cursor 是键集分页的游标，指向上一页最后一条记录的 (createdAt, id)，
+// 下一页从按 createdAt、id 倒序排列时排在它之后的记录开始.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        int64     `json:"i"`
+}
+
+// ParseCursor 解析由 Encode 生成的分页令牌.
+func ParseCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("malformed page token")
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID <= 0 {
+		return nil, errors.New("malformed page token")
+	}
+	return &c, nil
+}
+
+// Encode 将游标编码为不透明的分页令牌.
+// 时间保留原有的时区偏移，与数据库中以字符串保存时间的 SQLite 比较时格式一致.
+func (c *Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// after 返回排在游标之后的记录的查询条件，即 createdAt < ? OR (createdAt = ? AND id < ?).
+func (c *Cursor) after() clause.Expression {
+	createdAt := clause.Column{Name: "createdAt"}
+	id := clause.Column{Name: "id"}
+	return clause.Or(
+		clause.Lt{Column: createdAt, Value: c.CreatedAt},
+		clause.And(clause.Eq{Column: createdAt, Value: c.CreatedAt}, clause.Lt{Column: id, Value: c.ID}),
+	)
+}
+
+// seekOrder 是键集分页的排序方式，与游标的比较条件一致.
+// 使用 clause.OrderBy 而不是字符串，以便 PostgreSQL 中驼峰命名的列名被正确引用.
+var seekOrder = clause.OrderBy{Columns: []clause.OrderByColumn{
+	{Column: clause.Column{Name: "createdAt"}, Desc: true},
+	{Column: clause.Column{Name: "id"}, Desc: true},
+}}
@@ -0,0 +1,8 @@
+package store
+
+import "gorm.io/gorm"
+
+// NewStoreForTest 返回一个不共享的 dataStore，使每个测试使用独立的数据库.
+func NewStoreForTest(db *gorm.DB) IStore {
+	return &dataStore{db: db}
+}
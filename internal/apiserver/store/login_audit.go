@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// LoginAuditStore 定义了登录失败审计模块在 store 层所实现的方法.
+type LoginAuditStore interface {
+	Create(ctx context.Context, obj *model.LoginAudit) error
+	Delete(ctx context.Context, opts *where.Options) error
+	List(ctx context.Context, opts *where.Options) (int64, []*model.LoginAudit, error)
+}
+
+// loginAuditStore 是 LoginAuditStore 接口的实现.
+type loginAuditStore struct {
+	store *dataStore
+}
+
+// 确保 loginAuditStore 实现了 LoginAuditStore 接口.
+var _ LoginAuditStore = (*loginAuditStore)(nil)
+
+// newLoginAuditStore 创建 loginAuditStore 的实例.
+func newLoginAuditStore(store *dataStore) *loginAuditStore {
+	return &loginAuditStore{store: store}
+}
+
+// Create 插入一条登录失败审计记录.
+func (s *loginAuditStore) Create(ctx context.Context, obj *model.LoginAudit) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert login audit into database", "err", err, "username", obj.Username)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除登录失败审计记录.
+func (s *loginAuditStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.LoginAudit)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete login audit from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// List 返回登录失败审计记录列表和总数.
+func (s *loginAuditStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.LoginAudit, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list login audits from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
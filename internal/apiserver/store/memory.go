@@ -0,0 +1,423 @@
+package store
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/rid"
+)
+
+// memoryStore 是基于内存的 IStore 实现，数据只保存在当前进程中，适用于单元测试和演示模式.
+// 事务之间串行执行，事务执行期间其它 goroutine 的读写会等待事务结束.
+type memoryStore struct {
+	mu sync.RWMutex
+	// tx 是当前正在执行的最外层事务，用于判断上下文是否处于事务中
+	tx atomic.Pointer[memoryTx]
+
+	users          *memoryTable[model.User]
+	posts          *memoryTable[model.Post]
+	postRevisions  *memoryTable[model.PostRevision]
+	tags           *memoryTable[model.Tag]
+	categories     *memoryTable[model.Category]
+	postTags       *memoryTable[model.PostTag]
+	postCategories *memoryTable[model.PostCategory]
+	comments       *memoryTable[model.Comment]
+	refreshTokens  *memoryTable[model.RefreshToken]
+	loginAudits    *memoryTable[model.LoginAudit]
+	userTOTPs      *memoryTable[model.UserTOTP]
+	recoveryCodes  *memoryTable[model.RecoveryCode]
+}
+
+// memoryTx 标识内存存储中的一个事务.
+type memoryTx struct {
+	// root 是该事务所属的最外层事务
+	root *memoryTx
+}
+
+type memoryTransactionKey struct{}
+
+// _ 确保memoryStore实现了IStore接口
+var _ IStore = (*memoryStore)(nil)
+
+// NewMemoryStore 返回一个基于内存实现 IStore 接口的实例，每次调用都会创建新的空数据集.
+// 唯一索引与数据库表结构保持一致.
+func NewMemoryStore() *memoryStore {
+	return &memoryStore{
+		users: newMemoryTable("user", func(m *model.User) { m.UserID = rid.UserID.New(uint64(m.ID)) },
+			[]string{"userID"}, []string{"username"}, []string{"phone"}),
+		posts: newMemoryTable("post", func(m *model.Post) { m.PostID = rid.PostID.New(uint64(m.ID)) },
+			[]string{"postID"}),
+		postRevisions: newMemoryTable[model.PostRevision]("post_revision", nil,
+			[]string{"postID", "version"}),
+		tags: newMemoryTable("tag", func(m *model.Tag) { m.TagID = rid.TagID.New(uint64(m.ID)) },
+			[]string{"tagID"}, []string{"userID", "name"}),
+		categories: newMemoryTable("category", func(m *model.Category) { m.CategoryID = rid.CategoryID.New(uint64(m.ID)) },
+			[]string{"categoryID"}, []string{"userID", "name"}),
+		postTags: newMemoryTable[model.PostTag]("post_tag", nil,
+			[]string{"postID", "tagID"}),
+		postCategories: newMemoryTable[model.PostCategory]("post_category", nil,
+			[]string{"postID", "categoryID"}),
+		comments: newMemoryTable("comment", func(m *model.Comment) { m.CommentID = rid.CommentID.New(uint64(m.ID)) },
+			[]string{"commentID"}),
+		refreshTokens: newMemoryTable[model.RefreshToken]("refresh_token", nil,
+			[]string{"tokenHash"}),
+		loginAudits: newMemoryTable[model.LoginAudit]("login_audit", nil),
+		userTOTPs: newMemoryTable[model.UserTOTP]("user_totp", nil,
+			[]string{"userID"}),
+		recoveryCodes: newMemoryTable[model.RecoveryCode]("recovery_code", nil,
+			[]string{"userID", "codeHash"}),
+	}
+}
+
+// DB 内存存储没有数据库连接，始终返回 nil
+func (s *memoryStore) DB(ctx context.Context, wheres ...where.Where) *gorm.DB {
+	return nil
+}
+
+// TX 在事务中执行 fn，fn 返回错误或发生 panic 时将所有数据表恢复到事务开始前的状态.
+// 在事务中再次调用 TX 时与数据库的 savepoint 一致，内层事务回滚只撤销内层的修改.
+func (s *memoryStore) TX(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx := &memoryTx{}
+	if parent, ok := s.currentTX(ctx); ok {
+		tx.root = parent.root
+	} else {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		tx.root = tx
+		s.tx.Store(tx)
+		defer s.tx.Store(nil)
+	}
+
+	restore := s.snapshot()
+	committed := false
+	defer func() {
+		if !committed {
+			restore()
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, memoryTransactionKey{}, tx)); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// currentTX 返回 ctx 携带的当前正在执行的事务.
+func (s *memoryStore) currentTX(ctx context.Context) (*memoryTx, bool) {
+	tx, ok := ctx.Value(memoryTransactionKey{}).(*memoryTx)
+	if !ok || tx.root != s.tx.Load() {
+		return nil, false
+	}
+	return tx, true
+}
+
+// inTX 判断 ctx 是否携带当前正在执行的事务.
+func (s *memoryStore) inTX(ctx context.Context) bool {
+	_, ok := s.currentTX(ctx)
+	return ok
+}
+
+// lock 获取写锁并返回解锁函数，事务中已经持有锁时不再重复加锁.
+func (s *memoryStore) lock(ctx context.Context) func() {
+	if s.inTX(ctx) {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+// rlock 获取读锁并返回解锁函数，事务中已经持有锁时不再重复加锁.
+func (s *memoryStore) rlock(ctx context.Context) func() {
+	if s.inTX(ctx) {
+		return func() {}
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock
+}
+
+// snapshot 保存所有数据表的当前状态，返回用于回滚的函数.
+func (s *memoryStore) snapshot() func() {
+	restores := []func(){
+		s.users.snapshot(),
+		s.posts.snapshot(),
+		s.postRevisions.snapshot(),
+		s.tags.snapshot(),
+		s.categories.snapshot(),
+		s.postTags.snapshot(),
+		s.postCategories.snapshot(),
+		s.comments.snapshot(),
+		s.refreshTokens.snapshot(),
+		s.loginAudits.snapshot(),
+		s.userTOTPs.snapshot(),
+		s.recoveryCodes.snapshot(),
+	}
+	return func() {
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+// User 返回一个实现UserStore接口的实例
+func (s *memoryStore) User() UserStore {
+	return &memoryUserStore{newMemoryResource(s, s.users, errorx.ErrUserNotFound, desc("id"))}
+}
+
+// Post 返回一个实现PostStore接口的实例
+func (s *memoryStore) Post() PostStore {
+	return &memoryPostStore{newMemoryResource(s, s.posts, errorx.ErrPostNotFound, desc("id"))}
+}
+
+// PostRevision 返回一个实现PostRevisionStore接口的实例
+func (s *memoryStore) PostRevision() PostRevisionStore {
+	return &memoryPostRevisionStore{newMemoryResource(s, s.postRevisions, errorx.ErrPostRevisionNotFound, desc("version"))}
+}
+
+// Tag 返回一个实现TagStore接口的实例
+func (s *memoryStore) Tag() TagStore {
+	return &memoryTagStore{newMemoryResource(s, s.tags, errorx.ErrTagNotFound, desc("id"))}
+}
+
+// Category 返回一个实现CategoryStore接口的实例
+func (s *memoryStore) Category() CategoryStore {
+	return &memoryCategoryStore{newMemoryResource(s, s.categories, errorx.ErrCategoryNotFound, desc("id"))}
+}
+
+// PostTag 返回一个实现PostTagStore接口的实例
+func (s *memoryStore) PostTag() PostTagStore {
+	return &memoryPostTagStore{newMemoryResource(s, s.postTags, nil, asc("id"))}
+}
+
+// PostCategory 返回一个实现PostCategoryStore接口的实例
+func (s *memoryStore) PostCategory() PostCategoryStore {
+	return &memoryPostCategoryStore{newMemoryResource(s, s.postCategories, nil, asc("id"))}
+}
+
+// Comment 返回一个实现CommentStore接口的实例
+func (s *memoryStore) Comment() CommentStore {
+	return &memoryCommentStore{newMemoryResource(s, s.comments, errorx.ErrCommentNotFound, desc("id"))}
+}
+
+// RefreshToken 返回一个实现RefreshTokenStore接口的实例
+func (s *memoryStore) RefreshToken() RefreshTokenStore {
+	return &memoryRefreshTokenStore{newMemoryResource(s, s.refreshTokens, errorx.ErrRefreshTokenInvalid, desc("id"))}
+}
+
+// LoginAudit 返回一个实现LoginAuditStore接口的实例
+func (s *memoryStore) LoginAudit() LoginAuditStore {
+	return &memoryLoginAuditStore{newMemoryResource(s, s.loginAudits, nil, desc("id"))}
+}
+
+// UserTOTP 返回一个实现UserTOTPStore接口的实例
+func (s *memoryStore) UserTOTP() UserTOTPStore {
+	return &memoryUserTOTPStore{newMemoryResource(s, s.userTOTPs, errorx.ErrTOTPNotEnrolled, desc("id"))}
+}
+
+// RecoveryCode 返回一个实现RecoveryCodeStore接口的实例
+func (s *memoryStore) RecoveryCode() RecoveryCodeStore {
+	return &memoryRecoveryCodeStore{newMemoryResource(s, s.recoveryCodes, errorx.ErrTOTPCodeInvalid, asc("id"))}
+}
+
+// memoryUserStore 是 UserStore 接口的内存实现.
+type memoryUserStore struct {
+	*memoryResource[model.User]
+}
+
+// memoryPostStore 是 PostStore 接口的内存实现.
+type memoryPostStore struct {
+	*memoryResource[model.Post]
+}
+
+// memoryPostRevisionStore 是 PostRevisionStore 接口的内存实现.
+type memoryPostRevisionStore struct {
+	*memoryResource[model.PostRevision]
+}
+
+// LatestVersion 返回帖子最新的历史版本号，没有历史版本时返回 0.
+func (s *memoryPostRevisionStore) LatestVersion(ctx context.Context, postID string) (int64, error) {
+	defer s.store.rlock(ctx)()
+
+	var version int64
+	for _, row := range s.table.rows {
+		if row.PostID == postID && row.Version > version {
+			version = row.Version
+		}
+	}
+	return version, nil
+}
+
+// memoryTagStore 是 TagStore 接口的内存实现.
+type memoryTagStore struct {
+	*memoryResource[model.Tag]
+}
+
+// memoryCategoryStore 是 CategoryStore 接口的内存实现.
+type memoryCategoryStore struct {
+	*memoryResource[model.Category]
+}
+
+// memoryPostTagStore 是 PostTagStore 接口的内存实现.
+type memoryPostTagStore struct {
+	*memoryResource[model.PostTag]
+}
+
+// CountPosts 统计每个标签关联的博文数量，不包括回收站中的博文，没有关联博文的标签不会出现在结果中.
+func (s *memoryPostTagStore) CountPosts(ctx context.Context, tagIDs []string) (map[string]int64, error) {
+	defer s.store.rlock(ctx)()
+
+	wanted := make(map[string]struct{}, len(tagIDs))
+	for _, tagID := range tagIDs {
+		wanted[tagID] = struct{}{}
+	}
+	posts := make(map[string]struct{}, len(s.store.posts.rows))
+	for _, post := range s.store.posts.rows {
+		if !post.DeletedAt.Valid {
+			posts[post.PostID] = struct{}{}
+		}
+	}
+
+	counts := make(map[string]int64)
+	for _, row := range s.table.rows {
+		_, tagged := wanted[row.TagID]
+		_, alive := posts[row.PostID]
+		if tagged && alive {
+			counts[row.TagID]++
+		}
+	}
+	return counts, nil
+}
+
+// memoryPostCategoryStore 是 PostCategoryStore 接口的内存实现.
+type memoryPostCategoryStore struct {
+	*memoryResource[model.PostCategory]
+}
+
+// memoryCommentStore 是 CommentStore 接口的内存实现.
+type memoryCommentStore struct {
+	*memoryResource[model.Comment]
+}
+
+// memoryLoginAuditStore 是 LoginAuditStore 接口的内存实现.
+type memoryLoginAuditStore struct {
+	*memoryResource[model.LoginAudit]
+}
+
+// memoryRefreshTokenStore 是 RefreshTokenStore 接口的内存实现.
+type memoryRefreshTokenStore struct {
+	*memoryResource[model.RefreshToken]
+}
+
+// MarkUsed 将未使用的刷新令牌标记为已使用，令牌已经被使用过时返回 false.
+func (s *memoryRefreshTokenStore) MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error) {
+	defer s.store.lock(ctx)()
+
+	i, found := s.table.index(id)
+	if !found || s.table.rows[i].UsedAt != nil {
+		return false, nil
+	}
+	// 表中的行不会原地修改，修改副本后整体替换
+	row := *s.table.rows[i]
+	row.UsedAt = &usedAt
+	s.table.rows[i] = &row
+	return true, nil
+}
+
+// Revoke 撤销满足条件的所有未撤销的令牌.
+func (s *memoryRefreshTokenStore) Revoke(ctx context.Context, opts *where.Options, revokedAt time.Time) error {
+	defer s.store.lock(ctx)()
+
+	matched, err := s.table.filter(opts, scopeDefault)
+	if err != nil {
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+	for _, row := range matched {
+		if row.RevokedAt != nil {
+			continue
+		}
+		i, _ := s.table.index(row.ID)
+		revoked := *row
+		revoked.RevokedAt = &revokedAt
+		s.table.rows[i] = &revoked
+	}
+	return nil
+}
+
+// Revoked 判断令牌家族中是否有已撤销的令牌，家族中没有任何令牌时视为已撤销.
+func (s *memoryRefreshTokenStore) Revoked(ctx context.Context, familyID string) (bool, error) {
+	defer s.store.rlock(ctx)()
+
+	found := false
+	for _, row := range s.table.rows {
+		if row.FamilyID != familyID {
+			continue
+		}
+		if row.RevokedAt != nil {
+			return true, nil
+		}
+		found = true
+	}
+	return !found, nil
+}
+
+// memoryUserTOTPStore 是 UserTOTPStore 接口的内存实现.
+type memoryUserTOTPStore struct {
+	*memoryResource[model.UserTOTP]
+}
+
+// UseStep 记录验证码的时间步，时间步不大于已使用的时间步时返回 false.
+func (s *memoryUserTOTPStore) UseStep(ctx context.Context, id int64, step int64) (bool, error) {
+	defer s.store.lock(ctx)()
+
+	i, found := s.table.index(id)
+	if !found || s.table.rows[i].LastUsedStep >= step {
+		return false, nil
+	}
+	row := *s.table.rows[i]
+	row.LastUsedStep = step
+	s.table.rows[i] = &row
+	return true, nil
+}
+
+// memoryRecoveryCodeStore 是 RecoveryCodeStore 接口的内存实现.
+type memoryRecoveryCodeStore struct {
+	*memoryResource[model.RecoveryCode]
+}
+
+// MarkUsed 将未使用的恢复码标记为已使用，恢复码已经被使用过时返回 false.
+func (s *memoryRecoveryCodeStore) MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error) {
+	defer s.store.lock(ctx)()
+
+	i, found := s.table.index(id)
+	if !found || s.table.rows[i].UsedAt != nil {
+		return false, nil
+	}
+	row := *s.table.rows[i]
+	row.UsedAt = &usedAt
+	s.table.rows[i] = &row
+	return true, nil
+}
+
+// 确保内存实现满足对应的 store 接口.
+var (
+	_ UserStore         = (*memoryUserStore)(nil)
+	_ PostStore         = (*memoryPostStore)(nil)
+	_ PostRevisionStore = (*memoryPostRevisionStore)(nil)
+	_ TagStore          = (*memoryTagStore)(nil)
+	_ CategoryStore     = (*memoryCategoryStore)(nil)
+	_ PostTagStore      = (*memoryPostTagStore)(nil)
+	_ PostCategoryStore = (*memoryPostCategoryStore)(nil)
+	_ CommentStore      = (*memoryCommentStore)(nil)
+	_ RefreshTokenStore = (*memoryRefreshTokenStore)(nil)
+	_ LoginAuditStore   = (*memoryLoginAuditStore)(nil)
+	_ UserTOTPStore     = (*memoryUserTOTPStore)(nil)
+	_ RecoveryCodeStore = (*memoryRecoveryCodeStore)(nil)
+)
@@ -0,0 +1,459 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// memoryTable 保存一张表的数据，行按自增 ID 升序存放.
+// 表中保存的是对象的副本，写入时整体替换，不会原地修改，因此回滚时只需要恢复切片.
+type memoryTable[T any] struct {
+	name   string
+	rows   []*T
+	nextID int64
+	// onCreate 在分配自增 ID 之后调用，用于生成资源 ID
+	onCreate func(obj *T)
+	// uniques 是唯一索引包含的列
+	uniques [][]string
+	// softDelete 表示表中有 gorm.DeletedAt 类型的 deletedAt 列，与 gorm 一致，删除时只设置删除时间
+	softDelete bool
+}
+
+// memoryScope 定义查询时如何处理软删除的行.
+type memoryScope int
+
+const (
+	// scopeDefault 排除已软删除的行
+	scopeDefault memoryScope = iota
+	// scopeUnscoped 包含已软删除的行，与 gorm 的 Unscoped 一致
+	scopeUnscoped
+	// scopeDeleted 只包含已软删除的行
+	scopeDeleted
+)
+
+// newMemoryTable 创建一张内存数据表.
+func newMemoryTable[T any](name string, onCreate func(obj *T), uniques ...[]string) *memoryTable[T] {
+	t := reflect.TypeFor[T]()
+	i, ok := columnsOf(t)["deletedAt"]
+	softDelete := ok && t.Field(i).Type == reflect.TypeFor[gorm.DeletedAt]()
+
+	return &memoryTable[T]{name: name, onCreate: onCreate, uniques: uniques, softDelete: softDelete}
+}
+
+// snapshot 保存数据表的当前状态，返回用于回滚的函数.
+func (t *memoryTable[T]) snapshot() func() {
+	rows, nextID := slices.Clone(t.rows), t.nextID
+	return func() {
+		t.rows, t.nextID = rows, nextID
+	}
+}
+
+// index 返回 ID 对应的行下标，行不存在时返回 false.
+func (t *memoryTable[T]) index(id int64) (int, bool) {
+	return slices.BinarySearchFunc(t.rows, id, func(row *T, id int64) int {
+		return cmp.Compare(rowID(row), id)
+	})
+}
+
+// insert 插入一行数据，ID 为 0 时分配自增 ID 并设置创建时间.
+func (t *memoryTable[T]) insert(obj *T) error {
+	if rowID(obj) == 0 {
+		setRowID(obj, t.nextID+1)
+		if t.onCreate != nil {
+			t.onCreate(obj)
+		}
+	}
+	now := time.Now()
+	setZeroTime(obj, "createdAt", now)
+	setZeroTime(obj, "updatedAt", now)
+
+	id := rowID(obj)
+	i, found := t.index(id)
+	if found {
+		return fmt.Errorf("UNIQUE constraint failed: %s.id", t.name)
+	}
+	if err := t.checkUnique(obj); err != nil {
+		return err
+	}
+
+	row := *obj
+	t.rows = slices.Insert(t.rows, i, &row)
+	t.nextID = max(t.nextID, id)
+	return nil
+}
+
+// save 与 gorm 的 Save 一致：ID 为 0 或行不存在时插入，否则整体替换并更新修改时间.
+func (t *memoryTable[T]) save(obj *T) error {
+	i, found := t.index(rowID(obj))
+	if rowID(obj) == 0 || !found {
+		return t.insert(obj)
+	}
+
+	setTime(obj, "updatedAt", time.Now())
+	if err := t.checkUnique(obj); err != nil {
+		return err
+	}
+
+	row := *obj
+	t.rows[i] = &row
+	return nil
+}
+
+// checkUnique 检查 obj 是否与其它行的唯一索引冲突.
+func (t *memoryTable[T]) checkUnique(obj *T) error {
+	target := reflect.ValueOf(obj).Elem()
+	for _, columns := range t.uniques {
+		for _, row := range t.rows {
+			if rowID(row) == rowID(obj) {
+				continue
+			}
+			current := reflect.ValueOf(row).Elem()
+			if !slices.ContainsFunc(columns, func(column string) bool {
+				return !equalValues(columnValue(current, column), columnValue(target, column))
+			}) {
+				return fmt.Errorf("UNIQUE constraint failed: %s.%s", t.name, strings.Join(columns, "."))
+			}
+		}
+	}
+	return nil
+}
+
+// filter 返回满足查询条件的行，保持 ID 升序，scope 决定是否包含已软删除的行.
+func (t *memoryTable[T]) filter(opts *where.Options, scope memoryScope) ([]*T, error) {
+	match, err := compileWhere(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*T
+	for _, row := range t.rows {
+		if t.softDelete && scope != scopeUnscoped {
+			deleted := columnValue(reflect.ValueOf(row).Elem(), "deletedAt") != nil
+			if deleted != (scope == scopeDeleted) {
+				continue
+			}
+		}
+
+		ok, err := match(reflect.ValueOf(row).Elem())
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			ret = append(ret, row)
+		}
+	}
+	return ret, nil
+}
+
+// memoryOrder 定义列表查询的排序方式.
+type memoryOrder struct {
+	column string
+	desc   bool
+}
+
+// asc 按列升序排列.
+func asc(column string) memoryOrder {
+	return memoryOrder{column: column}
+}
+
+// desc 按列降序排列.
+func desc(column string) memoryOrder {
+	return memoryOrder{column: column, desc: true}
+}
+
+// orderBy 返回 opts 中通过 clause.OrderBy 指定的排序方式.
+func orderBy(opts *where.Options) []memoryOrder {
+	if opts == nil {
+		return nil
+	}
+
+	var orders []memoryOrder
+	for _, expr := range opts.Clauses {
+		if by, ok := expr.(clause.OrderBy); ok {
+			for _, column := range by.Columns {
+				orders = append(orders, memoryOrder{column: column.Column.Name, desc: column.Desc})
+			}
+		}
+	}
+	return orders
+}
+
+// sortRows 按 orders 依次比较各列，对行进行稳定排序.
+func sortRows[T any](rows []*T, orders []memoryOrder) {
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, order := range orders {
+			c, _ := compareValues(
+				columnValue(reflect.ValueOf(rows[i]).Elem(), order.column),
+				columnValue(reflect.ValueOf(rows[j]).Elem(), order.column),
+			)
+			if c == 0 {
+				continue
+			}
+			if order.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	})
+}
+
+// memoryResource 实现了各个 store 通用的增删改查方法.
+type memoryResource[T any] struct {
+	store *memoryStore
+	table *memoryTable[T]
+	// notFound 是 Get 查询不到记录时返回的错误
+	notFound error
+	// order 是 List 的排序方式
+	order memoryOrder
+}
+
+// newMemoryResource 创建 memoryResource 的实例.
+func newMemoryResource[T any](store *memoryStore, table *memoryTable[T], notFound error, order memoryOrder) *memoryResource[T] {
+	return &memoryResource[T]{store: store, table: table, notFound: notFound, order: order}
+}
+
+// Create 插入一条记录，并回填自增 ID 和资源 ID.
+func (s *memoryResource[T]) Create(ctx context.Context, obj *T) error {
+	defer s.store.lock(ctx)()
+
+	if err := s.table.insert(obj); err != nil {
+		log.With(ctx).Errorw("Failed to insert record into memory store", "err", err, "table", s.table.name)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Update 更新一条记录.
+func (s *memoryResource[T]) Update(ctx context.Context, obj *T) error {
+	defer s.store.lock(ctx)()
+
+	if err := s.table.save(obj); err != nil {
+		log.With(ctx).Errorw("Failed to update record in memory store", "err", err, "table", s.table.name)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Delete 根据条件删除记录，与 gorm 一致，没有任何条件时拒绝删除整张表，支持软删除的表只设置删除时间.
+func (s *memoryResource[T]) Delete(ctx context.Context, opts *where.Options) error {
+	return s.delete(ctx, opts, s.table.softDelete)
+}
+
+// Purge 根据条件彻底删除记录，包括已软删除的记录.
+func (s *memoryResource[T]) Purge(ctx context.Context, opts *where.Options) error {
+	return s.delete(ctx, opts, false)
+}
+
+// delete 删除满足条件的记录，soft 为 true 时只设置删除时间.
+func (s *memoryResource[T]) delete(ctx context.Context, opts *where.Options, soft bool) error {
+	defer s.store.lock(ctx)()
+
+	if opts == nil || len(opts.Filters)+len(opts.Clauses)+len(opts.Queries) == 0 {
+		return errorx.ErrDBWrite.WithMessage("WHERE conditions required")
+	}
+
+	scope := scopeUnscoped
+	if soft {
+		scope = scopeDefault
+	}
+	matched, err := s.table.filter(opts, scope)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to delete records from memory store", "err", err, "table", s.table.name, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	if !soft {
+		s.table.rows = slices.DeleteFunc(s.table.rows, func(row *T) bool {
+			return slices.Contains(matched, row)
+		})
+		return nil
+	}
+
+	// 表中的行不会原地修改，设置删除时间后整体替换
+	deletedAt := gorm.DeletedAt{Time: time.Now(), Valid: true}
+	for _, row := range matched {
+		i, _ := s.table.index(rowID(row))
+		obj := *row
+		setColumn(&obj, "deletedAt", deletedAt)
+		s.table.rows[i] = &obj
+	}
+	return nil
+}
+
+// Updates 与 gorm 的 Updates 一致，只更新满足条件的记录中 values 指定的列，同时更新修改时间，返回更新的记录数.
+func (s *memoryResource[T]) Updates(ctx context.Context, opts *where.Options, values map[string]any) (int64, error) {
+	defer s.store.lock(ctx)()
+
+	if opts == nil || len(opts.Filters)+len(opts.Clauses)+len(opts.Queries) == 0 {
+		return 0, errorx.ErrDBWrite.WithMessage("WHERE conditions required")
+	}
+
+	matched, err := s.table.filter(opts, scopeDefault)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to update records in memory store", "err", err, "table", s.table.name, "conditions", opts)
+		return 0, errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	// 所有行都更新成功后再整体替换，避免部分更新
+	now := time.Now()
+	updated := make([]*T, 0, len(matched))
+	for _, row := range matched {
+		obj := *row
+		for column, value := range values {
+			if err := assignColumn(&obj, column, value); err != nil {
+				return 0, errorx.ErrDBWrite.WithMessage(err.Error())
+			}
+		}
+		if _, ok := values["updatedAt"]; !ok {
+			setTime(&obj, "updatedAt", now)
+		}
+		if err := s.table.checkUnique(&obj); err != nil {
+			return 0, errorx.ErrDBWrite.WithMessage(err.Error())
+		}
+		updated = append(updated, &obj)
+	}
+	for _, obj := range updated {
+		i, _ := s.table.index(rowID(obj))
+		s.table.rows[i] = obj
+	}
+	return int64(len(matched)), nil
+}
+
+// Restore 根据条件恢复已软删除的记录，与 gorm 的 Update 一致，同时更新修改时间.
+func (s *memoryResource[T]) Restore(ctx context.Context, opts *where.Options) error {
+	defer s.store.lock(ctx)()
+
+	matched, err := s.table.filter(opts, scopeDeleted)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to restore records in memory store", "err", err, "table", s.table.name, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	now := time.Now()
+	for _, row := range matched {
+		i, _ := s.table.index(rowID(row))
+		obj := *row
+		setColumn(&obj, "deletedAt", gorm.DeletedAt{})
+		setTime(&obj, "updatedAt", now)
+		s.table.rows[i] = &obj
+	}
+	return nil
+}
+
+// Get 根据条件查询 ID 最小的一条记录.
+func (s *memoryResource[T]) Get(ctx context.Context, opts *where.Options) (*T, error) {
+	defer s.store.rlock(ctx)()
+
+	matched, err := s.table.filter(opts, scopeDefault)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to retrieve record from memory store", "err", err, "table", s.table.name, "conditions", opts)
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	if len(matched) == 0 {
+		return nil, s.notFound
+	}
+
+	obj := *matched[0]
+	return &obj, nil
+}
+
+// List 返回分页后的记录列表和满足条件的总数.
+func (s *memoryResource[T]) List(ctx context.Context, opts *where.Options) (int64, []*T, error) {
+	return s.list(ctx, opts, scopeDefault)
+}
+
+// ListDeleted 返回已软删除的记录列表和满足条件的总数.
+func (s *memoryResource[T]) ListDeleted(ctx context.Context, opts *where.Options) (int64, []*T, error) {
+	return s.list(ctx, opts, scopeDeleted)
+}
+
+// list 按 scope 查询记录，返回排序、分页后的记录列表和满足条件的总数.
+func (s *memoryResource[T]) list(ctx context.Context, opts *where.Options, scope memoryScope) (int64, []*T, error) {
+	defer s.store.rlock(ctx)()
+
+	matched, err := s.table.filter(opts, scope)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list records from memory store", "err", err, "table", s.table.name, "conditions", opts)
+		return 0, nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	sortRows(matched, append(orderBy(opts), s.order))
+
+	count := int64(len(matched))
+	if opts != nil {
+		matched = paginate(matched, opts.Offset, opts.Limit)
+	}
+
+	ret := make([]*T, 0, len(matched))
+	for _, row := range matched {
+		obj := *row
+		ret = append(ret, &obj)
+	}
+	return count, ret, nil
+}
+
+// Seek 按 createdAt、id 倒序返回排在游标 after 之后的记录，只使用 opts 中的 Limit 限制数量.
+func (s *memoryResource[T]) Seek(ctx context.Context, opts *where.Options, after *Cursor) ([]*T, error) {
+	defer s.store.rlock(ctx)()
+
+	seek := where.NewWhere()
+	if opts != nil {
+		seek.Filters, seek.Clauses, seek.Queries = opts.Filters, slices.Clone(opts.Clauses), opts.Queries
+		seek.Limit = opts.Limit
+	}
+	if after != nil {
+		seek = seek.C(after.after())
+	}
+	matched, err := s.table.filter(seek, scopeDefault)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to seek records from memory store", "err", err, "table", s.table.name, "conditions", opts)
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	sortRows(matched, []memoryOrder{desc("createdAt"), desc("id")})
+	matched = paginate(matched, 0, seek.Limit)
+
+	ret := make([]*T, 0, len(matched))
+	for _, row := range matched {
+		obj := *row
+		ret = append(ret, &obj)
+	}
+	return ret, nil
+}
+
+// Count 返回满足条件的记录总数.
+func (s *memoryResource[T]) Count(ctx context.Context, opts *where.Options) (int64, error) {
+	defer s.store.rlock(ctx)()
+
+	matched, err := s.table.filter(opts, scopeDefault)
+	if err != nil {
+		log.With(ctx).Errorw("Failed to count records in memory store", "err", err, "table", s.table.name, "conditions", opts)
+		return 0, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return int64(len(matched)), nil
+}
+
+// paginate 按 offset 和 limit 截取列表，limit 小于 0 表示不限制数量.
+func paginate[T any](rows []T, offset, limit int) []T {
+	if offset > 0 {
+		rows = rows[min(offset, len(rows)):]
+	}
+	if limit >= 0 && limit < len(rows) {
+		rows = rows[:limit]
+	}
+	return rows
+}
@@ -0,0 +1,146 @@
+package store_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/rid"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+
+	userM := &model.User{Username: "colin", Phone: "18110000000"}
+	require.NoError(t, s.User().Create(ctx, userM))
+	assert.EqualValues(t, 1, userM.ID)
+	assert.Equal(t, rid.UserID.New(1), userM.UserID)
+	assert.False(t, userM.CreatedAt.IsZero())
+
+	// 与数据库一致，违反唯一索引时写入失败
+	err := s.User().Create(ctx, &model.User{Username: "colin", Phone: "18110000001"})
+	assert.ErrorIs(t, err, errorx.ErrDBWrite)
+
+	for i := range 5 {
+		postM := &model.Post{UserID: userM.UserID, Title: fmt.Sprintf("Go tips %d", i), Status: int32(i % 2)}
+		require.NoError(t, s.Post().Create(ctx, postM))
+	}
+
+	// 按 ID 倒序分页，总数不受分页影响
+	count, posts, err := s.Post().List(ctx, where.F("userID", userM.UserID).P(2, 2))
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, count)
+	require.Len(t, posts, 2)
+	assert.Equal(t, "Go tips 2", posts[0].Title)
+	assert.Equal(t, "Go tips 1", posts[1].Title)
+
+	// 切片过滤条件、原生查询和子句
+	count, _, err = s.Post().List(ctx, where.F("status", int32(1)).Q("title like ?", "%TIPS%"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	count, _, err = s.Post().List(ctx, where.F("postID", []string{posts[0].PostID, "post-unknown"}))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	count, _, err = s.Post().List(ctx, where.C(clause.Gt{Column: clause.Column{Name: "id"}, Value: 3}))
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	count, _, err = s.Post().List(ctx, where.F("postID", []string{}))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// 未设置发布时间的文章不满足比较条件
+	publishedAt := time.Now().Add(-time.Minute)
+	posts[0].PublishedAt = &publishedAt
+	require.NoError(t, s.Post().Update(ctx, posts[0]))
+	count, _, err = s.Post().List(ctx, where.C(clause.Lte{Column: clause.Column{Name: "publishedAt"}, Value: time.Now()}))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	_, err = s.Post().Get(ctx, where.F("postID", "post-unknown"))
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	_, _, err = s.Post().List(ctx, where.F("unknown", 1))
+	assert.ErrorIs(t, err, errorx.ErrDBRead)
+
+	// 事务出错或 panic 时回滚
+	err = s.TX(ctx, func(ctx context.Context) error {
+		if err := s.Post().Delete(ctx, where.F("userID", userM.UserID)); err != nil {
+			return err
+		}
+		if err := s.PostRevision().Create(ctx, &model.PostRevision{PostID: posts[0].PostID, Version: 1}); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	require.Error(t, err)
+	assert.Panics(t, func() {
+		_ = s.TX(ctx, func(ctx context.Context) error {
+			_ = s.Post().Delete(ctx, where.F("userID", userM.UserID))
+			panic("rollback")
+		})
+	})
+	count, _, err = s.Post().List(ctx, where.F("userID", userM.UserID))
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, count)
+	version, err := s.PostRevision().LatestVersion(ctx, posts[0].PostID)
+	require.NoError(t, err)
+	assert.Zero(t, version)
+
+	// 内层事务回滚只撤销内层的修改，外层事务提交后数据可见
+	err = s.TX(ctx, func(ctx context.Context) error {
+		if err := s.Post().Delete(ctx, where.F("postID", posts[0].PostID)); err != nil {
+			return err
+		}
+		err := s.TX(ctx, func(ctx context.Context) error {
+			if err := s.Post().Delete(ctx, where.F("postID", posts[1].PostID)); err != nil {
+				return err
+			}
+			return errors.New("rollback")
+		})
+		assert.Error(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+	_, err = s.Post().Get(ctx, where.F("postID", posts[0].PostID))
+	assert.ErrorIs(t, err, errorx.ErrPostNotFound)
+	_, err = s.Post().Get(ctx, where.F("postID", posts[1].PostID))
+	assert.NoError(t, err)
+}
+
+func TestMemoryStoreConcurrency(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewMemoryStore()
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = s.TX(ctx, func(ctx context.Context) error {
+				return s.Tag().Create(ctx, &model.Tag{UserID: "user-1", Name: fmt.Sprintf("tag-%d", i)})
+			})
+			_, _, _ = s.Tag().List(ctx, where.F("userID", "user-1"))
+		}()
+	}
+	wg.Wait()
+
+	count, tags, err := s.Tag().List(ctx, where.F("userID", "user-1"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 20, count)
+	ids := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		ids[tag.TagID] = struct{}{}
+	}
+	assert.Len(t, ids, 20)
+}
@@ -0,0 +1,537 @@
+package store
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm/clause"
+)
+
+// memoryCond 判断一行数据是否满足条件.
+type memoryCond func(row reflect.Value) (bool, error)
+
+// compileWhere 将 where.Options 中的过滤条件、子句和原生查询编译为内存中的判断函数.
+// 支持 biz 层使用的等值、IN、比较、LIKE、IS NULL 以及 AND/OR/NOT 组合，其它条件返回错误.
+func compileWhere(opts *where.Options) (memoryCond, error) {
+	if opts == nil {
+		return allOf(nil), nil
+	}
+
+	var conds []memoryCond
+	for key, value := range opts.Filters {
+		column, err := columnName(key)
+		if err != nil {
+			return nil, err
+		}
+		if values, ok := sliceValues(value); ok {
+			conds = append(conds, inCond(column, values))
+			continue
+		}
+		conds = append(conds, eqCond(column, value))
+	}
+
+	for _, expr := range opts.Clauses {
+		// 排序子句由 List 处理，不参与过滤
+		if _, ok := expr.(clause.OrderBy); ok {
+			continue
+		}
+		// 内存存储的事务持有全局锁，行锁子句不需要处理
+		if _, ok := expr.(clause.Locking); ok {
+			continue
+		}
+		cond, err := compileExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+
+	for _, query := range opts.Queries {
+		sql, ok := query.Query.(string)
+		if !ok {
+			return nil, fmt.Errorf("unsupported query type %T", query.Query)
+		}
+		cond, err := compileSQL(sql, query.Args)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+
+	return allOf(conds), nil
+}
+
+// compileExpr 编译 gorm 的子句表达式.
+func compileExpr(expr clause.Expression) (memoryCond, error) {
+	switch e := expr.(type) {
+	case clause.Eq:
+		return compareExpr(e.Column, func(column string) memoryCond {
+			if values, ok := sliceValues(e.Value); ok {
+				return inCond(column, values)
+			}
+			return eqCond(column, e.Value)
+		})
+	case clause.Neq:
+		return compareExpr(e.Column, func(column string) memoryCond {
+			return notCond(eqCond(column, e.Value))
+		})
+	case clause.Gt:
+		return compareExpr(e.Column, func(column string) memoryCond { return cmpCond(column, e.Value, ">") })
+	case clause.Gte:
+		return compareExpr(e.Column, func(column string) memoryCond { return cmpCond(column, e.Value, ">=") })
+	case clause.Lt:
+		return compareExpr(e.Column, func(column string) memoryCond { return cmpCond(column, e.Value, "<") })
+	case clause.Lte:
+		return compareExpr(e.Column, func(column string) memoryCond { return cmpCond(column, e.Value, "<=") })
+	case clause.IN:
+		return compareExpr(e.Column, func(column string) memoryCond { return inCond(column, e.Values) })
+	case clause.Like:
+		pattern, ok := e.Value.(string)
+		if !ok {
+			return nil, fmt.Errorf("unsupported LIKE pattern %v", e.Value)
+		}
+		return compareExpr(e.Column, func(column string) memoryCond { return likeCond(column, pattern) })
+	case clause.AndConditions:
+		conds, err := compileExprs(e.Exprs)
+		return allOf(conds), err
+	case clause.Where:
+		conds, err := compileExprs(e.Exprs)
+		return allOf(conds), err
+	case clause.OrConditions:
+		conds, err := compileExprs(e.Exprs)
+		return anyOf(conds), err
+	case clause.NotConditions:
+		conds, err := compileExprs(e.Exprs)
+		return notCond(allOf(conds)), err
+	case clause.Expr:
+		return compileSQL(e.SQL, e.Vars)
+	default:
+		return nil, fmt.Errorf("unsupported clause %T", expr)
+	}
+}
+
+// compileExprs 依次编译多个子句表达式.
+func compileExprs(exprs []clause.Expression) ([]memoryCond, error) {
+	conds := make([]memoryCond, 0, len(exprs))
+	for _, expr := range exprs {
+		cond, err := compileExpr(expr)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+	return conds, nil
+}
+
+// compareExpr 解析子句中的列名后创建条件.
+func compareExpr(column any, build func(column string) memoryCond) (memoryCond, error) {
+	name, err := columnName(column)
+	if err != nil {
+		return nil, err
+	}
+	return build(name), nil
+}
+
+var (
+	// sqlAnd 用于拆分以 AND 连接的原生查询条件
+	sqlAnd = regexp.MustCompile(`(?i)\s+and\s+`)
+	// sqlCompare 匹配 "column op ?" 形式的条件
+	sqlCompare = regexp.MustCompile("(?i)^\\s*[`\"]?(\\w+)[`\"]?\\s*(=|!=|<>|<=|>=|<|>|not\\s+like|like|not\\s+in|in)\\s*\\(?\\s*\\?\\s*\\)?\\s*$")
+	// sqlNull 匹配 "column IS [NOT] NULL" 形式的条件
+	sqlNull = regexp.MustCompile("(?i)^\\s*[`\"]?(\\w+)[`\"]?\\s+is\\s+(not\\s+)?null\\s*$")
+)
+
+// compileSQL 编译由 AND 连接的简单原生查询条件，例如 "title like ?".
+func compileSQL(sql string, args []any) (memoryCond, error) {
+	var conds []memoryCond
+	for _, part := range sqlAnd.Split(strings.TrimSpace(sql), -1) {
+		if m := sqlNull.FindStringSubmatch(part); m != nil {
+			cond := eqCond(m[1], nil)
+			if m[2] != "" {
+				cond = notCond(cond)
+			}
+			conds = append(conds, cond)
+			continue
+		}
+
+		m := sqlCompare.FindStringSubmatch(part)
+		if m == nil {
+			return nil, fmt.Errorf("unsupported query %q", sql)
+		}
+		if len(args) == 0 {
+			return nil, fmt.Errorf("missing argument for query %q", sql)
+		}
+		column, arg := m[1], args[0]
+		args = args[1:]
+
+		switch op := strings.ToLower(strings.Join(strings.Fields(m[2]), " ")); op {
+		case "=":
+			conds = append(conds, eqCond(column, arg))
+		case "!=", "<>":
+			conds = append(conds, notCond(eqCond(column, arg)))
+		case "like", "not like":
+			pattern, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported LIKE pattern %v", arg)
+			}
+			cond := likeCond(column, pattern)
+			if op == "not like" {
+				cond = notCond(cond)
+			}
+			conds = append(conds, cond)
+		case "in", "not in":
+			values, ok := sliceValues(arg)
+			if !ok {
+				values = []any{arg}
+			}
+			cond := inCond(column, values)
+			if op == "not in" {
+				cond = notCond(cond)
+			}
+			conds = append(conds, cond)
+		default:
+			conds = append(conds, cmpCond(column, arg, op))
+		}
+	}
+	if len(args) != 0 {
+		return nil, fmt.Errorf("too many arguments for query %q", sql)
+	}
+	return allOf(conds), nil
+}
+
+// allOf 返回所有条件都满足时成立的条件，没有条件时始终成立.
+func allOf(conds []memoryCond) memoryCond {
+	return func(row reflect.Value) (bool, error) {
+		for _, cond := range conds {
+			if ok, err := cond(row); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
+
+// anyOf 返回任一条件满足时成立的条件.
+func anyOf(conds []memoryCond) memoryCond {
+	return func(row reflect.Value) (bool, error) {
+		for _, cond := range conds {
+			if ok, err := cond(row); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+}
+
+// notCond 对条件取反.
+func notCond(cond memoryCond) memoryCond {
+	return func(row reflect.Value) (bool, error) {
+		ok, err := cond(row)
+		return !ok, err
+	}
+}
+
+// eqCond 判断列是否等于 value，value 为 nil 时判断列是否为 NULL.
+func eqCond(column string, value any) memoryCond {
+	want := normalizeValue(reflect.ValueOf(value))
+	return func(row reflect.Value) (bool, error) {
+		got, err := lookupColumn(row, column)
+		if err != nil {
+			return false, err
+		}
+		if got == nil || want == nil {
+			return got == nil && want == nil, nil
+		}
+		c, err := compareValues(got, want)
+		return c == 0, err
+	}
+}
+
+// inCond 判断列是否等于 values 中的任一值，values 为空时不匹配任何行.
+func inCond(column string, values []any) memoryCond {
+	conds := make([]memoryCond, 0, len(values))
+	for _, value := range values {
+		conds = append(conds, eqCond(column, value))
+	}
+	return anyOf(conds)
+}
+
+// cmpCond 使用比较运算符比较列和 value，列为 NULL 时不成立.
+func cmpCond(column string, value any, op string) memoryCond {
+	want := normalizeValue(reflect.ValueOf(value))
+	return func(row reflect.Value) (bool, error) {
+		got, err := lookupColumn(row, column)
+		if err != nil || got == nil || want == nil {
+			return false, err
+		}
+		c, err := compareValues(got, want)
+		if err != nil {
+			return false, err
+		}
+		switch op {
+		case ">":
+			return c > 0, nil
+		case ">=":
+			return c >= 0, nil
+		case "<":
+			return c < 0, nil
+		default:
+			return c <= 0, nil
+		}
+	}
+}
+
+// likeCond 按 SQL LIKE 语义匹配字符串列，与 MySQL 默认排序规则一致，不区分大小写.
+func likeCond(column, pattern string) memoryCond {
+	var expr strings.Builder
+	expr.WriteString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			expr.WriteString(".*")
+		case '_':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+	re := regexp.MustCompile(expr.String())
+
+	return func(row reflect.Value) (bool, error) {
+		got, err := lookupColumn(row, column)
+		if err != nil || got == nil {
+			return false, err
+		}
+		s, ok := got.(string)
+		if !ok {
+			return false, fmt.Errorf("column %s is not a string", column)
+		}
+		return re.MatchString(s), nil
+	}
+}
+
+// columnName 获取过滤条件或子句中的列名.
+func columnName(column any) (string, error) {
+	switch c := column.(type) {
+	case string:
+		return strings.Trim(c, "`\""), nil
+	case clause.Column:
+		return c.Name, nil
+	default:
+		return "", fmt.Errorf("unsupported column %v", column)
+	}
+}
+
+// sliceValues 将切片或数组展开为元素列表，[]byte 不作为切片处理.
+func sliceValues(value any) ([]any, bool) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array || v.Type().Elem().Kind() == reflect.Uint8 {
+		return nil, false
+	}
+	values := make([]any, 0, v.Len())
+	for i := range v.Len() {
+		values = append(values, v.Index(i).Interface())
+	}
+	return values, true
+}
+
+// columnIndexes 缓存模型类型中列名到字段下标的映射.
+var columnIndexes sync.Map
+
+// columnsOf 根据 gorm 标签中的 column 返回列名到字段下标的映射.
+func columnsOf(t reflect.Type) map[string]int {
+	if columns, ok := columnIndexes.Load(t); ok {
+		return columns.(map[string]int)
+	}
+
+	columns := make(map[string]int, t.NumField())
+	for i := range t.NumField() {
+		for _, setting := range strings.Split(t.Field(i).Tag.Get("gorm"), ";") {
+			if name, ok := strings.CutPrefix(setting, "column:"); ok {
+				columns[name] = i
+			}
+		}
+	}
+	columnIndexes.Store(t, columns)
+	return columns
+}
+
+// lookupColumn 返回行中列的归一化值，列不存在时返回错误.
+func lookupColumn(row reflect.Value, column string) (any, error) {
+	i, ok := columnsOf(row.Type())[column]
+	if !ok {
+		return nil, fmt.Errorf("no such column: %s", column)
+	}
+	return normalizeValue(row.Field(i)), nil
+}
+
+// columnValue 返回行中列的归一化值，列不存在时返回 nil.
+func columnValue(row reflect.Value, column string) any {
+	value, _ := lookupColumn(row, column)
+	return value
+}
+
+// rowID 返回行的自增 ID.
+func rowID[T any](obj *T) int64 {
+	id, _ := columnValue(reflect.ValueOf(obj).Elem(), "id").(int64)
+	return id
+}
+
+// setRowID 设置行的自增 ID.
+func setRowID[T any](obj *T, id int64) {
+	row := reflect.ValueOf(obj).Elem()
+	row.Field(columnsOf(row.Type())["id"]).SetInt(id)
+}
+
+// setTime 设置 time.Time 类型的列.
+func setTime(obj any, column string, t time.Time) {
+	row := reflect.ValueOf(obj).Elem()
+	if i, ok := columnsOf(row.Type())[column]; ok && row.Field(i).Type() == reflect.TypeFor[time.Time]() {
+		row.Field(i).Set(reflect.ValueOf(t))
+	}
+}
+
+// setColumn 设置列的值，value 的类型需要与列的类型一致.
+func setColumn(obj any, column string, value any) {
+	row := reflect.ValueOf(obj).Elem()
+	if i, ok := columnsOf(row.Type())[column]; ok {
+		row.Field(i).Set(reflect.ValueOf(value))
+	}
+}
+
+// assignColumn 与 gorm 的 Updates 一致，将 value 转换为列的类型后赋值，nil 表示 NULL.
+func assignColumn(obj any, column string, value any) error {
+	row := reflect.ValueOf(obj).Elem()
+	i, ok := columnsOf(row.Type())[column]
+	if !ok {
+		return fmt.Errorf("unknown column %s", column)
+	}
+
+	field := row.Field(i)
+	if value == nil {
+		field.SetZero()
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Type().AssignableTo(field.Type()):
+		field.Set(v)
+	case field.Kind() == reflect.Pointer && v.Type().AssignableTo(field.Type().Elem()):
+		ptr := reflect.New(field.Type().Elem())
+		ptr.Elem().Set(v)
+		field.Set(ptr)
+	case v.Kind() != reflect.String && field.Kind() != reflect.String && v.Type().ConvertibleTo(field.Type()):
+		field.Set(v.Convert(field.Type()))
+	default:
+		return fmt.Errorf("cannot assign %T to column %s", value, column)
+	}
+	return nil
+}
+
+// setZeroTime 在 time.Time 类型的列为零值时设置为 t，与 gorm 创建记录时自动填充时间的行为一致.
+func setZeroTime(obj any, column string, t time.Time) {
+	if value, ok := columnValue(reflect.ValueOf(obj).Elem(), column).(time.Time); ok && value.IsZero() {
+		setTime(obj, column, t)
+	}
+}
+
+// normalizeValue 将值归一化为 int64、float64、string、bool、time.Time 或 nil（NULL），便于比较.
+func normalizeValue(v reflect.Value) any {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	}
+	if t, ok := v.Interface().(time.Time); ok {
+		return t
+	}
+	// gorm.DeletedAt 等实现了 driver.Valuer 的类型按写入数据库的值比较，无效时为 NULL
+	if valuer, ok := v.Interface().(driver.Valuer); ok {
+		value, err := valuer.Value()
+		if err != nil {
+			return nil
+		}
+		return normalizeValue(reflect.ValueOf(value))
+	}
+	return v.Interface()
+}
+
+// equalValues 判断两个归一化后的值是否相等，NULL 与任何值都不相等.
+func equalValues(a, b any) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	c, err := compareValues(a, b)
+	return err == nil && c == 0
+}
+
+// compareValues 比较两个非 NULL 的归一化值，类型不兼容时返回错误.
+func compareValues(a, b any) (int, error) {
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return cmp.Compare(x, y), nil
+		case float64:
+			return cmp.Compare(float64(x), y), nil
+		case bool:
+			return cmp.Compare(x, boolToInt(y)), nil
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return cmp.Compare(x, float64(y)), nil
+		case float64:
+			return cmp.Compare(x, y), nil
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	case bool:
+		switch y := b.(type) {
+		case bool:
+			return cmp.Compare(boolToInt(x), boolToInt(y)), nil
+		case int64:
+			return cmp.Compare(boolToInt(x), y), nil
+		}
+	case time.Time:
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %T with %T", a, b)
+}
+
+// boolToInt 将布尔值转换为数据库中的 0 和 1.
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/onexstack/onexstack/pkg/store/where"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
 	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
@@ -24,7 +25,15 @@ type PostStore interface {
 }
 
 // PostExpansion 定义了帖子操作的附加方法.
-type PostExpansion interface{}
+type PostExpansion interface {
+	Seek(ctx context.Context, opts *where.Options, after *Cursor) ([]*model.Post, error)
+	Count(ctx context.Context, opts *where.Options) (int64, error)
+	ListDeleted(ctx context.Context, opts *where.Options) (int64, []*model.Post, error)
+	Restore(ctx context.Context, opts *where.Options) error
+	Purge(ctx context.Context, opts *where.Options) error
+	// Updates 只更新满足条件的帖子的指定列，返回更新的记录数，条件中可以包含帖子的当前状态以实现条件更新.
+	Updates(ctx context.Context, opts *where.Options, values map[string]any) (int64, error)
+}
 
 // postStore 是 PostStore 接口的实现.
 type postStore struct {
@@ -59,7 +68,18 @@ func (s *postStore) Update(ctx context.Context, obj *model.Post) error {
 	return nil
 }
 
-// Delete 根据条件删除帖子记录.
+// Updates 只更新满足条件的帖子的 values 中的列，同时更新 updatedAt，不会覆盖其它请求并发修改的列.
+func (s *postStore) Updates(ctx context.Context, opts *where.Options, values map[string]any) (int64, error) {
+	result := s.store.DB(ctx, opts).Model(new(model.Post)).Updates(values)
+	if result.Error != nil {
+		log.With(ctx).Errorw("Failed to update post columns in database", "err", result.Error, "conditions", opts)
+		return 0, errorx.ErrDBWrite.WithMessage(result.Error.Error())
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Delete 根据条件软删除帖子记录，只设置 deletedAt，后续查询不再返回该帖子.
 func (s *postStore) Delete(ctx context.Context, opts *where.Options) error {
 	err := s.store.DB(ctx, opts).Delete(new(model.Post)).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -93,3 +113,63 @@ func (s *postStore) List(ctx context.Context, opts *where.Options) (count int64,
 	}
 	return
 }
+
+// Seek 按 createdAt、id 倒序返回排在游标 after 之后的帖子列表，after 为空时从第一条记录开始.
+// 只使用 opts 中的 Limit 限制数量，忽略 Offset，也不统计总数.
+func (s *postStore) Seek(ctx context.Context, opts *where.Options, after *Cursor) (ret []*model.Post, err error) {
+	db := s.store.DB(ctx, opts).Offset(-1)
+	if after != nil {
+		db = db.Where(after.after())
+	}
+	if err = db.Order(seekOrder).Find(&ret).Error; err != nil {
+		log.With(ctx).Errorw("Failed to seek posts from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// Count 返回满足条件的帖子总数，忽略分页参数.
+func (s *postStore) Count(ctx context.Context, opts *where.Options) (count int64, err error) {
+	err = s.store.DB(ctx, opts).Model(new(model.Post)).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to count posts in database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// ListDeleted 返回已软删除的帖子列表和总数.
+func (s *postStore) ListDeleted(ctx context.Context, opts *where.Options) (count int64, ret []*model.Post, err error) {
+	err = s.store.DB(ctx, opts).Unscoped().
+		Where(clause.Neq{Column: clause.Column{Name: "deletedAt"}, Value: nil}).
+		Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list deleted posts from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// Restore 根据条件恢复已软删除的帖子记录.
+func (s *postStore) Restore(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Unscoped().Model(new(model.Post)).
+		Where(clause.Neq{Column: clause.Column{Name: "deletedAt"}, Value: nil}).
+		Update("deletedAt", nil).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to restore post in database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Purge 根据条件彻底删除帖子记录，包括已软删除的帖子.
+func (s *postStore) Purge(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Unscoped().Delete(new(model.Post)).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to purge post from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
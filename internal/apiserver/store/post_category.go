@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// PostCategoryStore 定义了 post category 模块在 store 层所实现的方法.
+type PostCategoryStore interface {
+	Create(ctx context.Context, obj *model.PostCategory) error
+	Delete(ctx context.Context, opts *where.Options) error
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostCategory, error)
+
+	PostCategoryExpansion
+}
+
+// PostCategoryExpansion 定义了博文分类关联操作的附加方法.
+type PostCategoryExpansion interface{}
+
+// postCategoryStore 是 PostCategoryStore 接口的实现.
+type postCategoryStore struct {
+	store *dataStore
+}
+
+// 确保 postCategoryStore 实现了 PostCategoryStore 接口.
+var _ PostCategoryStore = (*postCategoryStore)(nil)
+
+// newPostCategoryStore 创建 postCategoryStore 的实例.
+func newPostCategoryStore(store *dataStore) *postCategoryStore {
+	return &postCategoryStore{store: store}
+}
+
+// Create 插入一条博文分类关联记录.
+func (s *postCategoryStore) Create(ctx context.Context, obj *model.PostCategory) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert post category into database", "err", err, "post category", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除博文分类关联记录.
+func (s *postCategoryStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.PostCategory)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete post category from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// List 返回博文分类关联列表和总数.
+func (s *postCategoryStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostCategory, err error) {
+	err = s.store.DB(ctx, opts).Order("id").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list post categories from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
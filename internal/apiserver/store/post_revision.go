@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// PostRevisionStore 定义了 post revision 模块在 store 层所实现的方法.
+type PostRevisionStore interface {
+	Create(ctx context.Context, obj *model.PostRevision) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostRevision, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostRevision, error)
+
+	PostRevisionExpansion
+}
+
+// PostRevisionExpansion 定义了帖子历史版本操作的附加方法.
+type PostRevisionExpansion interface {
+	LatestVersion(ctx context.Context, postID string) (int64, error)
+}
+
+// postRevisionStore 是 PostRevisionStore 接口的实现.
+type postRevisionStore struct {
+	store *dataStore
+}
+
+// 确保 postRevisionStore 实现了 PostRevisionStore 接口.
+var _ PostRevisionStore = (*postRevisionStore)(nil)
+
+// newPostRevisionStore 创建 postRevisionStore 的实例.
+func newPostRevisionStore(store *dataStore) *postRevisionStore {
+	return &postRevisionStore{store: store}
+}
+
+// Create 插入一条帖子历史版本记录.
+func (s *postRevisionStore) Create(ctx context.Context, obj *model.PostRevision) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert post revision into database", "err", err, "revision", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除帖子历史版本记录.
+func (s *postRevisionStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.PostRevision)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete post revision from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询帖子历史版本记录.
+func (s *postRevisionStore) Get(ctx context.Context, opts *where.Options) (*model.PostRevision, error) {
+	var obj model.PostRevision
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to retrieve post revision from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrPostRevisionNotFound
+		}
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回帖子历史版本列表和总数，按版本号从新到旧排列.
+func (s *postRevisionStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostRevision, err error) {
+	err = s.store.DB(ctx, opts).Order("version desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list post revisions from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// LatestVersion 返回帖子最新的历史版本号，没有历史版本时返回 0.
+func (s *postRevisionStore) LatestVersion(ctx context.Context, postID string) (int64, error) {
+	var version int64
+	err := s.store.DB(ctx, where.F("postID", postID)).Model(new(model.PostRevision)).Select("COALESCE(MAX(version), 0)").Scan(&version).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to get latest post revision version", "err", err, "postID", postID)
+		return 0, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return version, nil
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// PostTagStore 定义了 post tag 模块在 store 层所实现的方法.
+type PostTagStore interface {
+	Create(ctx context.Context, obj *model.PostTag) error
+	Delete(ctx context.Context, opts *where.Options) error
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostTag, error)
+
+	PostTagExpansion
+}
+
+// PostTagExpansion 定义了博文标签关联操作的附加方法.
+type PostTagExpansion interface {
+	CountPosts(ctx context.Context, tagIDs []string) (map[string]int64, error)
+}
+
+// postTagStore 是 PostTagStore 接口的实现.
+type postTagStore struct {
+	store *dataStore
+}
+
+// 确保 postTagStore 实现了 PostTagStore 接口.
+var _ PostTagStore = (*postTagStore)(nil)
+
+// newPostTagStore 创建 postTagStore 的实例.
+func newPostTagStore(store *dataStore) *postTagStore {
+	return &postTagStore{store: store}
+}
+
+// Create 插入一条博文标签关联记录.
+func (s *postTagStore) Create(ctx context.Context, obj *model.PostTag) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert post tag into database", "err", err, "post tag", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除博文标签关联记录.
+func (s *postTagStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.PostTag)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete post tag from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// List 返回博文标签关联列表和总数.
+func (s *postTagStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostTag, err error) {
+	err = s.store.DB(ctx, opts).Order("id").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list post tags from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// CountPosts 统计每个标签关联的博文数量，不包括回收站中的博文，没有关联博文的标签不会出现在结果中.
+func (s *postTagStore) CountPosts(ctx context.Context, tagIDs []string) (map[string]int64, error) {
+	var rows []struct {
+		TagID string `gorm:"column:tagID"`
+		Count int64  `gorm:"column:count"`
+	}
+	// 使用 clause.Column 引用列名，使 PostgreSQL 等区分大小写的数据库也能正确识别驼峰命名的列
+	tagIDColumn, postIDColumn := clause.Column{Name: "tagID"}, clause.Column{Name: "postID"}
+	// 子查询会自动排除已软删除的博文
+	posts := s.store.DB(ctx).Model(new(model.Post)).Select("?", postIDColumn)
+	err := s.store.DB(ctx, where.F("tagID", tagIDs)).Model(new(model.PostTag)).
+		Where("? IN (?)", postIDColumn, posts).
+		Select("?, COUNT(*) AS count", tagIDColumn).
+		Clauses(clause.GroupBy{Columns: []clause.Column{tagIDColumn}}).
+		Scan(&rows).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to count posts by tag", "err", err, "tagIDs", tagIDs)
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.TagID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,91 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// RecoveryCodeStore 定义了两步验证恢复码模块在 store 层所实现的方法.
+type RecoveryCodeStore interface {
+	Create(ctx context.Context, obj *model.RecoveryCode) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.RecoveryCode, error)
+
+	RecoveryCodeExpansion
+}
+
+// RecoveryCodeExpansion 定义了恢复码操作的附加方法.
+type RecoveryCodeExpansion interface {
+	// MarkUsed 将未使用的恢复码标记为已使用，恢复码已经被使用过时返回 false.
+	MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error)
+}
+
+// recoveryCodeStore 是 RecoveryCodeStore 接口的实现.
+type recoveryCodeStore struct {
+	store *dataStore
+}
+
+// 确保 recoveryCodeStore 实现了 RecoveryCodeStore 接口.
+var _ RecoveryCodeStore = (*recoveryCodeStore)(nil)
+
+// newRecoveryCodeStore 创建 recoveryCodeStore 的实例.
+func newRecoveryCodeStore(store *dataStore) *recoveryCodeStore {
+	return &recoveryCodeStore{store: store}
+}
+
+// Create 插入一条恢复码记录.
+func (s *recoveryCodeStore) Create(ctx context.Context, obj *model.RecoveryCode) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert recovery code into database", "err", err, "userID", obj.UserID)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除恢复码记录.
+func (s *recoveryCodeStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.RecoveryCode)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete recovery code from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询恢复码记录.
+func (s *recoveryCodeStore) Get(ctx context.Context, opts *where.Options) (*model.RecoveryCode, error) {
+	var obj model.RecoveryCode
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrTOTPCodeInvalid
+		}
+		log.With(ctx).Errorw("Failed to retrieve recovery code from database", "err", err, "conditions", opts)
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return &obj, nil
+}
+
+// MarkUsed 使用带 usedAt IS NULL 条件的更新标记恢复码，并发请求使用同一个恢复码时只有一个请求能够成功.
+func (s *recoveryCodeStore) MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error) {
+	result := s.store.DB(ctx).Model(new(model.RecoveryCode)).
+		Where(clause.Eq{Column: clause.PrimaryColumn, Value: id}, clause.Eq{Column: clause.Column{Name: "usedAt"}, Value: nil}).
+		Update("usedAt", usedAt)
+	if result.Error != nil {
+		log.With(ctx).Errorw("Failed to mark recovery code as used", "err", result.Error, "id", id)
+		return false, errorx.ErrDBWrite.WithMessage(result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, nil
+}
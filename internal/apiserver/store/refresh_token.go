@@ -0,0 +1,126 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// RefreshTokenStore 定义了 refresh token 模块在 store 层所实现的方法.
+type RefreshTokenStore interface {
+	Create(ctx context.Context, obj *model.RefreshToken) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.RefreshToken, error)
+
+	RefreshTokenExpansion
+}
+
+// RefreshTokenExpansion 定义了刷新令牌操作的附加方法.
+type RefreshTokenExpansion interface {
+	// MarkUsed 将未使用的刷新令牌标记为已使用，令牌已经被使用过时返回 false，用于检测令牌被重复使用.
+	MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error)
+	// Revoke 撤销满足条件的所有未撤销的令牌.
+	Revoke(ctx context.Context, opts *where.Options, revokedAt time.Time) error
+	// Revoked 判断令牌家族是否已被撤销，家族中没有任何令牌时视为已撤销，满足 Authn 中间件的撤销检查接口.
+	Revoked(ctx context.Context, familyID string) (bool, error)
+}
+
+// refreshTokenStore 是 RefreshTokenStore 接口的实现.
+type refreshTokenStore struct {
+	store *dataStore
+}
+
+// 确保 refreshTokenStore 实现了 RefreshTokenStore 接口.
+var _ RefreshTokenStore = (*refreshTokenStore)(nil)
+
+// newRefreshTokenStore 创建 refreshTokenStore 的实例.
+func newRefreshTokenStore(store *dataStore) *refreshTokenStore {
+	return &refreshTokenStore{store: store}
+}
+
+// Create 插入一条刷新令牌记录.
+func (s *refreshTokenStore) Create(ctx context.Context, obj *model.RefreshToken) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert refresh token into database", "err", err, "familyID", obj.FamilyID)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除刷新令牌记录.
+func (s *refreshTokenStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.RefreshToken)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete refresh token from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询刷新令牌记录.
+func (s *refreshTokenStore) Get(ctx context.Context, opts *where.Options) (*model.RefreshToken, error) {
+	var obj model.RefreshToken
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrRefreshTokenInvalid
+		}
+		log.With(ctx).Errorw("Failed to retrieve refresh token from database", "err", err, "conditions", opts)
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return &obj, nil
+}
+
+// MarkUsed 使用带 usedAt IS NULL 条件的更新标记令牌，并发请求使用同一个令牌时只有一个请求能够成功.
+func (s *refreshTokenStore) MarkUsed(ctx context.Context, id int64, usedAt time.Time) (bool, error) {
+	result := s.store.DB(ctx).Model(new(model.RefreshToken)).
+		Where(clause.Eq{Column: clause.PrimaryColumn, Value: id}, clause.Eq{Column: clause.Column{Name: "usedAt"}, Value: nil}).
+		Update("usedAt", usedAt)
+	if result.Error != nil {
+		log.With(ctx).Errorw("Failed to mark refresh token as used", "err", result.Error, "id", id)
+		return false, errorx.ErrDBWrite.WithMessage(result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Revoke 撤销满足条件的所有未撤销的令牌.
+func (s *refreshTokenStore) Revoke(ctx context.Context, opts *where.Options, revokedAt time.Time) error {
+	err := s.store.DB(ctx, opts).Model(new(model.RefreshToken)).
+		Where(clause.Eq{Column: clause.Column{Name: "revokedAt"}, Value: nil}).
+		Update("revokedAt", revokedAt).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to revoke refresh tokens", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Revoked 判断令牌家族是否已被撤销，撤销总是作用于整个家族.
+// 家族中没有任何令牌时同样视为已撤销，例如用户被删除后其令牌已被清理，或者会话 ID 是伪造的.
+func (s *refreshTokenStore) Revoked(ctx context.Context, familyID string) (bool, error) {
+	var total, revoked int64
+	err := s.store.DB(ctx, where.F("familyID", familyID)).Model(new(model.RefreshToken)).Count(&total).Error
+	if err == nil && total > 0 {
+		err = s.store.DB(ctx, where.F("familyID", familyID)).Model(new(model.RefreshToken)).
+			Where(clause.Neq{Column: clause.Column{Name: "revokedAt"}, Value: nil}).
+			Count(&revoked).Error
+	}
+	if err != nil {
+		log.With(ctx).Errorw("Failed to check refresh token family", "err", err, "familyID", familyID)
+		return false, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return total == 0 || revoked > 0, nil
+}
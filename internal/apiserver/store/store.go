@@ -16,10 +16,20 @@ var (
 
 type IStore interface {
 	DB(ctx context.Context, wheres ...where.Where) *gorm.DB
-	TX(ctx context.Context, fn func(tx *gorm.DB) error) error
+	TX(ctx context.Context, fn func(ctx context.Context) error) error
 
 	User() UserStore
 	Post() PostStore
+	PostRevision() PostRevisionStore
+	Tag() TagStore
+	Category() CategoryStore
+	PostTag() PostTagStore
+	PostCategory() PostCategoryStore
+	Comment() CommentStore
+	RefreshToken() RefreshTokenStore
+	LoginAudit() LoginAuditStore
+	UserTOTP() UserTOTPStore
+	RecoveryCode() RecoveryCodeStore
 }
 
 type transactionKey struct{}
@@ -55,14 +65,23 @@ func (s *dataStore) DB(ctx context.Context, wheres ...where.Where) *gorm.DB {
 	return db
 }
 
-// TX 返回一个新的事务实例
-func (s *dataStore) TX(ctx context.Context, fn func(tx *gorm.DB) error) error {
-	return s.db.Transaction(
-		func(tx *gorm.DB) error {
-			ctx = context.WithValue(ctx, transactionKey{}, tx)
-			return fn(tx)
-		},
-	)
+// TX 在事务中执行 fn，fn 中使用传入的 ctx 调用 store 的方法时会加入该事务.
+// fn 返回错误或发生 panic 时回滚事务；在事务中再次调用 TX 时使用 savepoint 实现嵌套事务，
+// 内层事务回滚不影响外层事务.
+func (s *dataStore) TX(ctx context.Context, fn func(ctx context.Context) error) error {
+	db := s.db.WithContext(ctx)
+	if tx, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		db = tx
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		return fn(withTX(ctx, tx))
+	})
+}
+
+// withTX 返回一个携带事务实例的上下文，store 的方法使用该上下文时会在事务中执行
+func withTX(ctx context.Context, tx *gorm.DB) context.Context {
+	return context.WithValue(ctx, transactionKey{}, tx)
 }
 
 // User 返回一个实现UserStore接口的实例
@@ -74,3 +93,53 @@ func (s *dataStore) User() UserStore {
 func (s *dataStore) Post() PostStore {
 	return newPostStore(s)
 }
+
+// PostRevision 返回一个实现PostRevisionStore接口的实例
+func (s *dataStore) PostRevision() PostRevisionStore {
+	return newPostRevisionStore(s)
+}
+
+// Tag 返回一个实现TagStore接口的实例
+func (s *dataStore) Tag() TagStore {
+	return newTagStore(s)
+}
+
+// Category 返回一个实现CategoryStore接口的实例
+func (s *dataStore) Category() CategoryStore {
+	return newCategoryStore(s)
+}
+
+// PostTag 返回一个实现PostTagStore接口的实例
+func (s *dataStore) PostTag() PostTagStore {
+	return newPostTagStore(s)
+}
+
+// PostCategory 返回一个实现PostCategoryStore接口的实例
+func (s *dataStore) PostCategory() PostCategoryStore {
+	return newPostCategoryStore(s)
+}
+
+// Comment 返回一个实现CommentStore接口的实例
+func (s *dataStore) Comment() CommentStore {
+	return newCommentStore(s)
+}
+
+// RefreshToken 返回一个实现RefreshTokenStore接口的实例
+func (s *dataStore) RefreshToken() RefreshTokenStore {
+	return newRefreshTokenStore(s)
+}
+
+// LoginAudit 返回一个实现LoginAuditStore接口的实例
+func (s *dataStore) LoginAudit() LoginAuditStore {
+	return newLoginAuditStore(s)
+}
+
+// UserTOTP 返回一个实现UserTOTPStore接口的实例
+func (s *dataStore) UserTOTP() UserTOTPStore {
+	return newUserTOTPStore(s)
+}
+
+// RecoveryCode 返回一个实现RecoveryCodeStore接口的实例
+func (s *dataStore) RecoveryCode() RecoveryCodeStore {
+	return newRecoveryCodeStore(s)
+}
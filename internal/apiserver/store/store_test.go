@@ -0,0 +1,335 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/migrations"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/apiserver/store"
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/migrate"
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+)
+
+// newSQLiteStore 创建基于 SQLite 内存数据库的 store，并执行 SQLite 的数据库迁移，每个测试使用独立的数据库.
+func newSQLiteStore(t *testing.T) store.IStore {
+	t.Helper()
+
+	db, err := genericoptions.NewSQLiteOptions().NewDB()
+	require.NoError(t, err)
+	// 内存数据库使用共享缓存，关闭所有连接后才会被释放
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	fsys, err := migrations.FS(genericoptions.DBTypeSQLite)
+	require.NoError(t, err)
+	m, err := migrate.New(db, fsys)
+	require.NoError(t, err)
+	_, err = m.Up(context.Background(), 0)
+	require.NoError(t, err)
+
+	return store.NewStoreForTest(db)
+}
+
+func TestStoreOnSQLite(t *testing.T) {
+	ctx := context.Background()
+	s := newSQLiteStore(t)
+
+	// 驼峰命名的列可以正常读写
+	user, err := s.User().Get(ctx, where.F("userID", "user-000000"))
+	require.NoError(t, err)
+	assert.Equal(t, "root", user.Username)
+
+	postM := &model.Post{UserID: user.UserID, Title: "hello", Content: "world"}
+	require.NoError(t, s.Post().Create(ctx, postM))
+	assert.NotEmpty(t, postM.PostID)
+
+	publishedAt := time.Now().Add(-time.Minute)
+	postM.Status, postM.PublishedAt = 1, &publishedAt
+	require.NoError(t, s.Post().Update(ctx, postM))
+
+	count, posts, err := s.Post().List(ctx, where.F("userID", user.UserID).C(clause.Lte{Column: clause.Column{Name: "publishedAt"}, Value: time.Now()}))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	assert.Equal(t, postM.PostID, posts[0].PostID)
+
+	require.NoError(t, s.PostRevision().Create(ctx, &model.PostRevision{PostID: postM.PostID, Version: 3}))
+	version, err := s.PostRevision().LatestVersion(ctx, postM.PostID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, version)
+
+	require.NoError(t, s.PostTag().Create(ctx, &model.PostTag{PostID: postM.PostID, TagID: "tag-1"}))
+	counts, err := s.PostTag().CountPosts(ctx, []string{"tag-1", "tag-2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"tag-1": 1}, counts)
+
+	// 事务中的操作在出错或 panic 时回滚
+	err = s.TX(ctx, func(ctx context.Context) error {
+		if err := s.Post().Delete(ctx, where.F("postID", postM.PostID)); err != nil {
+			return err
+		}
+		return gorm.ErrInvalidTransaction
+	})
+	require.ErrorIs(t, err, gorm.ErrInvalidTransaction)
+	assert.Panics(t, func() {
+		_ = s.TX(ctx, func(ctx context.Context) error {
+			_ = s.Post().Delete(ctx, where.F("postID", postM.PostID))
+			panic("rollback")
+		})
+	})
+	_, err = s.Post().Get(ctx, where.F("postID", postM.PostID))
+	assert.NoError(t, err)
+
+	// 嵌套事务使用 savepoint，内层回滚不影响外层事务
+	err = s.TX(ctx, func(ctx context.Context) error {
+		if err := s.PostTag().Delete(ctx, where.F("postID", postM.PostID)); err != nil {
+			return err
+		}
+		err := s.TX(ctx, func(ctx context.Context) error {
+			if err := s.Post().Delete(ctx, where.F("postID", postM.PostID)); err != nil {
+				return err
+			}
+			return gorm.ErrInvalidTransaction
+		})
+		assert.ErrorIs(t, err, gorm.ErrInvalidTransaction)
+		return nil
+	})
+	require.NoError(t, err)
+	_, err = s.Post().Get(ctx, where.F("postID", postM.PostID))
+	assert.NoError(t, err)
+	counts, err = s.PostTag().CountPosts(ctx, []string{"tag-1"})
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+}
+
+func TestUserSoftDelete(t *testing.T) {
+	stores := map[string]store.IStore{
+		"sqlite": newSQLiteStore(t),
+		"memory": store.NewMemoryStore(),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			userM := &model.User{Username: "deleted", Phone: "18110000009"}
+			require.NoError(t, s.User().Create(ctx, userM))
+
+			// 软删除后普通查询不再返回该用户，只能通过 ListDeleted 查询
+			require.NoError(t, s.User().Delete(ctx, where.F("userID", userM.UserID)))
+			_, err := s.User().Get(ctx, where.F("userID", userM.UserID))
+			assert.ErrorIs(t, err, errorx.ErrUserNotFound)
+
+			deletedBefore := clause.Lte{Column: clause.Column{Name: "deletedAt"}, Value: time.Now().Add(time.Minute)}
+			count, users, err := s.User().ListDeleted(ctx, where.C(deletedBefore))
+			require.NoError(t, err)
+			require.EqualValues(t, 1, count)
+			assert.Equal(t, userM.UserID, users[0].UserID)
+			assert.True(t, users[0].DeletedAt.Valid)
+			count, _, err = s.User().ListDeleted(ctx, where.C(clause.Lte{Column: clause.Column{Name: "deletedAt"}, Value: time.Now().Add(-time.Hour)}))
+			require.NoError(t, err)
+			assert.Zero(t, count)
+
+			// 彻底删除后无法再查询到
+			require.NoError(t, s.User().Purge(ctx, where.F("userID", userM.UserID)))
+			count, _, err = s.User().ListDeleted(ctx, where.NewWhere())
+			require.NoError(t, err)
+			assert.Zero(t, count)
+		})
+	}
+}
+
+func TestPostSeek(t *testing.T) {
+	stores := map[string]store.IStore{
+		"sqlite": newSQLiteStore(t),
+		"memory": store.NewMemoryStore(),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			base := time.Now().UTC().Truncate(time.Second)
+			var postIDs []string
+			for _, offset := range []time.Duration{-2 * time.Second, 0, 0, -time.Second, 0} {
+				postM := &model.Post{UserID: "user-seek", Title: "seek", CreatedAt: base.Add(offset)}
+				require.NoError(t, s.Post().Create(ctx, postM))
+				postIDs = append(postIDs, postM.PostID)
+			}
+
+			// 创建时间相同的文章按 ID 倒序排列，游标编码后再解析不影响比较
+			var (
+				got   []string
+				after *store.Cursor
+			)
+			for {
+				posts, err := s.Post().Seek(ctx, where.F("userID", "user-seek").L(2), after)
+				require.NoError(t, err)
+				for _, post := range posts {
+					got = append(got, post.PostID)
+				}
+				if len(posts) < 2 {
+					break
+				}
+				last := posts[len(posts)-1]
+				after, err = store.ParseCursor((&store.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}).Encode())
+				require.NoError(t, err)
+			}
+			assert.Equal(t, []string{postIDs[4], postIDs[2], postIDs[1], postIDs[3], postIDs[0]}, got)
+
+			count, err := s.Post().Count(ctx, where.F("userID", "user-seek").L(1))
+			require.NoError(t, err)
+			assert.EqualValues(t, 5, count)
+
+			_, err = store.ParseCursor("not-a-token")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPostUpdates(t *testing.T) {
+	stores := map[string]store.IStore{
+		"sqlite": newSQLiteStore(t),
+		"memory": store.NewMemoryStore(),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			publishedAt := time.Now().Add(-time.Minute)
+			postM := &model.Post{UserID: "user-updates", Title: "draft", Status: 1, PublishedAt: &publishedAt}
+			require.NoError(t, s.Post().Create(ctx, postM))
+
+			// 只更新指定的列，其它列保留并发修改后的值
+			postM.Title = "edited"
+			require.NoError(t, s.Post().Update(ctx, postM))
+			due := where.F("postID", postM.PostID, "status", int32(1)).C(clause.Lte{Column: clause.Column{Name: "publishedAt"}, Value: time.Now()})
+			n, err := s.Post().Updates(ctx, due, map[string]any{"status": int32(2)})
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, n)
+			got, err := s.Post().Get(ctx, where.F("postID", postM.PostID))
+			require.NoError(t, err)
+			assert.Equal(t, "edited", got.Title)
+			assert.EqualValues(t, 2, got.Status)
+			assert.False(t, got.UpdatedAt.Before(postM.UpdatedAt))
+
+			// 条件不再满足时不更新任何记录
+			n, err = s.Post().Updates(ctx, due, map[string]any{"status": int32(2)})
+			require.NoError(t, err)
+			assert.Zero(t, n)
+
+			n, err = s.Post().Updates(ctx, where.F("postID", postM.PostID), map[string]any{"status": int32(0), "publishedAt": nil})
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, n)
+			got, err = s.Post().Get(ctx, where.F("postID", postM.PostID))
+			require.NoError(t, err)
+			assert.Nil(t, got.PublishedAt)
+
+			// 回收站中的文章不会被更新
+			require.NoError(t, s.Post().Delete(ctx, where.F("postID", postM.PostID)))
+			n, err = s.Post().Updates(ctx, where.F("postID", postM.PostID), map[string]any{"status": int32(2)})
+			require.NoError(t, err)
+			assert.Zero(t, n)
+		})
+	}
+}
+
+func TestPostGetForUpdate(t *testing.T) {
+	stores := map[string]store.IStore{
+		"sqlite": newSQLiteStore(t),
+		"memory": store.NewMemoryStore(),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			postM := &model.Post{UserID: "user-lock", Title: "locked"}
+			require.NoError(t, s.Post().Create(ctx, postM))
+
+			// 行锁子句在 SQLite 和内存存储中被忽略，查询结果与普通查询一致
+			err := s.TX(ctx, func(ctx context.Context) error {
+				got, err := s.Post().Get(ctx, where.F("postID", postM.PostID).C(clause.Locking{Strength: clause.LockingStrengthUpdate}))
+				if err != nil {
+					return err
+				}
+				assert.Equal(t, "locked", got.Title)
+				return nil
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUserConditionalUpdates(t *testing.T) {
+	stores := map[string]store.IStore{
+		"sqlite": newSQLiteStore(t),
+		"memory": store.NewMemoryStore(),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			userM := &model.User{Username: "conditional-" + name, Password: "password", Email: name + "@conditional.com", Phone: "phone-" + name}
+			require.NoError(t, s.User().Create(ctx, userM))
+			read, err := s.User().Get(ctx, where.F("userID", userM.UserID))
+			require.NoError(t, err)
+
+			// 只有最后修改时间与读取时一致才更新，更新后的修改时间精确到微秒并且一定变化
+			updatedAt := conditional.NextModified(read.UpdatedAt)
+			cond := where.F("userID", read.UserID, "updatedAt", read.UpdatedAt)
+			n, err := s.User().Updates(ctx, cond, map[string]any{"nickname": "first", "updatedAt": updatedAt})
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, n)
+			got, err := s.User().Get(ctx, where.F("userID", userM.UserID))
+			require.NoError(t, err)
+			assert.Equal(t, "first", got.Nickname)
+			assert.True(t, got.UpdatedAt.Equal(updatedAt))
+			assert.NotEqual(t, conditional.ETag(read.UpdatedAt), conditional.ETag(got.UpdatedAt))
+
+			n, err = s.User().Updates(ctx, cond, map[string]any{"nickname": "second", "updatedAt": conditional.NextModified(read.UpdatedAt)})
+			require.NoError(t, err)
+			assert.Zero(t, n)
+			got, err = s.User().Get(ctx, where.F("userID", userM.UserID))
+			require.NoError(t, err)
+			assert.Equal(t, "first", got.Nickname)
+		})
+	}
+}
+
+func TestRefreshTokenRevoked(t *testing.T) {
+	stores := map[string]store.IStore{
+		"sqlite": newSQLiteStore(t),
+		"memory": store.NewMemoryStore(),
+	}
+	for name, s := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			familyID := "family-" + name
+			tokenM := &model.RefreshToken{UserID: "user-revoked", FamilyID: familyID, TokenHash: "hash-" + name, ExpiresAt: time.Now().Add(time.Hour)}
+			require.NoError(t, s.RefreshToken().Create(ctx, tokenM))
+
+			revoked, err := s.RefreshToken().Revoked(ctx, familyID)
+			require.NoError(t, err)
+			assert.False(t, revoked)
+
+			// 没有任何令牌的家族视为已撤销，例如伪造的会话 ID 或令牌已被清理
+			revoked, err = s.RefreshToken().Revoked(ctx, "family-unknown")
+			require.NoError(t, err)
+			assert.True(t, revoked)
+			require.NoError(t, s.RefreshToken().Delete(ctx, where.F("familyID", familyID)))
+			revoked, err = s.RefreshToken().Revoked(ctx, familyID)
+			require.NoError(t, err)
+			assert.True(t, revoked)
+
+			tokenM = &model.RefreshToken{UserID: "user-revoked", FamilyID: familyID, TokenHash: "hash2-" + name, ExpiresAt: time.Now().Add(time.Hour)}
+			require.NoError(t, s.RefreshToken().Create(ctx, tokenM))
+			require.NoError(t, s.RefreshToken().Revoke(ctx, where.F("familyID", familyID), time.Now()))
+			revoked, err = s.RefreshToken().Revoked(ctx, familyID)
+			require.NoError(t, err)
+			assert.True(t, revoked)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// TagStore 定义了 tag 模块在 store 层所实现的方法.
+type TagStore interface {
+	Create(ctx context.Context, obj *model.Tag) error
+	Update(ctx context.Context, obj *model.Tag) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.Tag, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.Tag, error)
+
+	TagExpansion
+}
+
+// TagExpansion 定义了标签操作的附加方法.
+type TagExpansion interface{}
+
+// tagStore 是 TagStore 接口的实现.
+type tagStore struct {
+	store *dataStore
+}
+
+// 确保 tagStore 实现了 TagStore 接口.
+var _ TagStore = (*tagStore)(nil)
+
+// newTagStore 创建 tagStore 的实例.
+func newTagStore(store *dataStore) *tagStore {
+	return &tagStore{store: store}
+}
+
+// Create 插入一条标签记录.
+func (s *tagStore) Create(ctx context.Context, obj *model.Tag) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert tag into database", "err", err, "tag", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新标签数据库记录.
+func (s *tagStore) Update(ctx context.Context, obj *model.Tag) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to update tag in database", "err", err, "tag", obj)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除标签记录.
+func (s *tagStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.Tag)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete tag from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询标签记录.
+func (s *tagStore) Get(ctx context.Context, opts *where.Options) (*model.Tag, error) {
+	var obj model.Tag
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to retrieve tag from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrTagNotFound
+		}
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回标签列表和总数.
+func (s *tagStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Tag, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list tags from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/onexstack/onexstack/pkg/store/where"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
 	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
@@ -24,7 +25,15 @@ type UserStore interface {
 }
 
 // UserExpansion 定义了用户操作的附加方法.
-type UserExpansion interface{}
+type UserExpansion interface {
+	Seek(ctx context.Context, opts *where.Options, after *Cursor) ([]*model.User, error)
+	Count(ctx context.Context, opts *where.Options) (int64, error)
+	ListDeleted(ctx context.Context, opts *where.Options) (int64, []*model.User, error)
+	Restore(ctx context.Context, opts *where.Options) error
+	Purge(ctx context.Context, opts *where.Options) error
+	// Updates 只更新满足条件的用户的指定列，返回更新的记录数，条件中可以包含用户的最后修改时间以实现条件更新.
+	Updates(ctx context.Context, opts *where.Options, values map[string]any) (int64, error)
+}
 
 // userStore 是 UserStore 接口的实现.
 type userStore struct {
@@ -59,7 +68,18 @@ func (s *userStore) Update(ctx context.Context, obj *model.User) error {
 	return nil
 }
 
-// Delete 根据条件删除用户记录.
+// Updates 只更新满足条件的用户的 values 中的列，同时更新 updatedAt，不会覆盖其它请求并发修改的列.
+func (s *userStore) Updates(ctx context.Context, opts *where.Options, values map[string]any) (int64, error) {
+	result := s.store.DB(ctx, opts).Model(new(model.User)).Updates(values)
+	if result.Error != nil {
+		log.With(ctx).Errorw("Failed to update user columns in database", "err", result.Error, "conditions", opts)
+		return 0, errorx.ErrDBWrite.WithMessage(result.Error.Error())
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Delete 根据条件软删除用户记录，只设置 deletedAt，后续查询不再返回该用户.
 func (s *userStore) Delete(ctx context.Context, opts *where.Options) error {
 	err := s.store.DB(ctx, opts).Delete(new(model.User)).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -93,3 +113,63 @@ func (s *userStore) List(ctx context.Context, opts *where.Options) (count int64,
 	}
 	return
 }
+
+// Seek 按 createdAt、id 倒序返回排在游标 after 之后的用户列表，after 为空时从第一条记录开始.
+// 只使用 opts 中的 Limit 限制数量，忽略 Offset，也不统计总数.
+func (s *userStore) Seek(ctx context.Context, opts *where.Options, after *Cursor) (ret []*model.User, err error) {
+	db := s.store.DB(ctx, opts).Offset(-1)
+	if after != nil {
+		db = db.Where(after.after())
+	}
+	if err = db.Order(seekOrder).Find(&ret).Error; err != nil {
+		log.With(ctx).Errorw("Failed to seek users from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// Count 返回满足条件的用户总数，忽略分页参数.
+func (s *userStore) Count(ctx context.Context, opts *where.Options) (count int64, err error) {
+	err = s.store.DB(ctx, opts).Model(new(model.User)).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to count users in database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// ListDeleted 返回已软删除的用户列表和总数.
+func (s *userStore) ListDeleted(ctx context.Context, opts *where.Options) (count int64, ret []*model.User, err error) {
+	err = s.store.DB(ctx, opts).Unscoped().
+		Where(clause.Neq{Column: clause.Column{Name: "deletedAt"}, Value: nil}).
+		Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to list deleted users from database", "err", err, "conditions", opts)
+		err = errorx.ErrDBRead.WithMessage(err.Error())
+	}
+	return
+}
+
+// Restore 根据条件恢复已软删除的用户记录.
+func (s *userStore) Restore(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Unscoped().Model(new(model.User)).
+		Where(clause.Neq{Column: clause.Column{Name: "deletedAt"}, Value: nil}).
+		Update("deletedAt", nil).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to restore user in database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Purge 根据条件彻底删除用户记录，包括已软删除的用户.
+func (s *userStore) Purge(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Unscoped().Delete(new(model.User)).Error
+	if err != nil {
+		log.With(ctx).Errorw("Failed to purge user from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/loveRyujin/fast_blog/internal/apiserver/model"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// UserTOTPStore 定义了两步验证模块在 store 层所实现的方法.
+type UserTOTPStore interface {
+	Create(ctx context.Context, obj *model.UserTOTP) error
+	Update(ctx context.Context, obj *model.UserTOTP) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserTOTP, error)
+
+	UserTOTPExpansion
+}
+
+// UserTOTPExpansion 定义了两步验证操作的附加方法.
+type UserTOTPExpansion interface {
+	// UseStep 记录验证码的时间步，时间步不大于已使用的时间步时返回 false，用于拒绝重复使用的验证码.
+	UseStep(ctx context.Context, id int64, step int64) (bool, error)
+}
+
+// userTOTPStore 是 UserTOTPStore 接口的实现.
+type userTOTPStore struct {
+	store *dataStore
+}
+
+// 确保 userTOTPStore 实现了 UserTOTPStore 接口.
+var _ UserTOTPStore = (*userTOTPStore)(nil)
+
+// newUserTOTPStore 创建 userTOTPStore 的实例.
+func newUserTOTPStore(store *dataStore) *userTOTPStore {
+	return &userTOTPStore{store: store}
+}
+
+// Create 插入一条两步验证记录.
+func (s *userTOTPStore) Create(ctx context.Context, obj *model.UserTOTP) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to insert user totp into database", "err", err, "userID", obj.UserID)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新两步验证记录，日志中不记录密钥.
+func (s *userTOTPStore) Update(ctx context.Context, obj *model.UserTOTP) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		log.With(ctx).Errorw("Failed to update user totp in database", "err", err, "userID", obj.UserID)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除两步验证记录.
+func (s *userTOTPStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.UserTOTP)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.With(ctx).Errorw("Failed to delete user totp from database", "err", err, "conditions", opts)
+		return errorx.ErrDBWrite.WithMessage(err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询两步验证记录.
+func (s *userTOTPStore) Get(ctx context.Context, opts *where.Options) (*model.UserTOTP, error) {
+	var obj model.UserTOTP
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.ErrTOTPNotEnrolled
+		}
+		log.With(ctx).Errorw("Failed to retrieve user totp from database", "err", err, "conditions", opts)
+		return nil, errorx.ErrDBRead.WithMessage(err.Error())
+	}
+
+	return &obj, nil
+}
+
+// UseStep 使用带 lastUsedStep < step 条件的更新记录时间步，并发请求使用同一个验证码时只有一个请求能够成功.
+func (s *userTOTPStore) UseStep(ctx context.Context, id int64, step int64) (bool, error) {
+	result := s.store.DB(ctx).Model(new(model.UserTOTP)).
+		Where(clause.Eq{Column: clause.PrimaryColumn, Value: id}, clause.Lt{Column: clause.Column{Name: "lastUsedStep"}, Value: step}).
+		Update("lastUsedStep", step)
+	if result.Error != nil {
+		log.With(ctx).Errorw("Failed to update totp step", "err", result.Error, "id", id)
+		return false, errorx.ErrDBWrite.WithMessage(result.Error.Error())
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,44 @@
+// Package cache 提供键值缓存，支持进程内的 LRU 缓存和 redis 缓存两种实现.
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+)
+
+// ErrMiss 表示缓存中不存在该键或者已经过期.
+var ErrMiss = errors.New("cache miss")
+
+// Cache 定义了缓存需要实现的方法.
+type Cache interface {
+	// Get 返回键对应的值，键不存在或已过期时返回 ErrMiss
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Set 保存键值，ttl 小于等于 0 时不过期
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete 删除键，键不存在时不返回错误
+	Delete(ctx context.Context, keys ...string) error
+	// Close 释放缓存占用的资源
+	Close() error
+}
+
+// New 根据配置创建 Cache 实例，缓存类型为 none 时返回 nil.
+func New(opts *genericoptions.CacheOptions, redisOpts *genericoptions.RedisOptions) (Cache, error) {
+	switch opts.Type {
+	case genericoptions.CacheTypeNone:
+		return nil, nil
+	case genericoptions.CacheTypeMemory:
+		return NewMemory(opts.Size), nil
+	case genericoptions.CacheTypeRedis:
+		client, err := redisOpts.NewClient()
+		if err != nil {
+			return nil, err
+		}
+		return NewRedis(client, "fast_blog:"), nil
+	default:
+		return nil, fmt.Errorf("unsupported cache type: %s", opts.Type)
+	}
+}
@@ -0,0 +1,78 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/cache"
+)
+
+// testCache 校验各个缓存实现共同的读写和删除行为.
+func testCache(t *testing.T, c cache.Cache) {
+	ctx := context.Background()
+
+	_, err := c.Get(ctx, "post:1")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+
+	require.NoError(t, c.Set(ctx, "post:1", []byte("hello"), time.Minute))
+	require.NoError(t, c.Set(ctx, "post:2", []byte("world"), 0))
+	value, err := c.Get(ctx, "post:1")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(value))
+
+	require.NoError(t, c.Set(ctx, "post:1", []byte("updated"), time.Minute))
+	value, err = c.Get(ctx, "post:1")
+	require.NoError(t, err)
+	assert.Equal(t, "updated", string(value))
+
+	require.NoError(t, c.Delete(ctx, "post:1", "post:2", "post:3"))
+	require.NoError(t, c.Delete(ctx))
+	_, err = c.Get(ctx, "post:1")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+	_, err = c.Get(ctx, "post:2")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+}
+
+func TestMemoryCache(t *testing.T) {
+	testCache(t, cache.NewMemory(10))
+
+	ctx := context.Background()
+	c := cache.NewMemory(2)
+	require.NoError(t, c.Set(ctx, "a", []byte("a"), 0))
+	require.NoError(t, c.Set(ctx, "b", []byte("b"), 0))
+	// 读取 a 后 b 成为最久未使用的条目，写入 c 时被淘汰
+	_, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.NoError(t, c.Set(ctx, "c", []byte("c"), 0))
+	_, err = c.Get(ctx, "b")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+	_, err = c.Get(ctx, "a")
+	assert.NoError(t, err)
+
+	require.NoError(t, c.Set(ctx, "ttl", []byte("ttl"), 20*time.Millisecond))
+	time.Sleep(50 * time.Millisecond)
+	_, err = c.Get(ctx, "ttl")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+}
+
+func TestRedisCache(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	c := cache.NewRedis(client, "test:")
+	t.Cleanup(func() { _ = c.Close() })
+
+	testCache(t, c)
+
+	ctx := context.Background()
+	require.NoError(t, c.Set(ctx, "ttl", []byte("ttl"), time.Minute))
+	assert.True(t, mr.Exists("test:ttl"))
+	mr.FastForward(time.Minute)
+	_, err := c.Get(ctx, "ttl")
+	assert.ErrorIs(t, err, cache.ErrMiss)
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryCache 是进程内的 LRU 缓存，条目数量超过上限时淘汰最久未使用的条目，过期的条目在读取时删除.
+type memoryCache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+// memoryEntry 是 LRU 链表中保存的条目.
+type memoryEntry struct {
+	key      string
+	value    []byte
+	expireAt time.Time
+}
+
+var _ Cache = (*memoryCache)(nil)
+
+// NewMemory 创建最多保存 size 个条目的进程内 LRU 缓存.
+func NewMemory(size int) Cache {
+	return &memoryCache{size: max(size, 1), ll: list.New(), items: make(map[string]*list.Element)}
+}
+
+// Get 实现 Cache 接口中的 Get 方法.
+func (c *memoryCache) Get(ctx context.Context, key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, ErrMiss
+	}
+	entry := elem.Value.(*memoryEntry)
+	if !entry.expireAt.IsZero() && !time.Now().Before(entry.expireAt) {
+		c.remove(elem)
+		return nil, ErrMiss
+	}
+
+	c.ll.MoveToFront(elem)
+	return entry.value, nil
+}
+
+// Set 实现 Cache 接口中的 Set 方法.
+func (c *memoryCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	var expireAt time.Time
+	if ttl > 0 {
+		expireAt = time.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value = &memoryEntry{key: key, value: value, expireAt: expireAt}
+		c.ll.MoveToFront(elem)
+		return nil
+	}
+
+	c.items[key] = c.ll.PushFront(&memoryEntry{key: key, value: value, expireAt: expireAt})
+	for c.ll.Len() > c.size {
+		c.remove(c.ll.Back())
+	}
+	return nil
+}
+
+// Delete 实现 Cache 接口中的 Delete 方法.
+func (c *memoryCache) Delete(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		if elem, ok := c.items[key]; ok {
+			c.remove(elem)
+		}
+	}
+	return nil
+}
+
+// Close 实现 Cache 接口中的 Close 方法.
+func (c *memoryCache) Close() error {
+	return nil
+}
+
+// remove 从链表和索引中删除条目，调用方需要持有锁.
+func (c *memoryCache) remove(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisCache 是基于 redis 的缓存，多个服务实例可以共享同一份缓存.
+type redisCache struct {
+	client redis.UniversalClient
+	// prefix 是所有键的前缀，避免与同一 redis 中的其它数据冲突
+	prefix string
+}
+
+var _ Cache = (*redisCache)(nil)
+
+// NewRedis 创建基于 redis 的缓存，所有键都会加上 prefix 前缀.
+func NewRedis(client redis.UniversalClient, prefix string) Cache {
+	return &redisCache{client: client, prefix: prefix}
+}
+
+// Get 实现 Cache 接口中的 Get 方法.
+func (c *redisCache) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := c.client.Get(ctx, c.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrMiss
+	}
+	return value, err
+}
+
+// Set 实现 Cache 接口中的 Set 方法.
+func (c *redisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.client.Set(ctx, c.prefix+key, value, max(ttl, 0)).Err()
+}
+
+// Delete 实现 Cache 接口中的 Delete 方法.
+func (c *redisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	prefixed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		prefixed = append(prefixed, c.prefix+key)
+	}
+	return c.client.Del(ctx, prefixed...).Err()
+}
+
+// Close 实现 Cache 接口中的 Close 方法.
+func (c *redisCache) Close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,139 @@
+// Package conditional 实现 HTTP 条件请求，根据资源的最后修改时间生成 ETag 和 Last-Modified 响应头，
+// 并处理 If-None-Match、If-Modified-Since 和 If-Match 请求头.
+package conditional
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ETag 根据资源的最后修改时间生成强 ETag.
+// 所有存储后端都将最后修改时间保存到微秒，修改资源时使用 NextModified 生成新的修改时间，每次修改后 ETag 都会变化.
+func ETag(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixNano(), 36) + `"`
+}
+
+// NextModified 返回修改资源时使用的最后修改时间，精确到微秒并且一定晚于资源当前的最后修改时间 updatedAt.
+func NextModified(updatedAt time.Time) time.Time {
+	next := time.Now().Truncate(time.Microsecond)
+	if !next.After(updatedAt) {
+		next = updatedAt.Truncate(time.Microsecond).Add(time.Microsecond)
+	}
+	return next
+}
+
+// SetHeaders 设置资源的 ETag 和 Last-Modified 响应头.
+// 响应允许客户端缓存，但每次使用前都需要重新验证.
+func SetHeaders(h http.Header, updatedAt time.Time) {
+	h.Set("ETag", ETag(updatedAt))
+	h.Set("Last-Modified", updatedAt.UTC().Format(http.TimeFormat))
+	h.Set("Cache-Control", "private, no-cache")
+	h.Del("Expires")
+}
+
+// NotModified 根据请求头 req 和已经设置了 ETag、Last-Modified 的响应头 resp 判断是否应该返回 304.
+// 请求中包含 If-None-Match 时忽略 If-Modified-Since.
+func NotModified(req, resp http.Header) bool {
+	if inm := req.Get("If-None-Match"); inm != "" {
+		etag := resp.Get("ETag")
+		return etag != "" && matchAny(inm, etag, weakEqual)
+	}
+
+	ims, err := http.ParseTime(req.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	modified, err := http.ParseTime(resp.Get("Last-Modified"))
+	if err != nil {
+		return false
+	}
+	return !modified.After(ims)
+}
+
+// Match 判断 If-Match 请求头是否与资源当前的 ETag 匹配，If-Match 中的弱 ETag 不会匹配.
+func Match(ifMatch, etag string) bool {
+	return matchAny(ifMatch, etag, strongEqual)
+}
+
+// Handler 包装 handler，对已经设置了 ETag 或 Last-Modified 响应头的 GET 和 HEAD 成功响应处理条件请求，
+// 条件满足时返回 304 并丢弃响应体.
+func Handler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		handler.ServeHTTP(&responseWriter{ResponseWriter: w, req: r.Header}, r)
+	})
+}
+
+// responseWriter 在写入状态码前检查条件请求.
+type responseWriter struct {
+	http.ResponseWriter
+	req         http.Header
+	wroteHeader bool
+	notModified bool
+}
+
+func (w *responseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	if code == http.StatusOK && NotModified(w.req, w.Header()) {
+		w.notModified = true
+		code = http.StatusNotModified
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Length")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.notModified {
+		return len(b), nil
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush 实现 http.Flusher，流式响应需要及时发送数据.
+func (w *responseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok && !w.notModified {
+		f.Flush()
+	}
+}
+
+// Unwrap 返回被包装的 http.ResponseWriter，供 http.ResponseController 使用.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// matchAny 判断以逗号分隔的 ETag 列表中是否有与 etag 相等的值，* 与任意 ETag 匹配.
+func matchAny(list, etag string, equal func(a, b string) bool) bool {
+	for item := range strings.SplitSeq(list, ",") {
+		item = strings.TrimSpace(item)
+		if item == "*" || item != "" && equal(item, etag) {
+			return true
+		}
+	}
+	return false
+}
+
+// weakEqual 使用弱比较，忽略 W/ 前缀.
+func weakEqual(a, b string) bool {
+	return strings.TrimPrefix(a, "W/") == strings.TrimPrefix(b, "W/")
+}
+
+// strongEqual 使用强比较，弱 ETag 与任何 ETag 都不相等.
+func strongEqual(a, b string) bool {
+	return a == b && !strings.HasPrefix(a, "W/")
+}
@@ -0,0 +1,78 @@
+package conditional_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
+)
+
+func TestNotModified(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 8, 0, 0, 500, time.UTC)
+	etag := conditional.ETag(updatedAt)
+	resp := http.Header{}
+	conditional.SetHeaders(resp, updatedAt)
+
+	tests := []struct {
+		header http.Header
+		want   bool
+	}{
+		{http.Header{}, false},
+		{http.Header{"If-None-Match": {etag}}, true},
+		{http.Header{"If-None-Match": {`"other", W/` + etag}}, true},
+		{http.Header{"If-None-Match": {"*"}}, true},
+		{http.Header{"If-None-Match": {`"other"`}}, false},
+		{http.Header{"If-Modified-Since": {updatedAt.Format(http.TimeFormat)}}, true},
+		{http.Header{"If-Modified-Since": {updatedAt.Add(-time.Second).Format(http.TimeFormat)}}, false},
+		{http.Header{"If-Modified-Since": {"yesterday"}}, false},
+		// If-None-Match 优先于 If-Modified-Since
+		{http.Header{"If-None-Match": {`"other"`}, "If-Modified-Since": {updatedAt.Format(http.TimeFormat)}}, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, conditional.NotModified(tt.header, resp), tt.header)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	etag := conditional.ETag(time.Unix(1735689600, 0))
+
+	assert.True(t, conditional.Match(etag, etag))
+	assert.True(t, conditional.Match(`"other", `+etag, etag))
+	assert.True(t, conditional.Match("*", etag))
+	assert.False(t, conditional.Match("W/"+etag, etag))
+	assert.False(t, conditional.Match(conditional.ETag(time.Unix(1735689601, 0)), etag))
+}
+
+func TestHandler(t *testing.T) {
+	updatedAt := time.Unix(1735689600, 0)
+	h := conditional.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conditional.SetHeaders(w.Header(), updatedAt)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"title":"go"}`))
+	}))
+
+	serve := func(method, ifNoneMatch string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/v1/posts/post-1", nil)
+		r.Header.Set("If-None-Match", ifNoneMatch)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve(http.MethodGet, conditional.ETag(updatedAt))
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Empty(t, w.Header().Get("Content-Type"))
+	assert.Equal(t, conditional.ETag(updatedAt), w.Header().Get("ETag"))
+
+	w = serve(http.MethodGet, `"other"`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"title":"go"}`, w.Body.String())
+
+	w = serve(http.MethodPut, conditional.ETag(updatedAt))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
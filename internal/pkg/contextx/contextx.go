@@ -10,6 +10,14 @@ type (
 	requestIDKey struct{}
 	// userIDKey 定义用户 ID 的上下文键.
 	userIDKey struct{}
+	// sessionIDKey 定义登录会话 ID 的上下文键.
+	sessionIDKey struct{}
+	// ifMatchKey 定义 If-Match 请求头的上下文键.
+	ifMatchKey struct{}
+	// clientIPKey 定义客户端 IP 的上下文键.
+	clientIPKey struct{}
+	// userAgentKey 定义 User-Agent 请求头的上下文键.
+	userAgentKey struct{}
 )
 
 // WithRequestID 将请求 ID 存放到上下文中.
@@ -33,3 +41,47 @@ func UserID(ctx context.Context) string {
 	userID, _ := ctx.Value(userIDKey{}).(string)
 	return userID
 }
+
+// WithSessionID 将签发访问令牌的登录会话 ID 存放到上下文中.
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sessionID)
+}
+
+// SessionID 从上下文中提取登录会话 ID.
+func SessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey{}).(string)
+	return sessionID
+}
+
+// WithIfMatch 将 If-Match 请求头存放到上下文中.
+func WithIfMatch(ctx context.Context, ifMatch string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, ifMatch)
+}
+
+// IfMatch 从上下文中提取 If-Match 请求头，请求中没有该请求头时返回空字符串.
+func IfMatch(ctx context.Context) string {
+	ifMatch, _ := ctx.Value(ifMatchKey{}).(string)
+	return ifMatch
+}
+
+// WithClientIP 将客户端 IP 存放到上下文中.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// ClientIP 从上下文中提取客户端 IP.
+func ClientIP(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+// WithUserAgent 将 User-Agent 请求头存放到上下文中.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey{}, userAgent)
+}
+
+// UserAgent 从上下文中提取 User-Agent 请求头.
+func UserAgent(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey{}).(string)
+	return userAgent
+}
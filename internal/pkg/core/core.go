@@ -1,12 +1,22 @@
 package core
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
 	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
 	"github.com/onexstack/onexstack/pkg/errorsx"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Validator 是验证函数的类型，用于对绑定的数据结构进行验证.
@@ -30,15 +40,151 @@ type ErrorResponse struct {
 }
 
 func HandleJSONRequest[T any, R any](c *gin.Context, handler Handler[T, R], validator ...Validator[T]) {
-	HandleRequest(c, c.ShouldBindJSON, handler, validator...)
+	HandleRequest(c, withURI(c, BindJSON(c)), handler, validator...)
 }
 
 func HandleQueryRequest[T any, R any](c *gin.Context, handler Handler[T, R], validator ...Validator[T]) {
-	HandleRequest(c, c.ShouldBindQuery, handler, validator...)
+	HandleRequest(c, withURI(c, BindQuery(c)), handler, validator...)
 }
 
 func HandleURIRequest[T any, R any](c *gin.Context, handler Handler[T, R], validator ...Validator[T]) {
-	HandleRequest(c, c.ShouldBindUri, handler, validator...)
+	HandleRequest(c, BindURI(c), handler, validator...)
+}
+
+// BindJSON 返回一个将 JSON 请求体绑定到请求结构体的 Binder.
+// Protobuf 消息使用 protojson 解析，以支持 google.protobuf.Timestamp 等类型的 JSON 表示，请求体为空时不做绑定.
+func BindJSON(c *gin.Context) Binder {
+	return func(obj any) error {
+		msg, ok := obj.(proto.Message)
+		if !ok {
+			return c.ShouldBindJSON(obj)
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(body)) == 0 {
+			return nil
+		}
+		return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(body, msg)
+	}
+}
+
+// BindURI 返回一个将路径参数绑定到请求结构体的 Binder.
+// Protobuf 生成的结构体没有 uri 标签，c.ShouldBindUri 无法绑定，因此按照字段名（如 userID）匹配路径参数.
+func BindURI(c *gin.Context) Binder {
+	return func(obj any) error {
+		msg, ok := obj.(proto.Message)
+		if !ok {
+			return c.ShouldBindUri(obj)
+		}
+
+		values := make(map[string][]string, len(c.Params))
+		for _, param := range c.Params {
+			values[param.Key] = []string{param.Value}
+		}
+		return bindValues(msg, values)
+	}
+}
+
+// BindQuery 返回一个将查询参数绑定到请求结构体的 Binder.
+// Protobuf 生成的结构体没有 form 标签，c.ShouldBindQuery 无法绑定，因此按照字段名（如 offset）匹配查询参数.
+func BindQuery(c *gin.Context) Binder {
+	return func(obj any) error {
+		msg, ok := obj.(proto.Message)
+		if !ok {
+			return c.ShouldBindQuery(obj)
+		}
+		return bindValues(msg, c.Request.URL.Query())
+	}
+}
+
+// BindQueryAndURI 返回先绑定查询参数、再绑定路径参数的 Binder，与 HandleQueryRequest 的绑定方式一致.
+func BindQueryAndURI(c *gin.Context) Binder {
+	return withURI(c, BindQuery(c))
+}
+
+// withURI 在 binder 绑定完成后再绑定路径参数，路径参数优先级更高.
+func withURI(c *gin.Context, binder Binder) Binder {
+	return func(obj any) error {
+		if err := binder(obj); err != nil {
+			return err
+		}
+		return BindURI(c)(obj)
+	}
+}
+
+// bindValues 将 values 中的值按照字段名（或 JSON 名称）绑定到 Protobuf 消息的标量字段上.
+func bindValues(msg proto.Message, values map[string][]string) error {
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		vals, ok := values[string(fd.Name())]
+		if !ok {
+			vals, ok = values[fd.JSONName()]
+		}
+		if !ok || len(vals) == 0 {
+			continue
+		}
+
+		if fd.IsList() {
+			list := m.Mutable(fd).List()
+			for _, val := range vals {
+				v, err := parseValue(fd, val)
+				if err != nil {
+					return err
+				}
+				list.Append(v)
+			}
+			continue
+		}
+
+		v, err := parseValue(fd, vals[0])
+		if err != nil {
+			return err
+		}
+		m.Set(fd, v)
+	}
+
+	return nil
+}
+
+// parseValue 将字符串解析为字段类型对应的值，消息类型只支持 RFC3339 格式的 google.protobuf.Timestamp.
+func parseValue(fd protoreflect.FieldDescriptor, val string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(val), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(val)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(val, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(val, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(val, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(val, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(val)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(val, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() != "google.protobuf.Timestamp" {
+			break
+		}
+		t, err := time.Parse(time.RFC3339Nano, val)
+		return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported field type %s for %s", fd.Kind(), fd.Name())
 }
 
 func HandleRequest[T any, R any](c *gin.Context, binder Binder, handler Handler[T, R], validator ...Validator[T]) {
@@ -63,13 +209,29 @@ func ReadRequest[T any](c *gin.Context, binder Binder, request *T, validator ...
 			continue
 		}
 		if err := validate(c.Request.Context(), request); err != nil {
-			return err
+			return errorx.ErrInvalidArugment.WithMessage(err.Error())
 		}
 	}
 
 	return nil
 }
 
+// WriteConditionalResponse 在 WriteResponse 的基础上根据资源的最后修改时间设置 ETag 和 Last-Modified 响应头，
+// 请求中的 If-None-Match 或 If-Modified-Since 条件满足时返回 304，不返回响应体.
+func WriteConditionalResponse(c *gin.Context, data any, updatedAt time.Time, err error) {
+	if err != nil {
+		WriteResponse(c, nil, err)
+		return
+	}
+
+	conditional.SetHeaders(c.Writer.Header(), updatedAt)
+	if conditional.NotModified(c.Request.Header, c.Writer.Header()) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+	WriteResponse(c, data, nil)
+}
+
 // WriteResponse 是通用的响应函数.
 // 它会根据是否发生错误，生成成功响应或标准化的错误响应.
 func WriteResponse(c *gin.Context, data any, err error) {
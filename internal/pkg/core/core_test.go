@@ -0,0 +1,43 @@
+package core_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	apiv1 "github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1"
+)
+
+func TestBindURI(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Params = gin.Params{{Key: "userID", Value: "user-000001"}}
+
+	var rq apiv1.GetUserRequest
+	assert.NoError(t, core.BindURI(c)(&rq))
+	assert.Equal(t, "user-000001", rq.UserID)
+}
+
+func TestBindQuery(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("GET", "/v1/posts?offset=10&limit=20&title=go", nil)
+
+	var rq apiv1.ListPostRequest
+	assert.NoError(t, core.BindQuery(c)(&rq))
+	assert.Equal(t, int64(10), rq.Offset)
+	assert.Equal(t, int64(20), rq.Limit)
+	assert.Equal(t, "go", rq.GetTitle())
+
+	c.Request = httptest.NewRequest("GET", "/v1/posts?created_after=2025-01-01T08:00:00%2B08:00", nil)
+	assert.NoError(t, core.BindQuery(c)(&rq))
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), rq.GetCreatedAfter().AsTime())
+
+	c.Request = httptest.NewRequest("GET", "/v1/posts?limit=abc", nil)
+	assert.Error(t, core.BindQuery(c)(&apiv1.ListPostRequest{}))
+
+	c.Request = httptest.NewRequest("GET", "/v1/posts?created_after=2025-01-01", nil)
+	assert.Error(t, core.BindQuery(c)(&apiv1.ListPostRequest{}))
+}
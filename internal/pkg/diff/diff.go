@@ -0,0 +1,102 @@
+package diff
+
+import "strings"
+
+// Operation 表示差异行的类型.
+type Operation int
+
+const (
+	// Equal 表示两段文本中相同的行.
+	Equal Operation = iota
+	// Insert 表示新文本中新增的行.
+	Insert
+	// Delete 表示旧文本中被删除的行.
+	Delete
+)
+
+// Line 表示差异结果中的一行.
+type Line struct {
+	Operation Operation
+	Text      string
+}
+
+// Lines 按行比较 a 和 b，返回将 a 变为 b 的差异结果.
+// 先去掉相同的首尾行，再对剩余部分求最长公共子序列，适用于博客内容这种规模的文本.
+func Lines(a, b string) []Line {
+	x, y := splitLines(a), splitLines(b)
+
+	// 相同的前缀和后缀不参与最长公共子序列计算
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+
+	ret := make([]Line, 0, len(x)+len(y))
+	for _, text := range x[:prefix] {
+		ret = append(ret, Line{Operation: Equal, Text: text})
+	}
+	ret = append(ret, lcs(x[prefix:len(x)-suffix], y[prefix:len(y)-suffix])...)
+	for _, text := range x[len(x)-suffix:] {
+		ret = append(ret, Line{Operation: Equal, Text: text})
+	}
+
+	return ret
+}
+
+// lcs 基于最长公共子序列计算 x 和 y 的差异，删除的行排在新增的行之前.
+func lcs(x, y []string) []Line {
+	n, m := len(x), len(y)
+	// table[i][j] 表示 x[i:] 和 y[j:] 的最长公共子序列长度
+	table := make([][]int, n+1)
+	for i := range table {
+		table[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				table[i][j] = table[i+1][j+1] + 1
+			} else {
+				table[i][j] = max(table[i+1][j], table[i][j+1])
+			}
+		}
+	}
+
+	ret := make([]Line, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case x[i] == y[j]:
+			ret = append(ret, Line{Operation: Equal, Text: x[i]})
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			ret = append(ret, Line{Operation: Delete, Text: x[i]})
+			i++
+		default:
+			ret = append(ret, Line{Operation: Insert, Text: y[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ret = append(ret, Line{Operation: Delete, Text: x[i]})
+	}
+	for ; j < m; j++ {
+		ret = append(ret, Line{Operation: Insert, Text: y[j]})
+	}
+
+	return ret
+}
+
+// splitLines 将文本按行拆分，统一换行符并忽略末尾的换行.
+func splitLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
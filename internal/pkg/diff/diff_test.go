@@ -0,0 +1,53 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/diff"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []diff.Line
+	}{
+		{
+			name: "identical",
+			a:    "a\nb\n",
+			b:    "a\nb",
+			want: []diff.Line{{diff.Equal, "a"}, {diff.Equal, "b"}},
+		},
+		{
+			name: "empty to content",
+			a:    "",
+			b:    "a\nb",
+			want: []diff.Line{{diff.Insert, "a"}, {diff.Insert, "b"}},
+		},
+		{
+			name: "modify middle line",
+			a:    "a\nb\nc",
+			b:    "a\nx\nc",
+			want: []diff.Line{{diff.Equal, "a"}, {diff.Delete, "b"}, {diff.Insert, "x"}, {diff.Equal, "c"}},
+		},
+		{
+			name: "insert and delete",
+			a:    "a\nb\nc\nd",
+			b:    "b\nc\ne\nd",
+			want: []diff.Line{{diff.Delete, "a"}, {diff.Equal, "b"}, {diff.Equal, "c"}, {diff.Insert, "e"}, {diff.Equal, "d"}},
+		},
+		{
+			name: "crlf line endings",
+			a:    "a\r\nb",
+			b:    "a\nb",
+			want: []diff.Line{{diff.Equal, "a"}, {diff.Equal, "b"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diff.Lines(tt.a, tt.b))
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package errorx
+
+import "net/http"
+
+var (
+	// ErrCategoryNotFound 表示分类未找到
+	ErrCategoryNotFound = New(http.StatusNotFound, "NotFound.CategoryNotFound", "Category not found")
+	// ErrCategoryAlreadyExists 表示分类已存在
+	ErrCategoryAlreadyExists = New(http.StatusBadRequest, "AlreadyExists.CategoryAlreadyExists", "Category already exists")
+)
@@ -21,4 +21,12 @@ var (
 	ErrSignToken = New(http.StatusUnauthorized, "Unauthenticated.SignToken", "Failed to sign token")
 	// ErrTokenInvalid 表示令牌无效
 	ErrTokenInvalid = New(http.StatusUnauthorized, "Unauthenticated.TokenInvalid", "Invalid token")
+	// ErrRefreshTokenInvalid 表示刷新令牌不存在、已过期或已被撤销
+	ErrRefreshTokenInvalid = New(http.StatusUnauthorized, "Unauthenticated.RefreshTokenInvalid", "Invalid refresh token")
+	// ErrRefreshTokenReused 表示已轮换的刷新令牌被再次使用，令牌可能已泄露，整个令牌家族已被撤销
+	ErrRefreshTokenReused = New(http.StatusUnauthorized, "Unauthenticated.RefreshTokenReused", "Refresh token has already been used, all sessions derived from it have been revoked")
+	// ErrPermissionDenied 表示请求没有权限
+	ErrPermissionDenied = New(http.StatusForbidden, "PermissionDenied", "Permission denied. Access to the requested resource is forbidden")
+	// ErrPreconditionFailed 表示 If-Match 等前置条件不满足，通常是资源已被其他请求修改
+	ErrPreconditionFailed = New(http.StatusPreconditionFailed, "PreconditionFailed", "Precondition failed. The resource has been modified")
 )
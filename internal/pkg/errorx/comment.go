@@ -0,0 +1,10 @@
+package errorx
+
+import "net/http"
+
+var (
+	// ErrCommentNotFound 表示评论未找到
+	ErrCommentNotFound = New(http.StatusNotFound, "NotFound.CommentNotFound", "Comment not found")
+	// ErrInvalidParentComment 表示回复的评论不属于同一篇文章
+	ErrInvalidParentComment = New(http.StatusBadRequest, "InvalidArgument.InvalidParentComment", "Parent comment does not belong to the post")
+)
@@ -3,8 +3,38 @@ package errorx
 import (
 	"errors"
 	"fmt"
+	"net/http"
+
+	httpstatus "github.com/go-kratos/kratos/v2/transport/http/status"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+func init() {
+	httpstatus.DefaultConverter = converter{httpstatus.DefaultConverter}
+}
+
+// converter 在 kratos 默认的 HTTP 状态码与 gRPC 状态码转换规则上增加 412 与 FailedPrecondition 的相互转换，
+// 使 412 错误经过 gRPC 拦截器和 errorsx.FromError 后不会变成 400 或 500.
+type converter struct {
+	httpstatus.Converter
+}
+
+func (c converter) ToGRPCCode(code int) codes.Code {
+	if code == http.StatusPreconditionFailed {
+		return codes.FailedPrecondition
+	}
+	return c.Converter.ToGRPCCode(code)
+}
+
+func (c converter) FromGRPCCode(code codes.Code) int {
+	if code == codes.FailedPrecondition {
+		return http.StatusPreconditionFailed
+	}
+	return c.Converter.FromGRPCCode(code)
+}
+
 type Errorx struct {
 	Code    int    `json:"code,omitempty"`
 	Reason  string `json:"reason,omitempty"`
@@ -28,6 +58,13 @@ func (e *Errorx) WithMessage(message string) *Errorx {
 	return e
 }
 
+// GRPCStatus 返回 gRPC 状态表示，使 gRPC 服务和 grpc-gateway 能够返回与 HTTP 服务一致的错误码和原因.
+func (e *Errorx) GRPCStatus() *status.Status {
+	details := errdetails.ErrorInfo{Reason: e.Reason}
+	s, _ := status.New(httpstatus.ToGRPCCode(e.Code), e.Message).WithDetails(&details)
+	return s
+}
+
 func FromError(err error) *Errorx {
 	if err == nil {
 		return nil
@@ -4,3 +4,6 @@ import "net/http"
 
 // ErrPostNotFound 表示文章未找到
 var ErrPostNotFound = New(http.StatusNotFound, "NotFound.PostNotFound", "Post not found")
+
+// ErrPostRevisionNotFound 表示文章历史版本未找到
+var ErrPostRevisionNotFound = New(http.StatusNotFound, "NotFound.PostRevisionNotFound", "Post revision not found")
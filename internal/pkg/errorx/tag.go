@@ -0,0 +1,10 @@
+package errorx
+
+import "net/http"
+
+var (
+	// ErrTagNotFound 表示标签未找到
+	ErrTagNotFound = New(http.StatusNotFound, "NotFound.TagNotFound", "Tag not found")
+	// ErrTagAlreadyExists 表示标签已存在
+	ErrTagAlreadyExists = New(http.StatusBadRequest, "AlreadyExists.TagAlreadyExists", "Tag already exists")
+)
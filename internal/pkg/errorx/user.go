@@ -11,4 +11,22 @@ var (
 	ErrUserAlreadyExists = New(http.StatusBadRequest, "AlreadyExists.UserAlreadyExists", "User already exists")
 	// ErrUserNotFound 表示用户未找到
 	ErrUserNotFound = New(http.StatusNotFound, "NotFound.UserNotFound", "User not found")
+	// ErrInvalidCredentials 表示用户名或密码错误，不区分用户不存在和密码错误，避免枚举用户名
+	ErrInvalidCredentials = New(http.StatusUnauthorized, "Unauthenticated.InvalidCredentials", "Invalid username or password")
+	// ErrTooManyLoginAttempts 表示登录失败次数过多，用户名或客户端 IP 被临时锁定
+	ErrTooManyLoginAttempts = New(http.StatusTooManyRequests, "ResourceExhausted.TooManyLoginAttempts", "Too many failed login attempts, please try again later")
+	// ErrTOTPAlreadyEnabled 表示用户已经启用了两步验证，需要先停用才能重新绑定
+	ErrTOTPAlreadyEnabled = New(http.StatusBadRequest, "AlreadyExists.TOTPAlreadyEnabled", "Two-factor authentication is already enabled")
+	// ErrTOTPNotEnrolled 表示用户没有绑定或没有启用两步验证
+	ErrTOTPNotEnrolled = New(http.StatusBadRequest, "InvalidArgument.TOTPNotEnrolled", "Two-factor authentication is not enabled")
+	// ErrTOTPCodeInvalid 表示两步验证的验证码或恢复码错误、已过期或已被使用
+	ErrTOTPCodeInvalid = New(http.StatusUnauthorized, "Unauthenticated.TOTPCodeInvalid", "Invalid verification code")
+	// ErrChallengeInvalid 表示两步验证的挑战令牌无效或已过期，需要重新登录
+	ErrChallengeInvalid = New(http.StatusUnauthorized, "Unauthenticated.ChallengeInvalid", "Invalid or expired challenge token, please log in again")
+	// ErrUserDisabled 表示用户已被禁用
+	ErrUserDisabled = New(http.StatusForbidden, "PermissionDenied.UserDisabled", "User has been disabled")
+	// ErrAddRole 表示为用户添加角色失败
+	ErrAddRole = New(http.StatusInternalServerError, "InternalError.AddRole", "Failed to add role for user")
+	// ErrRemoveRole 表示移除用户角色失败
+	ErrRemoveRole = New(http.StatusInternalServerError, "InternalError.RemoveRole", "Failed to remove role for user")
 )
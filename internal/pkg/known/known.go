@@ -8,10 +8,46 @@ const (
 	XUserID = "x-user-id"
 )
 
+// 定义授权相关的常量，与 casbin_rule 表中的角色保持一致.
+const (
+	// RoleAdmin 定义管理员角色.
+	RoleAdmin = "role::admin"
+
+	// RoleUser 定义普通用户角色.
+	RoleUser = "role::user"
+)
+
 // 定义其他常量.
 const (
 	// MaxErrGroupConcurrency 定义了 errgroup 的最大并发任务数量.
 	// 用于限制 errgroup 中同时执行的 Goroutine 数量，从而防止资源耗尽，提升程序的稳定性.
 	// 根据场景需求，可以调整该值大小.
 	MaxErrGroupConcurrency = 1000
+
+	// DefaultPageSize 定义了公开列表接口未指定每页数量时的默认值.
+	DefaultPageSize = 10
+
+	// MaxPageSize 定义了公开列表接口每页数量的上限，防止匿名请求一次拉取过多数据.
+	MaxPageSize = 100
+
+	// MaxTermNameLength 定义了标签和分类名称的最大长度，与数据库中 name 字段的长度保持一致.
+	MaxTermNameLength = 64
+
+	// MaxCommentLength 定义了评论内容的最大长度.
+	MaxCommentLength = 2000
+
+	// MaxSearchKeywordLength 定义了全文检索关键词的最大长度.
+	MaxSearchKeywordLength = 100
+
+	// MaxFilterLength 定义了列表接口过滤表达式的最大长度.
+	MaxFilterLength = 1024
+)
+
+// 定义用户数据导出的文件格式.
+const (
+	// ExportFormatZIP 将个人资料和每篇博文分别保存为 ZIP 文件中的 JSON 文件.
+	ExportFormatZIP = "zip"
+
+	// ExportFormatJSON 将个人资料和所有博文保存在同一个 JSON 文件中.
+	ExportFormatJSON = "json"
 )
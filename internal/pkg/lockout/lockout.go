@@ -0,0 +1,190 @@
+// Package lockout 记录登录失败次数，失败次数达到阈值后按指数退避临时锁定，用于防止暴力破解密码.
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	genericoptions "github.com/loveRyujin/fast_blog/pkg/options"
+)
+
+// Store 定义了保存失败计数和锁定状态需要实现的方法，多实例部署时应使用 redis 等共享存储.
+type Store interface {
+	// Incr 将 key 的计数加一并返回加一后的值，同时将计数的过期时间设置为 ttl
+	Incr(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	// Lock 锁定 key，锁定在 ttl 后自动解除
+	Lock(ctx context.Context, key string, ttl time.Duration) error
+	// LockedFor 返回 key 剩余的锁定时长，未锁定时返回 0
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	// Delete 删除 key，key 不存在时不返回错误
+	Delete(ctx context.Context, keys ...string) error
+	// Close 释放存储占用的资源
+	Close() error
+}
+
+// LoginGuard 按用户名和客户端 IP 分别限制登录失败次数.
+type LoginGuard struct {
+	store Store
+	users *Limiter
+	ips   *Limiter
+}
+
+// New 根据配置创建 LoginGuard.
+func New(opts *genericoptions.LockoutOptions, redisOpts *genericoptions.RedisOptions) (*LoginGuard, error) {
+	store, err := NewStore(opts, redisOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := Policy{Window: opts.Window, BaseDuration: opts.BaseDuration, MaxDuration: opts.MaxDuration}
+	users, ips := policy, policy
+	users.MaxFailures = int64(opts.MaxUserFailures)
+	ips.MaxFailures = int64(opts.MaxIPFailures)
+	return NewLoginGuard(store, users, ips), nil
+}
+
+// NewLoginGuard 创建使用 store 保存计数的 LoginGuard，users 和 ips 分别是用户名和客户端 IP 的锁定策略.
+func NewLoginGuard(store Store, users, ips Policy) *LoginGuard {
+	return &LoginGuard{
+		store: store,
+		users: NewLimiter(store, "user", users),
+		ips:   NewLimiter(store, "ip", ips),
+	}
+}
+
+// Check 返回用户名和客户端 IP 中较长的剩余锁定时长，都未锁定时返回 0.
+func (g *LoginGuard) Check(ctx context.Context, username, ip string) (time.Duration, error) {
+	userWait, err := g.users.Check(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	ipWait, err := g.ips.Check(ctx, ip)
+	if err != nil {
+		return 0, err
+	}
+	return max(userWait, ipWait), nil
+}
+
+// Fail 记录一次登录失败，返回用户名和客户端 IP 中较长的锁定时长.
+// 用户名不存在时同样计数，锁定行为与存在的用户一致，避免通过锁定枚举用户名.
+func (g *LoginGuard) Fail(ctx context.Context, username, ip string) (time.Duration, error) {
+	userWait, err := g.users.Fail(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	ipWait, err := g.ips.Fail(ctx, ip)
+	if err != nil {
+		return 0, err
+	}
+	return max(userWait, ipWait), nil
+}
+
+// Succeed 在登录成功后清除用户名的失败计数.
+// 客户端 IP 的计数不清除，避免攻击者穿插登录自己的账号来重置计数.
+func (g *LoginGuard) Succeed(ctx context.Context, username string) error {
+	return g.users.Reset(ctx, username)
+}
+
+// Close 释放存储占用的资源.
+func (g *LoginGuard) Close() error {
+	return g.store.Close()
+}
+
+// NewStore 根据配置创建保存失败计数的存储.
+func NewStore(opts *genericoptions.LockoutOptions, redisOpts *genericoptions.RedisOptions) (Store, error) {
+	switch opts.Store {
+	case genericoptions.LockoutStoreMemory:
+		return NewMemory(), nil
+	case genericoptions.LockoutStoreRedis:
+		client, err := redisOpts.NewClient()
+		if err != nil {
+			return nil, err
+		}
+		return NewRedis(client, "fast_blog:lockout:"), nil
+	default:
+		return nil, fmt.Errorf("unsupported lockout store: %s", opts.Store)
+	}
+}
+
+// Policy 是一类键的锁定策略.
+type Policy struct {
+	// MaxFailures 是允许的失败次数，失败次数达到该值后开始锁定，为 0 时不限制
+	MaxFailures int64
+	// Window 是失败计数的有效期，最后一次失败后超过 Window 与 MaxDuration 之和没有新的失败时计数清零
+	Window time.Duration
+	// BaseDuration 是第一次锁定的时长，之后每多失败一次锁定时长翻倍
+	BaseDuration time.Duration
+	// MaxDuration 是锁定时长的上限
+	MaxDuration time.Duration
+}
+
+// lockDuration 返回失败 failures 次后的锁定时长，未达到阈值时返回 0.
+func (p Policy) lockDuration(failures int64) time.Duration {
+	if p.MaxFailures <= 0 || failures < p.MaxFailures {
+		return 0
+	}
+
+	d := p.BaseDuration
+	for i := p.MaxFailures; i < failures && d < p.MaxDuration; i++ {
+		d *= 2
+	}
+	return min(d, p.MaxDuration)
+}
+
+// Limiter 按键限制失败次数，例如用户名或客户端 IP.
+type Limiter struct {
+	store  Store
+	prefix string
+	policy Policy
+}
+
+// NewLimiter 创建使用 store 保存计数的 Limiter，prefix 用于区分不同种类的键.
+func NewLimiter(store Store, prefix string, policy Policy) *Limiter {
+	return &Limiter{store: store, prefix: prefix, policy: policy}
+}
+
+// Check 返回 key 剩余的锁定时长，未锁定时返回 0.
+func (l *Limiter) Check(ctx context.Context, key string) (time.Duration, error) {
+	if l.policy.MaxFailures <= 0 || key == "" {
+		return 0, nil
+	}
+	return l.store.LockedFor(ctx, l.lockKey(key))
+}
+
+// Fail 记录 key 的一次失败，失败次数达到阈值时锁定 key 并返回锁定时长.
+// 计数的有效期包含最长的锁定时长，锁定期间计数不会清零，解除锁定后再次失败时锁定时长继续翻倍.
+func (l *Limiter) Fail(ctx context.Context, key string) (time.Duration, error) {
+	if l.policy.MaxFailures <= 0 || key == "" {
+		return 0, nil
+	}
+
+	failures, err := l.store.Incr(ctx, l.countKey(key), l.policy.Window+l.policy.MaxDuration)
+	if err != nil {
+		return 0, err
+	}
+
+	d := l.policy.lockDuration(failures)
+	if d > 0 {
+		if err := l.store.Lock(ctx, l.lockKey(key), d); err != nil {
+			return 0, err
+		}
+	}
+	return d, nil
+}
+
+// Reset 清除 key 的失败计数和锁定.
+func (l *Limiter) Reset(ctx context.Context, key string) error {
+	if l.policy.MaxFailures <= 0 || key == "" {
+		return nil
+	}
+	return l.store.Delete(ctx, l.countKey(key), l.lockKey(key))
+}
+
+func (l *Limiter) countKey(key string) string {
+	return l.prefix + ":count:" + key
+}
+
+func (l *Limiter) lockKey(key string) string {
+	return l.prefix + ":lock:" + key
+}
@@ -0,0 +1,87 @@
+package lockout_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/lockout"
+)
+
+// testLoginGuard 校验各个存储实现共同的计数、退避和重置行为.
+func testLoginGuard(t *testing.T, store lockout.Store) {
+	ctx := context.Background()
+	users := lockout.Policy{MaxFailures: 3, Window: time.Minute, BaseDuration: time.Minute, MaxDuration: 4 * time.Minute}
+	ips := lockout.Policy{MaxFailures: 8, Window: time.Minute, BaseDuration: time.Minute, MaxDuration: time.Hour}
+	guard := lockout.NewLoginGuard(store, users, ips)
+
+	// 未达到阈值时不锁定
+	for range 2 {
+		wait, err := guard.Fail(ctx, "alice", "10.0.0.1")
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+	wait, err := guard.Check(ctx, "alice", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	// 达到阈值后锁定，之后每次失败锁定时长翻倍，直到上限
+	for _, want := range []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 4 * time.Minute} {
+		wait, err := guard.Fail(ctx, "alice", "10.0.0.1")
+		require.NoError(t, err)
+		assert.Equal(t, want, wait)
+	}
+	wait, err = guard.Check(ctx, "alice", "10.0.0.2")
+	require.NoError(t, err)
+	assert.InDelta(t, 4*time.Minute, wait, float64(time.Second))
+
+	// 其它用户名不受影响
+	wait, err = guard.Check(ctx, "bob", "10.0.0.2")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	// 登录成功只清除用户名的计数，客户端 IP 的计数保留
+	require.NoError(t, guard.Succeed(ctx, "alice"))
+	wait, err = guard.Check(ctx, "alice", "10.0.0.2")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	// 客户端 IP 累计失败 9 次，已经超过阈值一次
+	for range 3 {
+		wait, err = guard.Fail(ctx, "bob", "10.0.0.1")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2*time.Minute, wait)
+	wait, err = guard.Check(ctx, "carol", "10.0.0.1")
+	require.NoError(t, err)
+	assert.InDelta(t, 2*time.Minute, wait, float64(time.Second))
+
+	require.NoError(t, guard.Close())
+}
+
+func TestMemoryLoginGuard(t *testing.T) {
+	testLoginGuard(t, lockout.NewMemory())
+}
+
+func TestRedisLoginGuard(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	testLoginGuard(t, lockout.NewRedis(client, "test:"))
+}
+
+func TestDisabledLimiter(t *testing.T) {
+	ctx := context.Background()
+	guard := lockout.NewLoginGuard(lockout.NewMemory(), lockout.Policy{}, lockout.Policy{})
+
+	for range 100 {
+		wait, err := guard.Fail(ctx, "alice", "10.0.0.1")
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+}
@@ -0,0 +1,102 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval 是内存存储清理过期键的最小间隔.
+const sweepInterval = time.Minute
+
+// memoryStore 是进程内的存储，多实例部署时各实例的计数互相独立.
+type memoryStore struct {
+	mu        sync.Mutex
+	counts    map[string]memoryEntry
+	locks     map[string]time.Time
+	lastSweep time.Time
+}
+
+// memoryEntry 是保存的计数.
+type memoryEntry struct {
+	value    int64
+	expireAt time.Time
+}
+
+var _ Store = (*memoryStore)(nil)
+
+// NewMemory 创建进程内的存储.
+func NewMemory() Store {
+	return &memoryStore{counts: make(map[string]memoryEntry), locks: make(map[string]time.Time)}
+}
+
+// Incr 实现 Store 接口中的 Incr 方法.
+func (s *memoryStore) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+	entry := s.counts[key]
+	if !now.Before(entry.expireAt) {
+		entry.value = 0
+	}
+	entry.value++
+	entry.expireAt = now.Add(ttl)
+	s.counts[key] = entry
+	return entry.value, nil
+}
+
+// Lock 实现 Store 接口中的 Lock 方法.
+func (s *memoryStore) Lock(ctx context.Context, key string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locks[key] = time.Now().Add(ttl)
+	return nil
+}
+
+// LockedFor 实现 Store 接口中的 LockedFor 方法.
+func (s *memoryStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return max(time.Until(s.locks[key]), 0), nil
+}
+
+// Delete 实现 Store 接口中的 Delete 方法.
+func (s *memoryStore) Delete(ctx context.Context, keys ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		delete(s.counts, key)
+		delete(s.locks, key)
+	}
+	return nil
+}
+
+// Close 实现 Store 接口中的 Close 方法.
+func (s *memoryStore) Close() error {
+	return nil
+}
+
+// sweep 定期删除过期的计数和锁定，避免大量不同的用户名或 IP 占用内存.
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, entry := range s.counts {
+		if !now.Before(entry.expireAt) {
+			delete(s.counts, key)
+		}
+	}
+	for key, expireAt := range s.locks {
+		if !now.Before(expireAt) {
+			delete(s.locks, key)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package lockout
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisStore 是基于 redis 的存储，多个服务实例共享同一份计数.
+type redisStore struct {
+	client redis.UniversalClient
+	// prefix 是所有键的前缀，避免与同一 redis 中的其它数据冲突
+	prefix string
+}
+
+var _ Store = (*redisStore)(nil)
+
+// NewRedis 创建基于 redis 的存储，所有键都会加上 prefix 前缀.
+func NewRedis(client redis.UniversalClient, prefix string) Store {
+	return &redisStore{client: client, prefix: prefix}
+}
+
+// Incr 实现 Store 接口中的 Incr 方法，计数和过期时间在同一个事务中设置.
+func (s *redisStore) Incr(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var incr *redis.IntCmd
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, s.prefix+key)
+		pipe.PExpire(ctx, s.prefix+key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// Lock 实现 Store 接口中的 Lock 方法.
+func (s *redisStore) Lock(ctx context.Context, key string, ttl time.Duration) error {
+	return s.client.Set(ctx, s.prefix+key, 1, ttl).Err()
+}
+
+// LockedFor 实现 Store 接口中的 LockedFor 方法，键不存在时 PTTL 返回负数.
+func (s *redisStore) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.client.PTTL(ctx, s.prefix+key).Result()
+	if err != nil {
+		return 0, err
+	}
+	return max(ttl, 0), nil
+}
+
+// Delete 实现 Store 接口中的 Delete 方法.
+func (s *redisStore) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	prefixed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		prefixed = append(prefixed, s.prefix+key)
+	}
+	return s.client.Del(ctx, prefixed...).Err()
+}
+
+// Close 实现 Store 接口中的 Close 方法.
+func (s *redisStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,87 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	mw "github.com/loveRyujin/fast_blog/internal/pkg/middleware/grpc"
+	"github.com/loveRyujin/fast_blog/pkg/token"
+)
+
+func newTokenManager(t *testing.T) *token.Manager {
+	tokens, err := token.NewManager(token.WithHMACKey("test-key-0123456789-0123456789-0123456789"))
+	require.NoError(t, err)
+	return tokens
+}
+
+func TestAuthnInterceptor(t *testing.T) {
+	tokens := newTokenManager(t)
+	interceptor := mw.AuthnInterceptor(tokens, nil, "/v1.FastBlog/Login")
+	handler := func(ctx context.Context, req any) (any, error) {
+		return contextx.UserID(ctx), nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/v1.FastBlog/GetUser"}
+
+	// 白名单中的方法无需认证
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/v1.FastBlog/Login"}, handler)
+	assert.NoError(t, err)
+	assert.Empty(t, resp)
+
+	// 缺少 token 的请求被拒绝
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.Error(t, err)
+
+	// 伪造的 token 被拒绝
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer invalid"))
+	_, err = interceptor(ctx, nil, info, handler)
+	assert.Error(t, err)
+
+	// 合法的 token 会将用户 ID 注入到上下文中
+	tokenString, _, err := tokens.Sign("user-000001", "session-1", nil)
+	assert.NoError(t, err)
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tokenString))
+	resp, err = interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-000001", resp)
+}
+
+// revokedSessions 是测试使用的撤销列表.
+type revokedSessions map[string]bool
+
+func (r revokedSessions) Revoked(_ context.Context, sessionID string) (bool, error) {
+	return r[sessionID], nil
+}
+
+func TestAuthnInterceptorRevoked(t *testing.T) {
+	tokens := newTokenManager(t)
+	interceptor := mw.AuthnInterceptor(tokens, revokedSessions{"session-revoked": true})
+	handler := func(ctx context.Context, req any) (any, error) {
+		return contextx.SessionID(ctx), nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/v1.FastBlog/GetUser"}
+	call := func(sessionID string) (any, error) {
+		tokenString, _, err := tokens.Sign("user-000001", sessionID, nil)
+		assert.NoError(t, err)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tokenString))
+		return interceptor(ctx, nil, info, handler)
+	}
+
+	// 已撤销会话签发的 token 被拒绝
+	_, err := call("session-revoked")
+	assert.Error(t, err)
+
+	// 未撤销会话的 token 会将会话 ID 注入到上下文中
+	resp, err := call("session-active")
+	assert.NoError(t, err)
+	assert.Equal(t, "session-active", resp)
+
+	// 不包含会话 ID 的 token 被拒绝
+	_, err = call("")
+	assert.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// authzAction 是 gRPC 请求在授权策略中对应的操作.
+const authzAction = "CALL"
+
+// Authorizer 定义授权器需要实现的方法.
+type Authorizer interface {
+	Authorize(subject, object, action string) (bool, error)
+}
+
+// AuthzInterceptor 是一个 gRPC 授权拦截器，以<用户 ID, 方法全名, CALL>为三元组调用授权器进行鉴权，
+// 必须在 AuthnInterceptor 之后使用. skipMethods 中的方法无需授权即可访问.
+func AuthzInterceptor(a Authorizer, skipMethods ...string) grpc.UnaryServerInterceptor {
+	whitelist := sets.New(skipMethods...)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if whitelist.Has(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		if err := authorize(ctx, a, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		// 继续处理请求
+		return handler(ctx, req)
+	}
+}
+
+// AuthzStreamInterceptor 是 AuthzInterceptor 的流式版本.
+func AuthzStreamInterceptor(a Authorizer, skipMethods ...string) grpc.StreamServerInterceptor {
+	whitelist := sets.New(skipMethods...)
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if whitelist.Has(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		if err := authorize(ss.Context(), a, info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+// authorize 使用上下文中的用户 ID 对指定的 gRPC 方法进行鉴权.
+func authorize(ctx context.Context, a Authorizer, method string) error {
+	subject := contextx.UserID(ctx)
+
+	log.With(ctx).Debugw("Build authorize context", "subject", subject, "object", method, "action", authzAction)
+
+	if allowed, err := a.Authorize(subject, method, authzAction); err != nil || !allowed {
+		return errorx.ErrPermissionDenied
+	}
+
+	return nil
+}
@@ -1,27 +1,56 @@
 package middleware
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
 	"github.com/loveRyujin/fast_blog/internal/pkg/core"
 	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
 	"github.com/loveRyujin/fast_blog/pkg/token"
 )
 
+// TokenParser 定义解析请求中 token 需要实现的方法.
+type TokenParser interface {
+	ParseRequest(c *gin.Context) (*token.Claims, error)
+}
+
+// RevocationChecker 定义撤销列表需要实现的方法.
+type RevocationChecker interface {
+	// Revoked 判断登录会话是否已被撤销
+	Revoked(ctx context.Context, sessionID string) (bool, error)
+}
+
 // Authn 是认证中间件，用来从 gin.Context 中提取 token 并验证 token 是否合法，
-// 如果合法则将 token 中的 sub 作为<用户名>存放在 gin.Context 的 XUsernameKey 键中.
-func Authn() gin.HandlerFunc {
+// 如果合法则将 token 中的用户 ID 和会话 ID 存放到上下文中. r 不为 nil 时拒绝已被撤销的会话签发的 token.
+func Authn(p TokenParser, r RevocationChecker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 解析 JWT Token
-		userID, err := token.ParseRequest(c)
+		claims, err := p.ParseRequest(c)
 		if err != nil {
 			core.WriteResponse(c, nil, errorx.ErrTokenInvalid)
 			c.Abort()
 			return
 		}
 
-		// 将用户ID和用户名注入到上下文中
-		ctx := contextx.WithUserID(c.Request.Context(), userID)
+		// 检查 token 所属的会话是否已被撤销
+		if r != nil {
+			revoked, err := r.Revoked(c.Request.Context(), claims.SessionID)
+			if err == nil && revoked {
+				log.With(c.Request.Context()).Debugw("Token session has been revoked", "sessionID", claims.SessionID)
+				err = errorx.ErrTokenInvalid
+			}
+			if err != nil {
+				core.WriteResponse(c, nil, err)
+				c.Abort()
+				return
+			}
+		}
+
+		// 将用户ID和会话ID注入到上下文中
+		ctx := contextx.WithUserID(c.Request.Context(), claims.UserID())
+		ctx = contextx.WithSessionID(ctx, claims.SessionID)
 		c.Request = c.Request.WithContext(ctx)
 
 		// 继续后续的操作
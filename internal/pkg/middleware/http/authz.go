@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/core"
+	"github.com/loveRyujin/fast_blog/internal/pkg/errorx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// Authorizer 定义授权器需要实现的方法.
+type Authorizer interface {
+	Authorize(subject, object, action string) (bool, error)
+}
+
+// Authz 是授权中间件，以<用户 ID, 请求路径, 请求方法>为三元组调用授权器进行鉴权，
+// 必须在 Authn 中间件之后使用.
+func Authz(a Authorizer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		subject := contextx.UserID(c.Request.Context())
+		object := c.Request.URL.Path
+		action := c.Request.Method
+
+		log.With(c.Request.Context()).Debugw("Build authorize context", "subject", subject, "object", object, "action", action)
+
+		if allowed, err := a.Authorize(subject, object, action); err != nil || !allowed {
+			core.WriteResponse(c, nil, errorx.ErrPermissionDenied)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
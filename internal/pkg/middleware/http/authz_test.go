@@ -0,0 +1,72 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"github.com/onexstack/onexstack/pkg/authz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/contextx"
+	"github.com/loveRyujin/fast_blog/internal/pkg/known"
+	mw "github.com/loveRyujin/fast_blog/internal/pkg/middleware/http"
+)
+
+// newAuthz 创建使用内存数据库保存策略的 casbin 授权器.
+func newAuthz(t *testing.T) *authz.Authz {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	a, err := authz.NewAuthz(db)
+	require.NoError(t, err)
+
+	_, err = a.AddPolicies([][]string{
+		{known.RoleAdmin, "*", "*", "allow"},
+		{known.RoleUser, "/v1/users/*", http.MethodDelete, "deny"},
+		{"user-000003", "/v1/posts", http.MethodGet, "deny"},
+	})
+	require.NoError(t, err)
+	_, err = a.AddGroupingPolicies([][]string{
+		{"user-000001", known.RoleUser},
+		{"user-000002", known.RoleAdmin},
+		{"user-000003", known.RoleUser},
+	})
+	require.NoError(t, err)
+	return a
+}
+
+func TestAuthz(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(func(c *gin.Context) {
+		ctx := contextx.WithUserID(c.Request.Context(), c.GetHeader("X-User-ID"))
+		c.Request = c.Request.WithContext(ctx)
+	}, mw.Authz(newAuthz(t)))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	engine.GET("/v1/posts", ok)
+	engine.POST("/v1/posts", ok)
+	engine.DELETE("/v1/users/:userID", ok)
+
+	do := func(method, path, userID string) int {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("X-User-ID", userID)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	// 未命中 deny 规则的请求放行
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/v1/posts", "user-000001"))
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/v1/posts", "user-000009"))
+
+	// deny 规则按<用户, 路径, 方法>匹配，并通过角色继承
+	assert.Equal(t, http.StatusForbidden, do(http.MethodDelete, "/v1/users/user-000002", "user-000001"))
+	assert.Equal(t, http.StatusOK, do(http.MethodDelete, "/v1/users/user-000001", "user-000002"))
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/v1/posts", "user-000003"))
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/v1/posts", "user-000003"))
+}
@@ -1,18 +1,15 @@
 package middleware
 
 import (
-	"net/http"
-	"time"
-
 	"github.com/gin-gonic/gin"
 )
 
 // NoCache 是一个 Gin 中间件，用来禁止客户端缓存 HTTP 请求的返回结果.
+// 支持条件请求的接口会在处理请求时覆盖 Cache-Control，并设置真实的 ETag 和 Last-Modified.
 func NoCache() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		c.Header("Cache-Control", "no-cache, no-store, max-age=0, must-revalidate, value")
+		c.Header("Cache-Control", "no-cache, no-store, max-age=0, must-revalidate")
 		c.Header("Expires", "Thu, 01 Jan 1970 00:00:00 GMT")
-		c.Header("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
 		c.Next()
 	}
 }
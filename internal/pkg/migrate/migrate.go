@@ -0,0 +1,284 @@
+// Package migrate 实现了基于版本号的 SQL 数据库迁移.
+//
+// 迁移文件命名为 <版本号>_<名称>.up.sql 和 <版本号>_<名称>.down.sql，版本号为正整数，
+// 已执行的迁移记录在 schema_migrations 表中.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// fileNamePattern 匹配迁移文件名，例如 0001_init.up.sql.
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration 表示一个版本的迁移.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status 表示一个迁移的执行状态.
+type Status struct {
+	*Migration
+	// AppliedAt 为迁移的执行时间，未执行的迁移为 nil
+	AppliedAt *time.Time
+}
+
+// schemaMigration 是 schema_migrations 表中的一条记录.
+type schemaMigration struct {
+	Version   int64     `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"column:name;size:255;not null"`
+	AppliedAt time.Time `gorm:"column:appliedAt;not null"`
+}
+
+// TableName 返回迁移记录表的表名.
+func (*schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator 负责执行迁移.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+}
+
+// New 从 fsys 根目录中加载迁移文件，创建 Migrator 实例.
+func New(db *gorm.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load 从 fsys 根目录中加载迁移文件，返回按版本号升序排列的迁移.
+func Load(fsys fs.FS) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+		version, _ := strconv.ParseInt(matches[1], 10, 64)
+		if version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d has different names: %s, %s", version, m.Name, matches[2])
+		}
+		if matches[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up step", m.Version, m.Name)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Migrations 返回所有迁移.
+func (m *Migrator) Migrations() []*Migration {
+	return m.migrations
+}
+
+// Up 按版本号升序执行未执行的迁移，steps 大于 0 时最多执行 steps 个，返回本次执行的迁移.
+func (m *Migrator) Up(ctx context.Context, steps int) ([]*Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		if steps > 0 && len(done) >= steps {
+			break
+		}
+
+		err := m.run(ctx, migration, migration.Up, func(tx *gorm.DB) error {
+			return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down 按版本号降序回滚已执行的迁移，steps 小于等于 0 时回滚 1 个，返回本次回滚的迁移.
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*Migration, error) {
+	if steps <= 0 {
+		steps = 1
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := m.migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if migration.Down == "" {
+			return done, fmt.Errorf("migration %d_%s has no down step", migration.Version, migration.Name)
+		}
+
+		err := m.run(ctx, migration, migration.Down, func(tx *gorm.DB) error {
+			return tx.Delete(&schemaMigration{}, migration.Version).Error
+		})
+		if err != nil {
+			return done, err
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Status 返回所有迁移的执行状态，按版本号升序排列.
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := &Status{Migration: migration}
+		if record, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &record.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// applied 创建 schema_migrations 表（如果不存在），并返回已执行的迁移记录.
+func (m *Migrator) applied(ctx context.Context) (map[int64]*schemaMigration, error) {
+	db := m.db.WithContext(ctx)
+	if err := db.AutoMigrate(&schemaMigration{}); err != nil {
+		return nil, err
+	}
+
+	var records []*schemaMigration
+	if err := db.Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]*schemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// run 在事务中逐条执行迁移语句，并通过 record 更新迁移记录.
+// 注意：MySQL 的 DDL 语句会隐式提交事务，执行失败时需要手动处理已生效的语句.
+func (m *Migrator) run(ctx context.Context, migration *Migration, script string, record func(tx *gorm.DB) error) error {
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, stmt := range Split(script) {
+			if err := tx.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+	if err != nil {
+		return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+	}
+
+	return nil
+}
+
+// Create 在 dirs 中的每个目录下创建一对版本号相同的空迁移文件，返回创建的文件路径.
+// 版本号为所有目录中已有迁移的最大版本号加 1，保证不同存储后端的迁移版本一致.
+func Create(name string, dirs ...string) ([]string, error) {
+	name = strings.ToLower(strings.NewReplacer("-", "_", " ", "_").Replace(strings.TrimSpace(name)))
+	if !fileNamePattern.MatchString("1_" + name + ".up.sql") {
+		return nil, fmt.Errorf("invalid migration name %q, only lowercase letters, digits and underscores are allowed", name)
+	}
+
+	var version int64
+	for _, dir := range dirs {
+		migrations, err := Load(os.DirFS(dir))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if len(migrations) > 0 {
+			version = max(version, migrations[len(migrations)-1].Version)
+		}
+	}
+	version++
+
+	var files []string
+	for _, dir := range dirs {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return files, err
+		}
+		for _, direction := range []string{"up", "down"} {
+			file := filepath.Join(dir, fmt.Sprintf("%04d_%s.%s.sql", version, name, direction))
+			content := fmt.Sprintf("-- %04d_%s %s\n", version, name, direction)
+			if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+				return files, err
+			}
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
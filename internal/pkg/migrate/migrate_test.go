@@ -0,0 +1,79 @@
+package migrate_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/migrate"
+)
+
+func TestSplit(t *testing.T) {
+	script := `-- 创建表; 注释中的分号会被忽略
+CREATE TABLE a (name varchar(10) DEFAULT 'x;y'); /* 多行
+注释; */
+INSERT INTO a VALUES ('it''s;'), ("a\"b;");
+-- 只有注释的语句会被忽略;
+`
+	assert.Equal(t, []string{
+		"-- 创建表; 注释中的分号会被忽略\nCREATE TABLE a (name varchar(10) DEFAULT 'x;y')",
+		"/* 多行\n注释; */\nINSERT INTO a VALUES ('it''s;'), (\"a\\\"b;\")",
+	}, migrate.Split(script))
+}
+
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open("file:migrate?mode=memory&cache=shared"), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	// 关闭所有连接后共享的内存数据库才会被释放，避免重复运行时读到上次的数据
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	fsys := fstest.MapFS{
+		"0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id integer);\nINSERT INTO a VALUES (1);")},
+		"0001_create_a.down.sql": {Data: []byte("DROP TABLE a;")},
+		"0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id integer);")},
+		"0002_create_b.down.sql": {Data: []byte("DROP TABLE b;")},
+		"0003_broken.up.sql":     {Data: []byte("CREATE TABLE c (id integer); SELECT * FROM missing;")},
+	}
+	m, err := migrate.New(db, fsys)
+	require.NoError(t, err)
+	require.Len(t, m.Migrations(), 3)
+
+	done, err := m.Up(ctx, 2)
+	require.NoError(t, err)
+	assert.Len(t, done, 2)
+	assert.True(t, db.Migrator().HasTable("b"))
+
+	// 执行失败的迁移整体回滚，不会留下记录
+	_, err = m.Up(ctx, 0)
+	require.Error(t, err)
+	assert.False(t, db.Migrator().HasTable("c"))
+
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	assert.NotNil(t, statuses[1].AppliedAt)
+	assert.Nil(t, statuses[2].AppliedAt)
+
+	done, err = m.Down(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, done, 1)
+	assert.EqualValues(t, 2, done[0].Version)
+	assert.False(t, db.Migrator().HasTable("b"))
+	assert.True(t, db.Migrator().HasTable("a"))
+}
+
+func TestLoadRejectsInvalidFiles(t *testing.T) {
+	_, err := migrate.Load(fstest.MapFS{"init.sql": {Data: []byte("SELECT 1;")}})
+	assert.Error(t, err)
+
+	_, err = migrate.Load(fstest.MapFS{"0001_init.down.sql": {Data: []byte("SELECT 1;")}})
+	assert.Error(t, err)
+}
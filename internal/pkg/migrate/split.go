@@ -0,0 +1,72 @@
+package migrate
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Split 将 SQL 脚本按分号拆分为单独的语句，忽略引号和注释中的分号，并去除只包含注释的空语句.
+// 逐条执行语句可以避免依赖数据库驱动的多语句支持（如 MySQL 的 multiStatements 参数）.
+func Split(script string) []string {
+	var (
+		stmts   []string
+		current strings.Builder
+		hasCode bool
+	)
+	flush := func() {
+		if hasCode {
+			stmts = append(stmts, strings.TrimSpace(current.String()))
+		}
+		current.Reset()
+		hasCode = false
+	}
+
+	runes := []rune(script)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		end := i + 1
+		switch {
+		case r == '\'' || r == '"' || r == '`':
+			// 引号内的内容原样保留，两个连续的引号或反斜杠表示转义
+			for ; end < len(runes); end++ {
+				if runes[end] == '\\' && r != '`' {
+					end++
+				} else if runes[end] == r {
+					if end+1 < len(runes) && runes[end+1] == r {
+						end++
+						continue
+					}
+					end++
+					break
+				}
+			}
+			hasCode = true
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			// 单行注释
+			for end < len(runes) && runes[end] != '\n' {
+				end++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			// 多行注释
+			end = i + 2
+			for end < len(runes) && !(runes[end-1] == '*' && runes[end] == '/' && end > i+2) {
+				end++
+			}
+			end = min(end+1, len(runes))
+		case r == ';':
+			flush()
+			continue
+		default:
+			if !unicode.IsSpace(r) {
+				hasCode = true
+			}
+		}
+
+		end = min(end, len(runes))
+		current.WriteString(string(runes[i:end]))
+		i = end - 1
+	}
+	flush()
+
+	return stmts
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/loveRyujin/fast_blog/internal/pkg/log"
+)
+
+// JobServer 按固定的时间间隔在后台执行任务，实现了 Server 接口，
+// 可以随其它服务一同启动，并在服务退出时优雅停止.
+type JobServer struct {
+	name     string
+	interval time.Duration
+	job      func(ctx context.Context)
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var _ Server = (*JobServer)(nil)
+
+// NewJobServer 创建一个新的后台任务服务实例.
+func NewJobServer(name string, interval time.Duration, job func(ctx context.Context)) *JobServer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &JobServer{
+		name:     name,
+		interval: interval,
+		job:      job,
+		ctx:      ctx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+}
+
+func (s *JobServer) Run() {
+	log.Infow("Start to run background job", "job", s.name, "interval", s.interval.String())
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			s.job(s.ctx)
+		}
+	}
+}
+
+func (s *JobServer) GracefulStop(ctx context.Context) {
+	log.Infow("Gracefully stop background job", "job", s.name)
+	s.cancel()
+
+	// 等待正在执行的任务结束，超时后直接返回
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		log.Errorw("Background job forced to stop", "job", s.name, "err", ctx.Err())
+	}
+}
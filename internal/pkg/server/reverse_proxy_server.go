@@ -6,11 +6,14 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/loveRyujin/fast_blog/internal/pkg/conditional"
 	"github.com/loveRyujin/fast_blog/internal/pkg/log"
 	"github.com/loveRyujin/fast_blog/pkg/options"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -38,13 +41,19 @@ func NewGRPCGatewayServer(
 		return nil, err
 	}
 
-	gwmux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-		MarshalOptions: protojson.MarshalOptions{
-			// 设置序列化 protobuf 数据时，枚举类型的字段以数字格式输出.
-			// 否则，默认会以字符串格式输出，跟枚举类型定义不一致，带来理解成本.
-			UseEnumNumbers: true,
-		},
-	}))
+	gwmux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &httpBodyMarshaler{&runtime.HTTPBodyMarshaler{
+			Marshaler: &runtime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					// 设置序列化 protobuf 数据时，枚举类型的字段以数字格式输出.
+					// 否则，默认会以字符串格式输出，跟枚举类型定义不一致，带来理解成本.
+					UseEnumNumbers: true,
+				},
+			},
+		}}),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(errorHandler),
+	)
 	if err := registerHandler(gwmux, conn); err != nil {
 		log.Errorw("Failed to register handler", "err", err)
 		return nil, err
@@ -53,11 +62,48 @@ func NewGRPCGatewayServer(
 	return &GRPCGatewayServer{
 		srv: &http.Server{
 			Addr:    httpOptions.Addr,
-			Handler: gwmux,
+			Handler: conditional.Handler(gwmux),
 		},
 	}, nil
 }
 
+// httpBodyMarshaler 使用 google.api.HttpBody 中的 Content-Type 和原始数据作为响应，
+// 并且不在流式响应的数据块之间插入分隔符，避免破坏下载的文件内容.
+type httpBodyMarshaler struct {
+	*runtime.HTTPBodyMarshaler
+}
+
+// Delimiter 返回流式响应的数据块之间的分隔符.
+func (m *httpBodyMarshaler) Delimiter() []byte {
+	return nil
+}
+
+// outgoingHeaders 是 gRPC 响应元数据中原样作为 HTTP 响应头的键.
+var outgoingHeaders = map[string]string{
+	"content-disposition": "Content-Disposition",
+	"etag":                "ETag",
+	"last-modified":       "Last-Modified",
+	"cache-control":       "Cache-Control",
+}
+
+// outgoingHeaderMatcher 将 gRPC 响应元数据中的 Content-Disposition、ETag 等原样作为 HTTP 响应头，
+// 其它元数据与默认行为一致，添加 Grpc-Metadata- 前缀.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if header, ok := outgoingHeaders[key]; ok {
+		return header, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// errorHandler 将 FailedPrecondition 错误转换为 412，其它错误与默认行为一致.
+// grpc-gateway 默认将 FailedPrecondition 转换为 400.
+func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.FailedPrecondition {
+		err = &runtime.HTTPStatusError{HTTPStatus: http.StatusPreconditionFailed, Err: err}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
 func (s *GRPCGatewayServer) Run() {
 	log.Infow("Start to listen the incoming requests", "protocol", protocolName(s.srv), "addr", s.srv.Addr)
 	if err := s.srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -9,6 +9,7 @@ package v1
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -27,26 +28,366 @@ var File_apiserver_v1_apiserver_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_apiserver_proto_rawDesc = "" +
 	"\n" +
-	"\x1capiserver/v1/apiserver.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1aapiserver/v1/healthz.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x82\x01\n" +
+	"\x1capiserver/v1/apiserver.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1aapiserver/v1/healthz.proto\x1a\x17apiserver/v1/user.proto\x1a\x17apiserver/v1/post.proto\x1a apiserver/v1/post_revision.proto\x1a\x16apiserver/v1/tag.proto\x1a\x1bapiserver/v1/category.proto\x1a\x1aapiserver/v1/comment.proto\x1a\x19apiserver/v1/search.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x94@\n" +
 	"\bFastBlog\x12v\n" +
 	"\aHealthz\x12\x16.google.protobuf.Empty\x1a\x13.v1.HealthzResponse\">\x92A+\n" +
 	"\f服务治理\x12\x12服务健康检查*\aHealthz\x82\xd3\xe4\x93\x02\n" +
-	"\x12\b/healthzB\xb5\x01\x92A|\x12S\n" +
+	"\x12\b/healthz\x12e\n" +
+	"\x05Login\x12\x10.v1.LoginRequest\x1a\x11.v1.LoginResponse\"7\x92A#\n" +
+	"\f用户管理\x12\f用户登录*\x05Login\x82\xd3\xe4\x93\x02\v:\x01*\"\x06/login\x12|\n" +
+	"\tLoginTOTP\x12\x14.v1.LoginTOTPRequest\x1a\x11.v1.LoginResponse\"F\x92A-\n" +
+	"\f用户管理\x12\x12两步验证登录*\tLoginTOTP\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/login/totp\x12\x89\x01\n" +
+	"\fRefreshToken\x12\x17.v1.RefreshTokenRequest\x1a\x18.v1.RefreshTokenResponse\"F\x92A*\n" +
+	"\f用户管理\x12\f刷新令牌*\fRefreshToken\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/refresh-token\x12j\n" +
+	"\x06Logout\x12\x11.v1.LogoutRequest\x1a\x12.v1.LogoutResponse\"9\x92A$\n" +
+	"\f用户管理\x12\f退出登录*\x06Logout\x82\xd3\xe4\x93\x02\f:\x01*\"\a/logout\x12\xa5\x01\n" +
+	"\x0eChangePassword\x12\x19.v1.ChangePasswordRequest\x1a\x1a.v1.ChangePasswordResponse\"\\\x92A,\n" +
+	"\f用户管理\x12\f修改密码*\x0eChangePassword\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v1/users/{userID}/change-password\x12\x90\x01\n" +
+	"\n" +
+	"EnrollTOTP\x12\x15.v1.EnrollTOTPRequest\x1a\x16.v1.EnrollTOTPResponse\"S\x92A.\n" +
+	"\f用户管理\x12\x12绑定两步验证*\n" +
+	"EnrollTOTP\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/users/{userID}/totp\x12\x9c\x01\n" +
+	"\vConfirmTOTP\x12\x16.v1.ConfirmTOTPRequest\x1a\x17.v1.ConfirmTOTPResponse\"\\\x92A/\n" +
+	"\f用户管理\x12\x12启用两步验证*\vConfirmTOTP\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/users/{userID}/totp/confirm\x12\x9c\x01\n" +
+	"\vDisableTOTP\x12\x16.v1.DisableTOTPRequest\x1a\x17.v1.DisableTOTPResponse\"\\\x92A/\n" +
+	"\f用户管理\x12\x12停用两步验证*\vDisableTOTP\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/users/{userID}/totp/disable\x12\xd6\x01\n" +
+	"\x17RegenerateRecoveryCodes\x12\".v1.RegenerateRecoveryCodesRequest\x1a#.v1.RegenerateRecoveryCodesResponse\"r\x92A>\n" +
+	"\f用户管理\x12\x15重新生成恢复码*\x17RegenerateRecoveryCodes\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/users/{userID}/totp/recovery-codes\x12|\n" +
+	"\n" +
+	"CreateUser\x12\x15.v1.CreateUserRequest\x1a\x16.v1.CreateUserResponse\"?\x92A(\n" +
+	"\f用户管理\x12\f创建用户*\n" +
+	"CreateUser\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12\x8b\x01\n" +
+	"\n" +
+	"UpdateUser\x12\x15.v1.UpdateUserRequest\x1a\x16.v1.UpdateUserResponse\"N\x92A.\n" +
+	"\f用户管理\x12\x12更新用户信息*\n" +
+	"UpdateUser\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/users/{userID}\x12\x82\x01\n" +
+	"\n" +
+	"DeleteUser\x12\x15.v1.DeleteUserRequest\x1a\x16.v1.DeleteUserResponse\"E\x92A(\n" +
+	"\f用户管理\x12\f删除用户*\n" +
+	"DeleteUser\x82\xd3\xe4\x93\x02\x14*\x12/v1/users/{userID}\x12|\n" +
+	"\aGetUser\x12\x12.v1.GetUserRequest\x1a\x13.v1.GetUserResponse\"H\x92A+\n" +
+	"\f用户管理\x12\x12获取用户信息*\aGetUser\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users/{userID}\x12\x9b\x01\n" +
+	"\x0eExportUserData\x12\x19.v1.ExportUserDataRequest\x1a\x14.google.api.HttpBody\"V\x92A2\n" +
+	"\f用户管理\x12\x12导出用户数据*\x0eExportUserData\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/users/{userID}/export0\x01\x12w\n" +
+	"\bListUser\x12\x13.v1.ListUserRequest\x1a\x14.v1.ListUserResponse\"@\x92A,\n" +
+	"\f用户管理\x12\x12列出所有用户*\bListUser\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12\x97\x01\n" +
+	"\rListTrashUser\x12\x18.v1.ListTrashUserRequest\x1a\x19.v1.ListTrashUserResponse\"Q\x92A7\n" +
+	"\f用户管理\x12\x18列出已注销的用户*\rListTrashUser\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/trash/users\x12\xa3\x01\n" +
+	"\vRestoreUser\x12\x16.v1.RestoreUserRequest\x1a\x17.v1.RestoreUserResponse\"c\x92A5\n" +
+	"\f用户管理\x12\x18恢复已注销的用户*\vRestoreUser\x82\xd3\xe4\x93\x02%:\x01*\" /v1/trash/users/{userID}/restore\x12\x96\x01\n" +
+	"\tPurgeUser\x12\x14.v1.PurgeUserRequest\x1a\x15.v1.PurgeUserResponse\"\\\x92A9\n" +
+	"\f用户管理\x12\x1e彻底删除已注销的用户*\tPurgeUser\x82\xd3\xe4\x93\x02\x1a*\x18/v1/trash/users/{userID}\x12|\n" +
+	"\n" +
+	"CreatePost\x12\x15.v1.CreatePostRequest\x1a\x16.v1.CreatePostResponse\"?\x92A(\n" +
+	"\f博客管理\x12\f创建博客*\n" +
+	"CreatePost\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/posts\x12\x85\x01\n" +
+	"\n" +
+	"UpdatePost\x12\x15.v1.UpdatePostRequest\x1a\x16.v1.UpdatePostResponse\"H\x92A(\n" +
+	"\f博客管理\x12\f更新博客*\n" +
+	"UpdatePost\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/posts/{postID}\x12|\n" +
+	"\n" +
+	"DeletePost\x12\x15.v1.DeletePostRequest\x1a\x16.v1.DeletePostResponse\"?\x92A(\n" +
+	"\f博客管理\x12\f删除博客*\n" +
+	"DeletePost\x82\xd3\xe4\x93\x02\x0e:\x01**\t/v1/posts\x12|\n" +
+	"\aGetPost\x12\x12.v1.GetPostRequest\x1a\x13.v1.GetPostResponse\"H\x92A+\n" +
+	"\f博客管理\x12\x12获取博客详情*\aGetPost\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/posts/{postID}\x12w\n" +
+	"\bListPost\x12\x13.v1.ListPostRequest\x1a\x14.v1.ListPostResponse\"@\x92A,\n" +
+	"\f博客管理\x12\x12获取博客列表*\bListPost\x82\xd3\xe4\x93\x02\v\x12\t/v1/posts\x12\xa0\x01\n" +
+	"\rListTrashPost\x12\x18.v1.ListTrashPostRequest\x1a\x19.v1.ListTrashPostResponse\"Z\x92A@\n" +
+	"\f博客管理\x12!获取回收站中的博客列表*\rListTrashPost\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/trash/posts\x12\xa3\x01\n" +
+	"\vRestorePost\x12\x16.v1.RestorePostRequest\x1a\x17.v1.RestorePostResponse\"c\x92A5\n" +
+	"\f博客管理\x12\x18从回收站恢复博客*\vRestorePost\x82\xd3\xe4\x93\x02%:\x01*\" /v1/trash/posts/{postID}/restore\x12\x93\x01\n" +
+	"\tPurgePost\x12\x14.v1.PurgePostRequest\x1a\x15.v1.PurgePostResponse\"Y\x92A<\n" +
+	"\f博客管理\x12!彻底删除回收站中的博客*\tPurgePost\x82\xd3\xe4\x93\x02\x14:\x01**\x0f/v1/trash/posts\x12\x91\x01\n" +
+	"\vPublishPost\x12\x16.v1.PublishPostRequest\x1a\x17.v1.PublishPostResponse\"Q\x92A)\n" +
+	"\f博客管理\x12\f发布博客*\vPublishPost\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/posts/{postID}/publish\x12\xa1\x01\n" +
+	"\rUnpublishPost\x12\x18.v1.UnpublishPostRequest\x1a\x19.v1.UnpublishPostResponse\"[\x92A1\n" +
+	"\f博客管理\x12\x12取消发布博客*\rUnpublishPost\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/posts/{postID}/unpublish\x12\x91\x01\n" +
+	"\vArchivePost\x12\x16.v1.ArchivePostRequest\x1a\x17.v1.ArchivePostResponse\"Q\x92A)\n" +
+	"\f博客管理\x12\f归档博客*\vArchivePost\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/posts/{postID}/archive\x12\xb6\x01\n" +
+	"\x10ListPostRevision\x12\x1b.v1.ListPostRevisionRequest\x1a\x1c.v1.ListPostRevisionResponse\"g\x92A@\n" +
+	"\f博客管理\x12\x1e获取博客历史版本列表*\x10ListPostRevision\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/posts/{postID}/revisions\x12\xb6\x01\n" +
+	"\x0fGetPostRevision\x12\x1a.v1.GetPostRevisionRequest\x1a\x1b.v1.GetPostRevisionResponse\"j\x92A9\n" +
+	"\f博客管理\x12\x18获取博客历史版本*\x0fGetPostRevision\x82\xd3\xe4\x93\x02(\x12&/v1/posts/{postID}/revisions/{version}\x12\xab\x01\n" +
+	"\x10DiffPostRevision\x12\x1b.v1.DiffPostRevisionRequest\x1a\x1c.v1.DiffPostRevisionResponse\"\\\x92A:\n" +
+	"\f博客管理\x12\x18比较博客历史版本*\x10DiffPostRevision\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/posts/{postID}/diff\x12\xd1\x01\n" +
+	"\x13RestorePostRevision\x12\x1e.v1.RestorePostRevisionRequest\x1a\x1f.v1.RestorePostRevisionResponse\"y\x92A=\n" +
+	"\f博客管理\x12\x18恢复博客历史版本*\x13RestorePostRevision\x82\xd3\xe4\x93\x023:\x01*\"./v1/posts/{postID}/revisions/{version}/restore\x12w\n" +
+	"\tCreateTag\x12\x14.v1.CreateTagRequest\x1a\x15.v1.CreateTagResponse\"=\x92A'\n" +
+	"\f标签管理\x12\f创建标签*\tCreateTag\x82\xd3\xe4\x93\x02\r:\x01*\"\b/v1/tags\x12\x7f\n" +
+	"\tUpdateTag\x12\x14.v1.UpdateTagRequest\x1a\x15.v1.UpdateTagResponse\"E\x92A'\n" +
+	"\f标签管理\x12\f更新标签*\tUpdateTag\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/tags/{tagID}\x12|\n" +
+	"\tDeleteTag\x12\x14.v1.DeleteTagRequest\x1a\x15.v1.DeleteTagResponse\"B\x92A'\n" +
+	"\f标签管理\x12\f删除标签*\tDeleteTag\x82\xd3\xe4\x93\x02\x12*\x10/v1/tags/{tagID}\x12v\n" +
+	"\x06GetTag\x12\x11.v1.GetTagRequest\x1a\x12.v1.GetTagResponse\"E\x92A*\n" +
+	"\f标签管理\x12\x12获取标签详情*\x06GetTag\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/tags/{tagID}\x12r\n" +
+	"\aListTag\x12\x12.v1.ListTagRequest\x1a\x13.v1.ListTagResponse\">\x92A+\n" +
+	"\f标签管理\x12\x12获取标签列表*\aListTag\x82\xd3\xe4\x93\x02\n" +
+	"\x12\b/v1/tags\x12\x84\x01\n" +
+	"\vGetTagCloud\x12\x16.v1.GetTagCloudRequest\x1a\x17.v1.GetTagCloudResponse\"D\x92A,\n" +
+	"\f标签管理\x12\x0f获取标签云*\vGetTagCloud\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/tag-cloud\x12\x91\x01\n" +
+	"\x0eCreateCategory\x12\x19.v1.CreateCategoryRequest\x1a\x1a.v1.CreateCategoryResponse\"H\x92A,\n" +
+	"\f分类管理\x12\f创建分类*\x0eCreateCategory\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/categories\x12\x9e\x01\n" +
+	"\x0eUpdateCategory\x12\x19.v1.UpdateCategoryRequest\x1a\x1a.v1.UpdateCategoryResponse\"U\x92A,\n" +
+	"\f分类管理\x12\f更新分类*\x0eUpdateCategory\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/v1/categories/{categoryID}\x12\x9b\x01\n" +
+	"\x0eDeleteCategory\x12\x19.v1.DeleteCategoryRequest\x1a\x1a.v1.DeleteCategoryResponse\"R\x92A,\n" +
+	"\f分类管理\x12\f删除分类*\x0eDeleteCategory\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/categories/{categoryID}\x12\x95\x01\n" +
+	"\vGetCategory\x12\x16.v1.GetCategoryRequest\x1a\x17.v1.GetCategoryResponse\"U\x92A/\n" +
+	"\f分类管理\x12\x12获取分类详情*\vGetCategory\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/categories/{categoryID}\x12\x8c\x01\n" +
+	"\fListCategory\x12\x17.v1.ListCategoryRequest\x1a\x18.v1.ListCategoryResponse\"I\x92A0\n" +
+	"\f分类管理\x12\x12获取分类列表*\fListCategory\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/categories\x12\x9a\x01\n" +
+	"\rCreateComment\x12\x18.v1.CreateCommentRequest\x1a\x19.v1.CreateCommentResponse\"T\x92A+\n" +
+	"\f评论管理\x12\f发表评论*\rCreateComment\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/posts/{postID}/comments\x12\x97\x01\n" +
+	"\rUpdateComment\x12\x18.v1.UpdateCommentRequest\x1a\x19.v1.UpdateCommentResponse\"Q\x92A+\n" +
+	"\f评论管理\x12\f修改评论*\rUpdateComment\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/v1/comments/{commentID}\x12\x94\x01\n" +
+	"\rDeleteComment\x12\x18.v1.DeleteCommentRequest\x1a\x19.v1.DeleteCommentResponse\"N\x92A+\n" +
+	"\f评论管理\x12\f删除评论*\rDeleteComment\x82\xd3\xe4\x93\x02\x1a*\x18/v1/comments/{commentID}\x12\xa8\x01\n" +
+	"\x0fModerateComment\x12\x1a.v1.ModerateCommentRequest\x1a\x1b.v1.ModerateCommentResponse\"\\\x92A-\n" +
+	"\f评论管理\x12\f审核评论*\x0fModerateComment\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/comments/{commentID}/moderate\x12\x9b\x01\n" +
+	"\vListComment\x12\x16.v1.ListCommentRequest\x1a\x17.v1.ListCommentResponse\"[\x92A5\n" +
+	"\f评论管理\x12\x18获取文章评论列表*\vListComment\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/posts/{postID}/comments\x12\x80\x01\n" +
+	"\n" +
+	"SearchPost\x12\x15.v1.SearchPostRequest\x1a\x16.v1.SearchPostResponse\"C\x92A(\n" +
+	"\f博客管理\x12\f检索博客*\n" +
+	"SearchPost\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/search/posts\x12\xbf\x01\n" +
+	"\x0eListPublicPost\x12\x19.v1.ListPublicPostRequest\x1a\x1a.v1.ListPublicPostResponse\"v\x92A8\n" +
+	"\f公开博客\x12\x18获取公开博客列表*\x0eListPublicPost\x82\xd3\xe4\x93\x025Z!\x12\x1f/v1/public/users/{userID}/posts\x12\x10/v1/public/posts\x12\xa1\x01\n" +
+	"\rGetPublicPost\x12\x18.v1.GetPublicPostRequest\x1a\x19.v1.GetPublicPostResponse\"[\x92A7\n" +
+	"\f公开博客\x12\x18获取公开博客详情*\rGetPublicPost\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/public/posts/{postID}\x12\xba\x01\n" +
+	"\x11ListPublicComment\x12\x1c.v1.ListPublicCommentRequest\x1a\x1d.v1.ListPublicCommentResponse\"h\x92A;\n" +
+	"\f公开博客\x12\x18获取公开评论列表*\x11ListPublicComment\x82\xd3\xe4\x93\x02$\x12\"/v1/public/posts/{postID}/comments\x12\xa5\x01\n" +
+	"\x10SearchPublicPost\x12\x1b.v1.SearchPublicPostRequest\x1a\x1c.v1.SearchPublicPostResponse\"V\x92A4\n" +
+	"\f公开博客\x12\x12检索公开博客*\x10SearchPublicPost\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/public/search/postsB\xb5\x01\x92A|\x12S\n" +
 	"\rfast_blog API\"=\n" +
 	"\x12精简博客项目\x12'https://github.com/loveRyujin/fast_blog2\x031.0*\x01\x022\x10application/json:\x10application/jsonZ4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
 
 var file_apiserver_v1_apiserver_proto_goTypes = []any{
-	(*emptypb.Empty)(nil),   // 0: google.protobuf.Empty
-	(*HealthzResponse)(nil), // 1: v1.HealthzResponse
+	(*emptypb.Empty)(nil),                   // 0: google.protobuf.Empty
+	(*LoginRequest)(nil),                    // 1: v1.LoginRequest
+	(*LoginTOTPRequest)(nil),                // 2: v1.LoginTOTPRequest
+	(*RefreshTokenRequest)(nil),             // 3: v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),                   // 4: v1.LogoutRequest
+	(*ChangePasswordRequest)(nil),           // 5: v1.ChangePasswordRequest
+	(*EnrollTOTPRequest)(nil),               // 6: v1.EnrollTOTPRequest
+	(*ConfirmTOTPRequest)(nil),              // 7: v1.ConfirmTOTPRequest
+	(*DisableTOTPRequest)(nil),              // 8: v1.DisableTOTPRequest
+	(*RegenerateRecoveryCodesRequest)(nil),  // 9: v1.RegenerateRecoveryCodesRequest
+	(*CreateUserRequest)(nil),               // 10: v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),               // 11: v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),               // 12: v1.DeleteUserRequest
+	(*GetUserRequest)(nil),                  // 13: v1.GetUserRequest
+	(*ExportUserDataRequest)(nil),           // 14: v1.ExportUserDataRequest
+	(*ListUserRequest)(nil),                 // 15: v1.ListUserRequest
+	(*ListTrashUserRequest)(nil),            // 16: v1.ListTrashUserRequest
+	(*RestoreUserRequest)(nil),              // 17: v1.RestoreUserRequest
+	(*PurgeUserRequest)(nil),                // 18: v1.PurgeUserRequest
+	(*CreatePostRequest)(nil),               // 19: v1.CreatePostRequest
+	(*UpdatePostRequest)(nil),               // 20: v1.UpdatePostRequest
+	(*DeletePostRequest)(nil),               // 21: v1.DeletePostRequest
+	(*GetPostRequest)(nil),                  // 22: v1.GetPostRequest
+	(*ListPostRequest)(nil),                 // 23: v1.ListPostRequest
+	(*ListTrashPostRequest)(nil),            // 24: v1.ListTrashPostRequest
+	(*RestorePostRequest)(nil),              // 25: v1.RestorePostRequest
+	(*PurgePostRequest)(nil),                // 26: v1.PurgePostRequest
+	(*PublishPostRequest)(nil),              // 27: v1.PublishPostRequest
+	(*UnpublishPostRequest)(nil),            // 28: v1.UnpublishPostRequest
+	(*ArchivePostRequest)(nil),              // 29: v1.ArchivePostRequest
+	(*ListPostRevisionRequest)(nil),         // 30: v1.ListPostRevisionRequest
+	(*GetPostRevisionRequest)(nil),          // 31: v1.GetPostRevisionRequest
+	(*DiffPostRevisionRequest)(nil),         // 32: v1.DiffPostRevisionRequest
+	(*RestorePostRevisionRequest)(nil),      // 33: v1.RestorePostRevisionRequest
+	(*CreateTagRequest)(nil),                // 34: v1.CreateTagRequest
+	(*UpdateTagRequest)(nil),                // 35: v1.UpdateTagRequest
+	(*DeleteTagRequest)(nil),                // 36: v1.DeleteTagRequest
+	(*GetTagRequest)(nil),                   // 37: v1.GetTagRequest
+	(*ListTagRequest)(nil),                  // 38: v1.ListTagRequest
+	(*GetTagCloudRequest)(nil),              // 39: v1.GetTagCloudRequest
+	(*CreateCategoryRequest)(nil),           // 40: v1.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),           // 41: v1.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),           // 42: v1.DeleteCategoryRequest
+	(*GetCategoryRequest)(nil),              // 43: v1.GetCategoryRequest
+	(*ListCategoryRequest)(nil),             // 44: v1.ListCategoryRequest
+	(*CreateCommentRequest)(nil),            // 45: v1.CreateCommentRequest
+	(*UpdateCommentRequest)(nil),            // 46: v1.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),            // 47: v1.DeleteCommentRequest
+	(*ModerateCommentRequest)(nil),          // 48: v1.ModerateCommentRequest
+	(*ListCommentRequest)(nil),              // 49: v1.ListCommentRequest
+	(*SearchPostRequest)(nil),               // 50: v1.SearchPostRequest
+	(*ListPublicPostRequest)(nil),           // 51: v1.ListPublicPostRequest
+	(*GetPublicPostRequest)(nil),            // 52: v1.GetPublicPostRequest
+	(*ListPublicCommentRequest)(nil),        // 53: v1.ListPublicCommentRequest
+	(*SearchPublicPostRequest)(nil),         // 54: v1.SearchPublicPostRequest
+	(*HealthzResponse)(nil),                 // 55: v1.HealthzResponse
+	(*LoginResponse)(nil),                   // 56: v1.LoginResponse
+	(*RefreshTokenResponse)(nil),            // 57: v1.RefreshTokenResponse
+	(*LogoutResponse)(nil),                  // 58: v1.LogoutResponse
+	(*ChangePasswordResponse)(nil),          // 59: v1.ChangePasswordResponse
+	(*EnrollTOTPResponse)(nil),              // 60: v1.EnrollTOTPResponse
+	(*ConfirmTOTPResponse)(nil),             // 61: v1.ConfirmTOTPResponse
+	(*DisableTOTPResponse)(nil),             // 62: v1.DisableTOTPResponse
+	(*RegenerateRecoveryCodesResponse)(nil), // 63: v1.RegenerateRecoveryCodesResponse
+	(*CreateUserResponse)(nil),              // 64: v1.CreateUserResponse
+	(*UpdateUserResponse)(nil),              // 65: v1.UpdateUserResponse
+	(*DeleteUserResponse)(nil),              // 66: v1.DeleteUserResponse
+	(*GetUserResponse)(nil),                 // 67: v1.GetUserResponse
+	(*httpbody.HttpBody)(nil),               // 68: google.api.HttpBody
+	(*ListUserResponse)(nil),                // 69: v1.ListUserResponse
+	(*ListTrashUserResponse)(nil),           // 70: v1.ListTrashUserResponse
+	(*RestoreUserResponse)(nil),             // 71: v1.RestoreUserResponse
+	(*PurgeUserResponse)(nil),               // 72: v1.PurgeUserResponse
+	(*CreatePostResponse)(nil),              // 73: v1.CreatePostResponse
+	(*UpdatePostResponse)(nil),              // 74: v1.UpdatePostResponse
+	(*DeletePostResponse)(nil),              // 75: v1.DeletePostResponse
+	(*GetPostResponse)(nil),                 // 76: v1.GetPostResponse
+	(*ListPostResponse)(nil),                // 77: v1.ListPostResponse
+	(*ListTrashPostResponse)(nil),           // 78: v1.ListTrashPostResponse
+	(*RestorePostResponse)(nil),             // 79: v1.RestorePostResponse
+	(*PurgePostResponse)(nil),               // 80: v1.PurgePostResponse
+	(*PublishPostResponse)(nil),             // 81: v1.PublishPostResponse
+	(*UnpublishPostResponse)(nil),           // 82: v1.UnpublishPostResponse
+	(*ArchivePostResponse)(nil),             // 83: v1.ArchivePostResponse
+	(*ListPostRevisionResponse)(nil),        // 84: v1.ListPostRevisionResponse
+	(*GetPostRevisionResponse)(nil),         // 85: v1.GetPostRevisionResponse
+	(*DiffPostRevisionResponse)(nil),        // 86: v1.DiffPostRevisionResponse
+	(*RestorePostRevisionResponse)(nil),     // 87: v1.RestorePostRevisionResponse
+	(*CreateTagResponse)(nil),               // 88: v1.CreateTagResponse
+	(*UpdateTagResponse)(nil),               // 89: v1.UpdateTagResponse
+	(*DeleteTagResponse)(nil),               // 90: v1.DeleteTagResponse
+	(*GetTagResponse)(nil),                  // 91: v1.GetTagResponse
+	(*ListTagResponse)(nil),                 // 92: v1.ListTagResponse
+	(*GetTagCloudResponse)(nil),             // 93: v1.GetTagCloudResponse
+	(*CreateCategoryResponse)(nil),          // 94: v1.CreateCategoryResponse
+	(*UpdateCategoryResponse)(nil),          // 95: v1.UpdateCategoryResponse
+	(*DeleteCategoryResponse)(nil),          // 96: v1.DeleteCategoryResponse
+	(*GetCategoryResponse)(nil),             // 97: v1.GetCategoryResponse
+	(*ListCategoryResponse)(nil),            // 98: v1.ListCategoryResponse
+	(*CreateCommentResponse)(nil),           // 99: v1.CreateCommentResponse
+	(*UpdateCommentResponse)(nil),           // 100: v1.UpdateCommentResponse
+	(*DeleteCommentResponse)(nil),           // 101: v1.DeleteCommentResponse
+	(*ModerateCommentResponse)(nil),         // 102: v1.ModerateCommentResponse
+	(*ListCommentResponse)(nil),             // 103: v1.ListCommentResponse
+	(*SearchPostResponse)(nil),              // 104: v1.SearchPostResponse
+	(*ListPublicPostResponse)(nil),          // 105: v1.ListPublicPostResponse
+	(*GetPublicPostResponse)(nil),           // 106: v1.GetPublicPostResponse
+	(*ListPublicCommentResponse)(nil),       // 107: v1.ListPublicCommentResponse
+	(*SearchPublicPostResponse)(nil),        // 108: v1.SearchPublicPostResponse
 }
 var file_apiserver_v1_apiserver_proto_depIdxs = []int32{
-	0, // 0: v1.FastBlog.Healthz:input_type -> google.protobuf.Empty
-	1, // 1: v1.FastBlog.Healthz:output_type -> v1.HealthzResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,   // 0: v1.FastBlog.Healthz:input_type -> google.protobuf.Empty
+	1,   // 1: v1.FastBlog.Login:input_type -> v1.LoginRequest
+	2,   // 2: v1.FastBlog.LoginTOTP:input_type -> v1.LoginTOTPRequest
+	3,   // 3: v1.FastBlog.RefreshToken:input_type -> v1.RefreshTokenRequest
+	4,   // 4: v1.FastBlog.Logout:input_type -> v1.LogoutRequest
+	5,   // 5: v1.FastBlog.ChangePassword:input_type -> v1.ChangePasswordRequest
+	6,   // 6: v1.FastBlog.EnrollTOTP:input_type -> v1.EnrollTOTPRequest
+	7,   // 7: v1.FastBlog.ConfirmTOTP:input_type -> v1.ConfirmTOTPRequest
+	8,   // 8: v1.FastBlog.DisableTOTP:input_type -> v1.DisableTOTPRequest
+	9,   // 9: v1.FastBlog.RegenerateRecoveryCodes:input_type -> v1.RegenerateRecoveryCodesRequest
+	10,  // 10: v1.FastBlog.CreateUser:input_type -> v1.CreateUserRequest
+	11,  // 11: v1.FastBlog.UpdateUser:input_type -> v1.UpdateUserRequest
+	12,  // 12: v1.FastBlog.DeleteUser:input_type -> v1.DeleteUserRequest
+	13,  // 13: v1.FastBlog.GetUser:input_type -> v1.GetUserRequest
+	14,  // 14: v1.FastBlog.ExportUserData:input_type -> v1.ExportUserDataRequest
+	15,  // 15: v1.FastBlog.ListUser:input_type -> v1.ListUserRequest
+	16,  // 16: v1.FastBlog.ListTrashUser:input_type -> v1.ListTrashUserRequest
+	17,  // 17: v1.FastBlog.RestoreUser:input_type -> v1.RestoreUserRequest
+	18,  // 18: v1.FastBlog.PurgeUser:input_type -> v1.PurgeUserRequest
+	19,  // 19: v1.FastBlog.CreatePost:input_type -> v1.CreatePostRequest
+	20,  // 20: v1.FastBlog.UpdatePost:input_type -> v1.UpdatePostRequest
+	21,  // 21: v1.FastBlog.DeletePost:input_type -> v1.DeletePostRequest
+	22,  // 22: v1.FastBlog.GetPost:input_type -> v1.GetPostRequest
+	23,  // 23: v1.FastBlog.ListPost:input_type -> v1.ListPostRequest
+	24,  // 24: v1.FastBlog.ListTrashPost:input_type -> v1.ListTrashPostRequest
+	25,  // 25: v1.FastBlog.RestorePost:input_type -> v1.RestorePostRequest
+	26,  // 26: v1.FastBlog.PurgePost:input_type -> v1.PurgePostRequest
+	27,  // 27: v1.FastBlog.PublishPost:input_type -> v1.PublishPostRequest
+	28,  // 28: v1.FastBlog.UnpublishPost:input_type -> v1.UnpublishPostRequest
+	29,  // 29: v1.FastBlog.ArchivePost:input_type -> v1.ArchivePostRequest
+	30,  // 30: v1.FastBlog.ListPostRevision:input_type -> v1.ListPostRevisionRequest
+	31,  // 31: v1.FastBlog.GetPostRevision:input_type -> v1.GetPostRevisionRequest
+	32,  // 32: v1.FastBlog.DiffPostRevision:input_type -> v1.DiffPostRevisionRequest
+	33,  // 33: v1.FastBlog.RestorePostRevision:input_type -> v1.RestorePostRevisionRequest
+	34,  // 34: v1.FastBlog.CreateTag:input_type -> v1.CreateTagRequest
+	35,  // 35: v1.FastBlog.UpdateTag:input_type -> v1.UpdateTagRequest
+	36,  // 36: v1.FastBlog.DeleteTag:input_type -> v1.DeleteTagRequest
+	37,  // 37: v1.FastBlog.GetTag:input_type -> v1.GetTagRequest
+	38,  // 38: v1.FastBlog.ListTag:input_type -> v1.ListTagRequest
+	39,  // 39: v1.FastBlog.GetTagCloud:input_type -> v1.GetTagCloudRequest
+	40,  // 40: v1.FastBlog.CreateCategory:input_type -> v1.CreateCategoryRequest
+	41,  // 41: v1.FastBlog.UpdateCategory:input_type -> v1.UpdateCategoryRequest
+	42,  // 42: v1.FastBlog.DeleteCategory:input_type -> v1.DeleteCategoryRequest
+	43,  // 43: v1.FastBlog.GetCategory:input_type -> v1.GetCategoryRequest
+	44,  // 44: v1.FastBlog.ListCategory:input_type -> v1.ListCategoryRequest
+	45,  // 45: v1.FastBlog.CreateComment:input_type -> v1.CreateCommentRequest
+	46,  // 46: v1.FastBlog.UpdateComment:input_type -> v1.UpdateCommentRequest
+	47,  // 47: v1.FastBlog.DeleteComment:input_type -> v1.DeleteCommentRequest
+	48,  // 48: v1.FastBlog.ModerateComment:input_type -> v1.ModerateCommentRequest
+	49,  // 49: v1.FastBlog.ListComment:input_type -> v1.ListCommentRequest
+	50,  // 50: v1.FastBlog.SearchPost:input_type -> v1.SearchPostRequest
+	51,  // 51: v1.FastBlog.ListPublicPost:input_type -> v1.ListPublicPostRequest
+	52,  // 52: v1.FastBlog.GetPublicPost:input_type -> v1.GetPublicPostRequest
+	53,  // 53: v1.FastBlog.ListPublicComment:input_type -> v1.ListPublicCommentRequest
+	54,  // 54: v1.FastBlog.SearchPublicPost:input_type -> v1.SearchPublicPostRequest
+	55,  // 55: v1.FastBlog.Healthz:output_type -> v1.HealthzResponse
+	56,  // 56: v1.FastBlog.Login:output_type -> v1.LoginResponse
+	56,  // 57: v1.FastBlog.LoginTOTP:output_type -> v1.LoginResponse
+	57,  // 58: v1.FastBlog.RefreshToken:output_type -> v1.RefreshTokenResponse
+	58,  // 59: v1.FastBlog.Logout:output_type -> v1.LogoutResponse
+	59,  // 60: v1.FastBlog.ChangePassword:output_type -> v1.ChangePasswordResponse
+	60,  // 61: v1.FastBlog.EnrollTOTP:output_type -> v1.EnrollTOTPResponse
+	61,  // 62: v1.FastBlog.ConfirmTOTP:output_type -> v1.ConfirmTOTPResponse
+	62,  // 63: v1.FastBlog.DisableTOTP:output_type -> v1.DisableTOTPResponse
+	63,  // 64: v1.FastBlog.RegenerateRecoveryCodes:output_type -> v1.RegenerateRecoveryCodesResponse
+	64,  // 65: v1.FastBlog.CreateUser:output_type -> v1.CreateUserResponse
+	65,  // 66: v1.FastBlog.UpdateUser:output_type -> v1.UpdateUserResponse
+	66,  // 67: v1.FastBlog.DeleteUser:output_type -> v1.DeleteUserResponse
+	67,  // 68: v1.FastBlog.GetUser:output_type -> v1.GetUserResponse
+	68,  // 69: v1.FastBlog.ExportUserData:output_type -> google.api.HttpBody
+	69,  // 70: v1.FastBlog.ListUser:output_type -> v1.ListUserResponse
+	70,  // 71: v1.FastBlog.ListTrashUser:output_type -> v1.ListTrashUserResponse
+	71,  // 72: v1.FastBlog.RestoreUser:output_type -> v1.RestoreUserResponse
+	72,  // 73: v1.FastBlog.PurgeUser:output_type -> v1.PurgeUserResponse
+	73,  // 74: v1.FastBlog.CreatePost:output_type -> v1.CreatePostResponse
+	74,  // 75: v1.FastBlog.UpdatePost:output_type -> v1.UpdatePostResponse
+	75,  // 76: v1.FastBlog.DeletePost:output_type -> v1.DeletePostResponse
+	76,  // 77: v1.FastBlog.GetPost:output_type -> v1.GetPostResponse
+	77,  // 78: v1.FastBlog.ListPost:output_type -> v1.ListPostResponse
+	78,  // 79: v1.FastBlog.ListTrashPost:output_type -> v1.ListTrashPostResponse
+	79,  // 80: v1.FastBlog.RestorePost:output_type -> v1.RestorePostResponse
+	80,  // 81: v1.FastBlog.PurgePost:output_type -> v1.PurgePostResponse
+	81,  // 82: v1.FastBlog.PublishPost:output_type -> v1.PublishPostResponse
+	82,  // 83: v1.FastBlog.UnpublishPost:output_type -> v1.UnpublishPostResponse
+	83,  // 84: v1.FastBlog.ArchivePost:output_type -> v1.ArchivePostResponse
+	84,  // 85: v1.FastBlog.ListPostRevision:output_type -> v1.ListPostRevisionResponse
+	85,  // 86: v1.FastBlog.GetPostRevision:output_type -> v1.GetPostRevisionResponse
+	86,  // 87: v1.FastBlog.DiffPostRevision:output_type -> v1.DiffPostRevisionResponse
+	87,  // 88: v1.FastBlog.RestorePostRevision:output_type -> v1.RestorePostRevisionResponse
+	88,  // 89: v1.FastBlog.CreateTag:output_type -> v1.CreateTagResponse
+	89,  // 90: v1.FastBlog.UpdateTag:output_type -> v1.UpdateTagResponse
+	90,  // 91: v1.FastBlog.DeleteTag:output_type -> v1.DeleteTagResponse
+	91,  // 92: v1.FastBlog.GetTag:output_type -> v1.GetTagResponse
+	92,  // 93: v1.FastBlog.ListTag:output_type -> v1.ListTagResponse
+	93,  // 94: v1.FastBlog.GetTagCloud:output_type -> v1.GetTagCloudResponse
+	94,  // 95: v1.FastBlog.CreateCategory:output_type -> v1.CreateCategoryResponse
+	95,  // 96: v1.FastBlog.UpdateCategory:output_type -> v1.UpdateCategoryResponse
+	96,  // 97: v1.FastBlog.DeleteCategory:output_type -> v1.DeleteCategoryResponse
+	97,  // 98: v1.FastBlog.GetCategory:output_type -> v1.GetCategoryResponse
+	98,  // 99: v1.FastBlog.ListCategory:output_type -> v1.ListCategoryResponse
+	99,  // 100: v1.FastBlog.CreateComment:output_type -> v1.CreateCommentResponse
+	100, // 101: v1.FastBlog.UpdateComment:output_type -> v1.UpdateCommentResponse
+	101, // 102: v1.FastBlog.DeleteComment:output_type -> v1.DeleteCommentResponse
+	102, // 103: v1.FastBlog.ModerateComment:output_type -> v1.ModerateCommentResponse
+	103, // 104: v1.FastBlog.ListComment:output_type -> v1.ListCommentResponse
+	104, // 105: v1.FastBlog.SearchPost:output_type -> v1.SearchPostResponse
+	105, // 106: v1.FastBlog.ListPublicPost:output_type -> v1.ListPublicPostResponse
+	106, // 107: v1.FastBlog.GetPublicPost:output_type -> v1.GetPublicPostResponse
+	107, // 108: v1.FastBlog.ListPublicComment:output_type -> v1.ListPublicCommentResponse
+	108, // 109: v1.FastBlog.SearchPublicPost:output_type -> v1.SearchPublicPostResponse
+	55,  // [55:110] is the sub-list for method output_type
+	0,   // [0:55] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_apiserver_proto_init() }
@@ -55,6 +396,13 @@ func file_apiserver_v1_apiserver_proto_init() {
 		return
 	}
 	file_apiserver_v1_healthz_proto_init()
+	file_apiserver_v1_user_proto_init()
+	file_apiserver_v1_post_proto_init()
+	file_apiserver_v1_post_revision_proto_init()
+	file_apiserver_v1_tag_proto_init()
+	file_apiserver_v1_category_proto_init()
+	file_apiserver_v1_comment_proto_init()
+	file_apiserver_v1_search_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,7 +21,61 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FastBlog_Healthz_FullMethodName = "/v1.FastBlog/Healthz"
+	FastBlog_Healthz_FullMethodName                 = "/v1.FastBlog/Healthz"
+	FastBlog_Login_FullMethodName                   = "/v1.FastBlog/Login"
+	FastBlog_LoginTOTP_FullMethodName               = "/v1.FastBlog/LoginTOTP"
+	FastBlog_RefreshToken_FullMethodName            = "/v1.FastBlog/RefreshToken"
+	FastBlog_Logout_FullMethodName                  = "/v1.FastBlog/Logout"
+	FastBlog_ChangePassword_FullMethodName          = "/v1.FastBlog/ChangePassword"
+	FastBlog_EnrollTOTP_FullMethodName              = "/v1.FastBlog/EnrollTOTP"
+	FastBlog_ConfirmTOTP_FullMethodName             = "/v1.FastBlog/ConfirmTOTP"
+	FastBlog_DisableTOTP_FullMethodName             = "/v1.FastBlog/DisableTOTP"
+	FastBlog_RegenerateRecoveryCodes_FullMethodName = "/v1.FastBlog/RegenerateRecoveryCodes"
+	FastBlog_CreateUser_FullMethodName              = "/v1.FastBlog/CreateUser"
+	FastBlog_UpdateUser_FullMethodName              = "/v1.FastBlog/UpdateUser"
+	FastBlog_DeleteUser_FullMethodName              = "/v1.FastBlog/DeleteUser"
+	FastBlog_GetUser_FullMethodName                 = "/v1.FastBlog/GetUser"
+	FastBlog_ExportUserData_FullMethodName          = "/v1.FastBlog/ExportUserData"
+	FastBlog_ListUser_FullMethodName                = "/v1.FastBlog/ListUser"
+	FastBlog_ListTrashUser_FullMethodName           = "/v1.FastBlog/ListTrashUser"
+	FastBlog_RestoreUser_FullMethodName             = "/v1.FastBlog/RestoreUser"
+	FastBlog_PurgeUser_FullMethodName               = "/v1.FastBlog/PurgeUser"
+	FastBlog_CreatePost_FullMethodName              = "/v1.FastBlog/CreatePost"
+	FastBlog_UpdatePost_FullMethodName              = "/v1.FastBlog/UpdatePost"
+	FastBlog_DeletePost_FullMethodName              = "/v1.FastBlog/DeletePost"
+	FastBlog_GetPost_FullMethodName                 = "/v1.FastBlog/GetPost"
+	FastBlog_ListPost_FullMethodName                = "/v1.FastBlog/ListPost"
+	FastBlog_ListTrashPost_FullMethodName           = "/v1.FastBlog/ListTrashPost"
+	FastBlog_RestorePost_FullMethodName             = "/v1.FastBlog/RestorePost"
+	FastBlog_PurgePost_FullMethodName               = "/v1.FastBlog/PurgePost"
+	FastBlog_PublishPost_FullMethodName             = "/v1.FastBlog/PublishPost"
+	FastBlog_UnpublishPost_FullMethodName           = "/v1.FastBlog/UnpublishPost"
+	FastBlog_ArchivePost_FullMethodName             = "/v1.FastBlog/ArchivePost"
+	FastBlog_ListPostRevision_FullMethodName        = "/v1.FastBlog/ListPostRevision"
+	FastBlog_GetPostRevision_FullMethodName         = "/v1.FastBlog/GetPostRevision"
+	FastBlog_DiffPostRevision_FullMethodName        = "/v1.FastBlog/DiffPostRevision"
+	FastBlog_RestorePostRevision_FullMethodName     = "/v1.FastBlog/RestorePostRevision"
+	FastBlog_CreateTag_FullMethodName               = "/v1.FastBlog/CreateTag"
+	FastBlog_UpdateTag_FullMethodName               = "/v1.FastBlog/UpdateTag"
+	FastBlog_DeleteTag_FullMethodName               = "/v1.FastBlog/DeleteTag"
+	FastBlog_GetTag_FullMethodName                  = "/v1.FastBlog/GetTag"
+	FastBlog_ListTag_FullMethodName                 = "/v1.FastBlog/ListTag"
+	FastBlog_GetTagCloud_FullMethodName             = "/v1.FastBlog/GetTagCloud"
+	FastBlog_CreateCategory_FullMethodName          = "/v1.FastBlog/CreateCategory"
+	FastBlog_UpdateCategory_FullMethodName          = "/v1.FastBlog/UpdateCategory"
+	FastBlog_DeleteCategory_FullMethodName          = "/v1.FastBlog/DeleteCategory"
+	FastBlog_GetCategory_FullMethodName             = "/v1.FastBlog/GetCategory"
+	FastBlog_ListCategory_FullMethodName            = "/v1.FastBlog/ListCategory"
+	FastBlog_CreateComment_FullMethodName           = "/v1.FastBlog/CreateComment"
+	FastBlog_UpdateComment_FullMethodName           = "/v1.FastBlog/UpdateComment"
+	FastBlog_DeleteComment_FullMethodName           = "/v1.FastBlog/DeleteComment"
+	FastBlog_ModerateComment_FullMethodName         = "/v1.FastBlog/ModerateComment"
+	FastBlog_ListComment_FullMethodName             = "/v1.FastBlog/ListComment"
+	FastBlog_SearchPost_FullMethodName              = "/v1.FastBlog/SearchPost"
+	FastBlog_ListPublicPost_FullMethodName          = "/v1.FastBlog/ListPublicPost"
+	FastBlog_GetPublicPost_FullMethodName           = "/v1.FastBlog/GetPublicPost"
+	FastBlog_ListPublicComment_FullMethodName       = "/v1.FastBlog/ListPublicComment"
+	FastBlog_SearchPublicPost_FullMethodName        = "/v1.FastBlog/SearchPublicPost"
 )
 
 // FastBlogClient is the client API for FastBlog service.
@@ -31,6 +86,114 @@ const (
 type FastBlogClient interface {
 	// Healthz 健康检查
 	Healthz(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthzResponse, error)
+	// Login 用户登录
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// LoginTOTP 使用挑战令牌和两步验证码完成登录
+	LoginTOTP(ctx context.Context, in *LoginTOTPRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// RefreshToken 刷新令牌
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Logout 退出登录，撤销当前登录会话的所有令牌
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// ChangePassword 修改密码
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// EnrollTOTP 绑定两步验证，返回 TOTP 密钥，确认后才会启用
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	// ConfirmTOTP 使用验证码确认并启用两步验证，返回恢复码
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	// DisableTOTP 停用两步验证
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	// RegenerateRecoveryCodes 重新生成两步验证的恢复码
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error)
+	// CreateUser 创建用户
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	// UpdateUser 更新用户信息
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	// DeleteUser 删除用户
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// GetUser 获取用户信息
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// ExportUserData 导出用户的个人资料和博文，以 ZIP 或 JSON 文件流式返回
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
+	// ListUser 列出所有用户
+	ListUser(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
+	// ListTrashUser 列出已注销、等待彻底删除的用户
+	ListTrashUser(ctx context.Context, in *ListTrashUserRequest, opts ...grpc.CallOption) (*ListTrashUserResponse, error)
+	// RestoreUser 恢复已注销的用户
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	// PurgeUser 彻底删除已注销的用户及其拥有的所有资源
+	PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*PurgeUserResponse, error)
+	// CreatePost 创建博客
+	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
+	// UpdatePost 更新博客
+	UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error)
+	// DeletePost 删除博客
+	DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error)
+	// GetPost 获取博客详情
+	GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error)
+	// ListPost 获取博客列表
+	ListPost(ctx context.Context, in *ListPostRequest, opts ...grpc.CallOption) (*ListPostResponse, error)
+	// ListTrashPost 获取回收站中的博客列表
+	ListTrashPost(ctx context.Context, in *ListTrashPostRequest, opts ...grpc.CallOption) (*ListTrashPostResponse, error)
+	// RestorePost 从回收站恢复博客
+	RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error)
+	// PurgePost 彻底删除回收站中的博客
+	PurgePost(ctx context.Context, in *PurgePostRequest, opts ...grpc.CallOption) (*PurgePostResponse, error)
+	// PublishPost 发布博客，支持定时发布
+	PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*PublishPostResponse, error)
+	// UnpublishPost 取消发布博客，博客恢复为草稿
+	UnpublishPost(ctx context.Context, in *UnpublishPostRequest, opts ...grpc.CallOption) (*UnpublishPostResponse, error)
+	// ArchivePost 归档博客
+	ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*ArchivePostResponse, error)
+	// ListPostRevision 获取博客的历史版本列表
+	ListPostRevision(ctx context.Context, in *ListPostRevisionRequest, opts ...grpc.CallOption) (*ListPostRevisionResponse, error)
+	// GetPostRevision 获取博客的指定历史版本
+	GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*GetPostRevisionResponse, error)
+	// DiffPostRevision 按行比较博客的两个版本
+	DiffPostRevision(ctx context.Context, in *DiffPostRevisionRequest, opts ...grpc.CallOption) (*DiffPostRevisionResponse, error)
+	// RestorePostRevision 将博客恢复到指定的历史版本
+	RestorePostRevision(ctx context.Context, in *RestorePostRevisionRequest, opts ...grpc.CallOption) (*RestorePostRevisionResponse, error)
+	// CreateTag 创建标签
+	CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error)
+	// UpdateTag 更新标签
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	// DeleteTag 删除标签
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
+	// GetTag 获取标签详情
+	GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error)
+	// ListTag 获取标签列表
+	ListTag(ctx context.Context, in *ListTagRequest, opts ...grpc.CallOption) (*ListTagResponse, error)
+	// GetTagCloud 获取标签云，返回每个标签下的文章数量
+	GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...grpc.CallOption) (*GetTagCloudResponse, error)
+	// CreateCategory 创建分类
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	// UpdateCategory 更新分类
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	// DeleteCategory 删除分类
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// GetCategory 获取分类详情
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
+	// ListCategory 获取分类列表
+	ListCategory(ctx context.Context, in *ListCategoryRequest, opts ...grpc.CallOption) (*ListCategoryResponse, error)
+	// CreateComment 发表评论
+	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
+	// UpdateComment 修改评论
+	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error)
+	// DeleteComment 删除评论
+	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error)
+	// ModerateComment 审核评论
+	ModerateComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*ModerateCommentResponse, error)
+	// ListComment 获取文章评论列表
+	ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error)
+	// SearchPost 全文检索当前用户的博客
+	SearchPost(ctx context.Context, in *SearchPostRequest, opts ...grpc.CallOption) (*SearchPostResponse, error)
+	// ListPublicPost 匿名获取公开博客列表
+	ListPublicPost(ctx context.Context, in *ListPublicPostRequest, opts ...grpc.CallOption) (*ListPublicPostResponse, error)
+	// GetPublicPost 匿名获取公开博客详情
+	GetPublicPost(ctx context.Context, in *GetPublicPostRequest, opts ...grpc.CallOption) (*GetPublicPostResponse, error)
+	// ListPublicComment 获取公开评论列表
+	ListPublicComment(ctx context.Context, in *ListPublicCommentRequest, opts ...grpc.CallOption) (*ListPublicCommentResponse, error)
+	// SearchPublicPost 匿名全文检索公开博客
+	SearchPublicPost(ctx context.Context, in *SearchPublicPostRequest, opts ...grpc.CallOption) (*SearchPublicPostResponse, error)
 }
 
 type fastBlogClient struct {
@@ -51,6 +214,555 @@ func (c *fastBlogClient) Healthz(ctx context.Context, in *emptypb.Empty, opts ..
 	return out, nil
 }
 
+func (c *fastBlogClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, FastBlog_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) LoginTOTP(ctx context.Context, in *LoginTOTPRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, FastBlog_LoginTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, FastBlog_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, FastBlog_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, FastBlog_EnrollTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ConfirmTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTOTPResponse)
+	err := c.cc.Invoke(ctx, FastBlog_DisableTOTP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, FastBlog_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FastBlog_ServiceDesc.Streams[0], FastBlog_ExportUserData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUserDataRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FastBlog_ExportUserDataClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
+func (c *fastBlogClient) ListUser(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListTrashUser(ctx context.Context, in *ListTrashUserRequest, opts ...grpc.CallOption) (*ListTrashUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListTrashUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) PurgeUser(ctx context.Context, in *PurgeUserRequest, opts ...grpc.CallOption) (*PurgeUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeUserResponse)
+	err := c.cc.Invoke(ctx, FastBlog_PurgeUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_CreatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) UpdatePost(ctx context.Context, in *UpdatePostRequest, opts ...grpc.CallOption) (*UpdatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_UpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) DeletePost(ctx context.Context, in *DeletePostRequest, opts ...grpc.CallOption) (*DeletePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) GetPost(ctx context.Context, in *GetPostRequest, opts ...grpc.CallOption) (*GetPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_GetPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListPost(ctx context.Context, in *ListPostRequest, opts ...grpc.CallOption) (*ListPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListTrashPost(ctx context.Context, in *ListTrashPostRequest, opts ...grpc.CallOption) (*ListTrashPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListTrashPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) RestorePost(ctx context.Context, in *RestorePostRequest, opts ...grpc.CallOption) (*RestorePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) PurgePost(ctx context.Context, in *PurgePostRequest, opts ...grpc.CallOption) (*PurgePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgePostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_PurgePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) PublishPost(ctx context.Context, in *PublishPostRequest, opts ...grpc.CallOption) (*PublishPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_PublishPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) UnpublishPost(ctx context.Context, in *UnpublishPostRequest, opts ...grpc.CallOption) (*UnpublishPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_UnpublishPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ArchivePost(ctx context.Context, in *ArchivePostRequest, opts ...grpc.CallOption) (*ArchivePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ArchivePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListPostRevision(ctx context.Context, in *ListPostRevisionRequest, opts ...grpc.CallOption) (*ListPostRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPostRevisionResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListPostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) GetPostRevision(ctx context.Context, in *GetPostRevisionRequest, opts ...grpc.CallOption) (*GetPostRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostRevisionResponse)
+	err := c.cc.Invoke(ctx, FastBlog_GetPostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) DiffPostRevision(ctx context.Context, in *DiffPostRevisionRequest, opts ...grpc.CallOption) (*DiffPostRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffPostRevisionResponse)
+	err := c.cc.Invoke(ctx, FastBlog_DiffPostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) RestorePostRevision(ctx context.Context, in *RestorePostRevisionRequest, opts ...grpc.CallOption) (*RestorePostRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestorePostRevisionResponse)
+	err := c.cc.Invoke(ctx, FastBlog_RestorePostRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) CreateTag(ctx context.Context, in *CreateTagRequest, opts ...grpc.CallOption) (*CreateTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTagResponse)
+	err := c.cc.Invoke(ctx, FastBlog_CreateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, FastBlog_UpdateTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTagResponse)
+	err := c.cc.Invoke(ctx, FastBlog_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) GetTag(ctx context.Context, in *GetTagRequest, opts ...grpc.CallOption) (*GetTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagResponse)
+	err := c.cc.Invoke(ctx, FastBlog_GetTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListTag(ctx context.Context, in *ListTagRequest, opts ...grpc.CallOption) (*ListTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) GetTagCloud(ctx context.Context, in *GetTagCloudRequest, opts ...grpc.CallOption) (*GetTagCloudResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTagCloudResponse)
+	err := c.cc.Invoke(ctx, FastBlog_GetTagCloud_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, FastBlog_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, FastBlog_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, FastBlog_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryResponse)
+	err := c.cc.Invoke(ctx, FastBlog_GetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListCategory(ctx context.Context, in *ListCategoryRequest, opts ...grpc.CallOption) (*ListCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommentResponse)
+	err := c.cc.Invoke(ctx, FastBlog_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*UpdateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCommentResponse)
+	err := c.cc.Invoke(ctx, FastBlog_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*DeleteCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCommentResponse)
+	err := c.cc.Invoke(ctx, FastBlog_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ModerateComment(ctx context.Context, in *ModerateCommentRequest, opts ...grpc.CallOption) (*ModerateCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateCommentResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ModerateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListComment(ctx context.Context, in *ListCommentRequest, opts ...grpc.CallOption) (*ListCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) SearchPost(ctx context.Context, in *SearchPostRequest, opts ...grpc.CallOption) (*SearchPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_SearchPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListPublicPost(ctx context.Context, in *ListPublicPostRequest, opts ...grpc.CallOption) (*ListPublicPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPublicPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListPublicPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) GetPublicPost(ctx context.Context, in *GetPublicPostRequest, opts ...grpc.CallOption) (*GetPublicPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_GetPublicPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) ListPublicComment(ctx context.Context, in *ListPublicCommentRequest, opts ...grpc.CallOption) (*ListPublicCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPublicCommentResponse)
+	err := c.cc.Invoke(ctx, FastBlog_ListPublicComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fastBlogClient) SearchPublicPost(ctx context.Context, in *SearchPublicPostRequest, opts ...grpc.CallOption) (*SearchPublicPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPublicPostResponse)
+	err := c.cc.Invoke(ctx, FastBlog_SearchPublicPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FastBlogServer is the server API for FastBlog service.
 // All implementations must embed UnimplementedFastBlogServer
 // for forward compatibility.
@@ -59,54 +771,1289 @@ func (c *fastBlogClient) Healthz(ctx context.Context, in *emptypb.Empty, opts ..
 type FastBlogServer interface {
 	// Healthz 健康检查
 	Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error)
+	// Login 用户登录
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// LoginTOTP 使用挑战令牌和两步验证码完成登录
+	LoginTOTP(context.Context, *LoginTOTPRequest) (*LoginResponse, error)
+	// RefreshToken 刷新令牌
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Logout 退出登录，撤销当前登录会话的所有令牌
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// ChangePassword 修改密码
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// EnrollTOTP 绑定两步验证，返回 TOTP 密钥，确认后才会启用
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	// ConfirmTOTP 使用验证码确认并启用两步验证，返回恢复码
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	// DisableTOTP 停用两步验证
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	// RegenerateRecoveryCodes 重新生成两步验证的恢复码
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error)
+	// CreateUser 创建用户
+	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	// UpdateUser 更新用户信息
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// DeleteUser 删除用户
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// GetUser 获取用户信息
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// ExportUserData 导出用户的个人资料和博文，以 ZIP 或 JSON 文件流式返回
+	ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
+	// ListUser 列出所有用户
+	ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error)
+	// ListTrashUser 列出已注销、等待彻底删除的用户
+	ListTrashUser(context.Context, *ListTrashUserRequest) (*ListTrashUserResponse, error)
+	// RestoreUser 恢复已注销的用户
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
+	// PurgeUser 彻底删除已注销的用户及其拥有的所有资源
+	PurgeUser(context.Context, *PurgeUserRequest) (*PurgeUserResponse, error)
+	// CreatePost 创建博客
+	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
+	// UpdatePost 更新博客
+	UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error)
+	// DeletePost 删除博客
+	DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error)
+	// GetPost 获取博客详情
+	GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error)
+	// ListPost 获取博客列表
+	ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error)
+	// ListTrashPost 获取回收站中的博客列表
+	ListTrashPost(context.Context, *ListTrashPostRequest) (*ListTrashPostResponse, error)
+	// RestorePost 从回收站恢复博客
+	RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error)
+	// PurgePost 彻底删除回收站中的博客
+	PurgePost(context.Context, *PurgePostRequest) (*PurgePostResponse, error)
+	// PublishPost 发布博客，支持定时发布
+	PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error)
+	// UnpublishPost 取消发布博客，博客恢复为草稿
+	UnpublishPost(context.Context, *UnpublishPostRequest) (*UnpublishPostResponse, error)
+	// ArchivePost 归档博客
+	ArchivePost(context.Context, *ArchivePostRequest) (*ArchivePostResponse, error)
+	// ListPostRevision 获取博客的历史版本列表
+	ListPostRevision(context.Context, *ListPostRevisionRequest) (*ListPostRevisionResponse, error)
+	// GetPostRevision 获取博客的指定历史版本
+	GetPostRevision(context.Context, *GetPostRevisionRequest) (*GetPostRevisionResponse, error)
+	// DiffPostRevision 按行比较博客的两个版本
+	DiffPostRevision(context.Context, *DiffPostRevisionRequest) (*DiffPostRevisionResponse, error)
+	// RestorePostRevision 将博客恢复到指定的历史版本
+	RestorePostRevision(context.Context, *RestorePostRevisionRequest) (*RestorePostRevisionResponse, error)
+	// CreateTag 创建标签
+	CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error)
+	// UpdateTag 更新标签
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	// DeleteTag 删除标签
+	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
+	// GetTag 获取标签详情
+	GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error)
+	// ListTag 获取标签列表
+	ListTag(context.Context, *ListTagRequest) (*ListTagResponse, error)
+	// GetTagCloud 获取标签云，返回每个标签下的文章数量
+	GetTagCloud(context.Context, *GetTagCloudRequest) (*GetTagCloudResponse, error)
+	// CreateCategory 创建分类
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	// UpdateCategory 更新分类
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	// DeleteCategory 删除分类
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// GetCategory 获取分类详情
+	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
+	// ListCategory 获取分类列表
+	ListCategory(context.Context, *ListCategoryRequest) (*ListCategoryResponse, error)
+	// CreateComment 发表评论
+	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
+	// UpdateComment 修改评论
+	UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error)
+	// DeleteComment 删除评论
+	DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error)
+	// ModerateComment 审核评论
+	ModerateComment(context.Context, *ModerateCommentRequest) (*ModerateCommentResponse, error)
+	// ListComment 获取文章评论列表
+	ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error)
+	// SearchPost 全文检索当前用户的博客
+	SearchPost(context.Context, *SearchPostRequest) (*SearchPostResponse, error)
+	// ListPublicPost 匿名获取公开博客列表
+	ListPublicPost(context.Context, *ListPublicPostRequest) (*ListPublicPostResponse, error)
+	// GetPublicPost 匿名获取公开博客详情
+	GetPublicPost(context.Context, *GetPublicPostRequest) (*GetPublicPostResponse, error)
+	// ListPublicComment 获取公开评论列表
+	ListPublicComment(context.Context, *ListPublicCommentRequest) (*ListPublicCommentResponse, error)
+	// SearchPublicPost 匿名全文检索公开博客
+	SearchPublicPost(context.Context, *SearchPublicPostRequest) (*SearchPublicPostResponse, error)
+	mustEmbedUnimplementedFastBlogServer()
+}
+
+// UnimplementedFastBlogServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFastBlogServer struct{}
+
+func (UnimplementedFastBlogServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+}
+func (UnimplementedFastBlogServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedFastBlogServer) LoginTOTP(context.Context, *LoginTOTPRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginTOTP not implemented")
+}
+func (UnimplementedFastBlogServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedFastBlogServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedFastBlogServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedFastBlogServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedFastBlogServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedFastBlogServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedFastBlogServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesRequest) (*RegenerateRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedFastBlogServer) CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedFastBlogServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedFastBlogServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedFastBlogServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedFastBlogServer) ExportUserData(*ExportUserDataRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedFastBlogServer) ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUser not implemented")
+}
+func (UnimplementedFastBlogServer) ListTrashUser(context.Context, *ListTrashUserRequest) (*ListTrashUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashUser not implemented")
+}
+func (UnimplementedFastBlogServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedFastBlogServer) PurgeUser(context.Context, *PurgeUserRequest) (*PurgeUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUser not implemented")
+}
+func (UnimplementedFastBlogServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedFastBlogServer) UpdatePost(context.Context, *UpdatePostRequest) (*UpdatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedFastBlogServer) DeletePost(context.Context, *DeletePostRequest) (*DeletePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedFastBlogServer) GetPost(context.Context, *GetPostRequest) (*GetPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedFastBlogServer) ListPost(context.Context, *ListPostRequest) (*ListPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
+}
+func (UnimplementedFastBlogServer) ListTrashPost(context.Context, *ListTrashPostRequest) (*ListTrashPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashPost not implemented")
+}
+func (UnimplementedFastBlogServer) RestorePost(context.Context, *RestorePostRequest) (*RestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedFastBlogServer) PurgePost(context.Context, *PurgePostRequest) (*PurgePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgePost not implemented")
+}
+func (UnimplementedFastBlogServer) PublishPost(context.Context, *PublishPostRequest) (*PublishPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
+}
+func (UnimplementedFastBlogServer) UnpublishPost(context.Context, *UnpublishPostRequest) (*UnpublishPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishPost not implemented")
+}
+func (UnimplementedFastBlogServer) ArchivePost(context.Context, *ArchivePostRequest) (*ArchivePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePost not implemented")
+}
+func (UnimplementedFastBlogServer) ListPostRevision(context.Context, *ListPostRevisionRequest) (*ListPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostRevision not implemented")
+}
+func (UnimplementedFastBlogServer) GetPostRevision(context.Context, *GetPostRevisionRequest) (*GetPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevision not implemented")
+}
+func (UnimplementedFastBlogServer) DiffPostRevision(context.Context, *DiffPostRevisionRequest) (*DiffPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffPostRevision not implemented")
+}
+func (UnimplementedFastBlogServer) RestorePostRevision(context.Context, *RestorePostRevisionRequest) (*RestorePostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePostRevision not implemented")
+}
+func (UnimplementedFastBlogServer) CreateTag(context.Context, *CreateTagRequest) (*CreateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedFastBlogServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
+func (UnimplementedFastBlogServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedFastBlogServer) GetTag(context.Context, *GetTagRequest) (*GetTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
+func (UnimplementedFastBlogServer) ListTag(context.Context, *ListTagRequest) (*ListTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTag not implemented")
+}
+func (UnimplementedFastBlogServer) GetTagCloud(context.Context, *GetTagCloudRequest) (*GetTagCloudResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagCloud not implemented")
+}
+func (UnimplementedFastBlogServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedFastBlogServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedFastBlogServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedFastBlogServer) GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedFastBlogServer) ListCategory(context.Context, *ListCategoryRequest) (*ListCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategory not implemented")
+}
+func (UnimplementedFastBlogServer) CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedFastBlogServer) UpdateComment(context.Context, *UpdateCommentRequest) (*UpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedFastBlogServer) DeleteComment(context.Context, *DeleteCommentRequest) (*DeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedFastBlogServer) ModerateComment(context.Context, *ModerateCommentRequest) (*ModerateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateComment not implemented")
+}
+func (UnimplementedFastBlogServer) ListComment(context.Context, *ListCommentRequest) (*ListCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComment not implemented")
+}
+func (UnimplementedFastBlogServer) SearchPost(context.Context, *SearchPostRequest) (*SearchPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPost not implemented")
+}
+func (UnimplementedFastBlogServer) ListPublicPost(context.Context, *ListPublicPostRequest) (*ListPublicPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicPost not implemented")
+}
+func (UnimplementedFastBlogServer) GetPublicPost(context.Context, *GetPublicPostRequest) (*GetPublicPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicPost not implemented")
+}
+func (UnimplementedFastBlogServer) ListPublicComment(context.Context, *ListPublicCommentRequest) (*ListPublicCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicComment not implemented")
+}
+func (UnimplementedFastBlogServer) SearchPublicPost(context.Context, *SearchPublicPostRequest) (*SearchPublicPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPublicPost not implemented")
+}
+func (UnimplementedFastBlogServer) mustEmbedUnimplementedFastBlogServer() {}
+func (UnimplementedFastBlogServer) testEmbeddedByValue()                  {}
+
+// UnsafeFastBlogServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FastBlogServer will
+// result in compilation errors.
+type UnsafeFastBlogServer interface {
 	mustEmbedUnimplementedFastBlogServer()
 }
 
-// UnimplementedFastBlogServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedFastBlogServer struct{}
+func RegisterFastBlogServer(s grpc.ServiceRegistrar, srv FastBlogServer) {
+	// If the following call pancis, it indicates UnimplementedFastBlogServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FastBlog_ServiceDesc, srv)
+}
+
+func _FastBlog_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).Healthz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_Healthz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).Healthz(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_LoginTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).LoginTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_LoginTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).LoginTOTP(ctx, req.(*LoginTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_EnrollTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ConfirmTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_DisableTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FastBlogServer).ExportUserData(m, &grpc.GenericServerStream[ExportUserDataRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FastBlog_ExportUserDataServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
+func _FastBlog_ListUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListUser(ctx, req.(*ListUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListTrashUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListTrashUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListTrashUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListTrashUser(ctx, req.(*ListTrashUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_PurgeUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).PurgeUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_PurgeUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).PurgeUser(ctx, req.(*PurgeUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).CreatePost(ctx, req.(*CreatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).UpdatePost(ctx, req.(*UpdatePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).DeletePost(ctx, req.(*DeletePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).GetPost(ctx, req.(*GetPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListPost(ctx, req.(*ListPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListTrashPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListTrashPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListTrashPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListTrashPost(ctx, req.(*ListTrashPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).RestorePost(ctx, req.(*RestorePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_PurgePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).PurgePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_PurgePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).PurgePost(ctx, req.(*PurgePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_PublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).PublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_PublishPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).PublishPost(ctx, req.(*PublishPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_UnpublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).UnpublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_UnpublishPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).UnpublishPost(ctx, req.(*UnpublishPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ArchivePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ArchivePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ArchivePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ArchivePost(ctx, req.(*ArchivePostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListPostRevision(ctx, req.(*ListPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_GetPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).GetPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_GetPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).GetPostRevision(ctx, req.(*GetPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_DiffPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).DiffPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_DiffPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).DiffPostRevision(ctx, req.(*DiffPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_RestorePostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestorePostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).RestorePostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_RestorePostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).RestorePostRevision(ctx, req.(*RestorePostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_CreateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).CreateTag(ctx, req.(*CreateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).DeleteTag(ctx, req.(*DeleteTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).GetTag(ctx, req.(*GetTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListTag(ctx, req.(*ListTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_GetTagCloud_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagCloudRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).GetTagCloud(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_GetTagCloud_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).GetTagCloud(ctx, req.(*GetTagCloudRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListCategory(ctx, req.(*ListCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).CreateComment(ctx, req.(*CreateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedFastBlogServer) Healthz(context.Context, *emptypb.Empty) (*HealthzResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Healthz not implemented")
+func _FastBlog_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).UpdateComment(ctx, req.(*UpdateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedFastBlogServer) mustEmbedUnimplementedFastBlogServer() {}
-func (UnimplementedFastBlogServer) testEmbeddedByValue()                  {}
 
-// UnsafeFastBlogServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to FastBlogServer will
-// result in compilation errors.
-type UnsafeFastBlogServer interface {
-	mustEmbedUnimplementedFastBlogServer()
+func _FastBlog_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).DeleteComment(ctx, req.(*DeleteCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterFastBlogServer(s grpc.ServiceRegistrar, srv FastBlogServer) {
-	// If the following call pancis, it indicates UnimplementedFastBlogServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _FastBlog_ModerateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&FastBlog_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(FastBlogServer).ModerateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ModerateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ModerateComment(ctx, req.(*ModerateCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _FastBlog_Healthz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _FastBlog_ListComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(FastBlogServer).Healthz(ctx, in)
+		return srv.(FastBlogServer).ListComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: FastBlog_Healthz_FullMethodName,
+		FullMethod: FastBlog_ListComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(FastBlogServer).Healthz(ctx, req.(*emptypb.Empty))
+		return srv.(FastBlogServer).ListComment(ctx, req.(*ListCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_SearchPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).SearchPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_SearchPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).SearchPost(ctx, req.(*SearchPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListPublicPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListPublicPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListPublicPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListPublicPost(ctx, req.(*ListPublicPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_GetPublicPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).GetPublicPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_GetPublicPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).GetPublicPost(ctx, req.(*GetPublicPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_ListPublicComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).ListPublicComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_ListPublicComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).ListPublicComment(ctx, req.(*ListPublicCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FastBlog_SearchPublicPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPublicPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FastBlogServer).SearchPublicPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FastBlog_SearchPublicPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FastBlogServer).SearchPublicPost(ctx, req.(*SearchPublicPostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -122,7 +2069,225 @@ var FastBlog_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Healthz",
 			Handler:    _FastBlog_Healthz_Handler,
 		},
+		{
+			MethodName: "Login",
+			Handler:    _FastBlog_Login_Handler,
+		},
+		{
+			MethodName: "LoginTOTP",
+			Handler:    _FastBlog_LoginTOTP_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _FastBlog_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _FastBlog_Logout_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _FastBlog_ChangePassword_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _FastBlog_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _FastBlog_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _FastBlog_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _FastBlog_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "CreateUser",
+			Handler:    _FastBlog_CreateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _FastBlog_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _FastBlog_DeleteUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _FastBlog_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUser",
+			Handler:    _FastBlog_ListUser_Handler,
+		},
+		{
+			MethodName: "ListTrashUser",
+			Handler:    _FastBlog_ListTrashUser_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _FastBlog_RestoreUser_Handler,
+		},
+		{
+			MethodName: "PurgeUser",
+			Handler:    _FastBlog_PurgeUser_Handler,
+		},
+		{
+			MethodName: "CreatePost",
+			Handler:    _FastBlog_CreatePost_Handler,
+		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _FastBlog_UpdatePost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _FastBlog_DeletePost_Handler,
+		},
+		{
+			MethodName: "GetPost",
+			Handler:    _FastBlog_GetPost_Handler,
+		},
+		{
+			MethodName: "ListPost",
+			Handler:    _FastBlog_ListPost_Handler,
+		},
+		{
+			MethodName: "ListTrashPost",
+			Handler:    _FastBlog_ListTrashPost_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _FastBlog_RestorePost_Handler,
+		},
+		{
+			MethodName: "PurgePost",
+			Handler:    _FastBlog_PurgePost_Handler,
+		},
+		{
+			MethodName: "PublishPost",
+			Handler:    _FastBlog_PublishPost_Handler,
+		},
+		{
+			MethodName: "UnpublishPost",
+			Handler:    _FastBlog_UnpublishPost_Handler,
+		},
+		{
+			MethodName: "ArchivePost",
+			Handler:    _FastBlog_ArchivePost_Handler,
+		},
+		{
+			MethodName: "ListPostRevision",
+			Handler:    _FastBlog_ListPostRevision_Handler,
+		},
+		{
+			MethodName: "GetPostRevision",
+			Handler:    _FastBlog_GetPostRevision_Handler,
+		},
+		{
+			MethodName: "DiffPostRevision",
+			Handler:    _FastBlog_DiffPostRevision_Handler,
+		},
+		{
+			MethodName: "RestorePostRevision",
+			Handler:    _FastBlog_RestorePostRevision_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _FastBlog_CreateTag_Handler,
+		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _FastBlog_UpdateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _FastBlog_DeleteTag_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _FastBlog_GetTag_Handler,
+		},
+		{
+			MethodName: "ListTag",
+			Handler:    _FastBlog_ListTag_Handler,
+		},
+		{
+			MethodName: "GetTagCloud",
+			Handler:    _FastBlog_GetTagCloud_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _FastBlog_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _FastBlog_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _FastBlog_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _FastBlog_GetCategory_Handler,
+		},
+		{
+			MethodName: "ListCategory",
+			Handler:    _FastBlog_ListCategory_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _FastBlog_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _FastBlog_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _FastBlog_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ModerateComment",
+			Handler:    _FastBlog_ModerateComment_Handler,
+		},
+		{
+			MethodName: "ListComment",
+			Handler:    _FastBlog_ListComment_Handler,
+		},
+		{
+			MethodName: "SearchPost",
+			Handler:    _FastBlog_SearchPost_Handler,
+		},
+		{
+			MethodName: "ListPublicPost",
+			Handler:    _FastBlog_ListPublicPost_Handler,
+		},
+		{
+			MethodName: "GetPublicPost",
+			Handler:    _FastBlog_GetPublicPost_Handler,
+		},
+		{
+			MethodName: "ListPublicComment",
+			Handler:    _FastBlog_ListPublicComment_Handler,
+		},
+		{
+			MethodName: "SearchPublicPost",
+			Handler:    _FastBlog_SearchPublicPost_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _FastBlog_ExportUserData_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "apiserver/v1/apiserver.proto",
 }
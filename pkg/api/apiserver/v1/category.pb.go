@@ -0,0 +1,709 @@
+// Category API 定义，包含博客分类的请求和响应消息
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.1
+// source: apiserver/v1/category.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Category 表示博客分类，分类属于创建它的用户
+type Category struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// categoryID 表示分类 ID
+	CategoryID string `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	// name 表示分类名称，同一用户下唯一
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description 表示分类描述
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// createdAt 表示分类创建时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// updatedAt 表示分类最后更新时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Category) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateCategoryRequest 表示创建分类请求
+type CreateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name 表示分类名称
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// description 表示分类描述
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CreateCategoryResponse 表示创建分类响应
+type CreateCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// categoryID 表示创建的分类 ID
+	CategoryID    string `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCategoryResponse) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+// UpdateCategoryRequest 表示更新分类请求
+type UpdateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// categoryID 表示要更新的分类 ID，对应 {categoryID}
+	CategoryID string `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	// name 表示更新后的分类名称
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// description 表示更新后的分类描述
+	Description   *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateCategoryRequest) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// UpdateCategoryResponse 表示更新分类响应
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{4}
+}
+
+// DeleteCategoryRequest 表示删除分类请求，删除分类的同时会解除其与文章的关联
+type DeleteCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// categoryID 表示要删除的分类 ID
+	CategoryID    string `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCategoryRequest) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+// DeleteCategoryResponse 表示删除分类响应
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{6}
+}
+
+// GetCategoryRequest 表示获取分类请求
+type GetCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// categoryID 表示要获取的分类 ID
+	CategoryID    string `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCategoryRequest) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+// GetCategoryResponse 表示获取分类响应
+type GetCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// category 表示返回的分类信息
+	Category      *Category `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryResponse) Reset() {
+	*x = GetCategoryResponse{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryResponse) ProtoMessage() {}
+
+func (x *GetCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// ListCategoryRequest 表示获取分类列表请求
+type ListCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// offset 表示偏移量
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit 表示每页数量
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryRequest) Reset() {
+	*x = ListCategoryRequest{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryRequest) ProtoMessage() {}
+
+func (x *ListCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCategoryRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListCategoryRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListCategoryResponse 表示获取分类列表响应
+type ListCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示分类总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// categories 表示分类列表
+	Categories    []*Category `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryResponse) Reset() {
+	*x = ListCategoryResponse{}
+	mi := &file_apiserver_v1_category_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryResponse) ProtoMessage() {}
+
+func (x *ListCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_category_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_category_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCategoryResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListCategoryResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+var File_apiserver_v1_category_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_category_proto_rawDesc = "" +
+	"\n" +
+	"\x1bapiserver/v1/category.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x01\n" +
+	"\bCategory\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\tR\n" +
+	"categoryID\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x128\n" +
+	"\tcreatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"M\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"8\n" +
+	"\x16CreateCategoryResponse\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\tR\n" +
+	"categoryID\"\x90\x01\n" +
+	"\x15UpdateCategoryRequest\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\tR\n" +
+	"categoryID\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"\x18\n" +
+	"\x16UpdateCategoryResponse\"7\n" +
+	"\x15DeleteCategoryRequest\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\tR\n" +
+	"categoryID\"\x18\n" +
+	"\x16DeleteCategoryResponse\"4\n" +
+	"\x12GetCategoryRequest\x12\x1e\n" +
+	"\n" +
+	"categoryID\x18\x01 \x01(\tR\n" +
+	"categoryID\"?\n" +
+	"\x13GetCategoryResponse\x12(\n" +
+	"\bcategory\x18\x01 \x01(\v2\f.v1.CategoryR\bcategory\"C\n" +
+	"\x13ListCategoryRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"d\n" +
+	"\x14ListCategoryResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12,\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\f.v1.CategoryR\n" +
+	"categoriesB6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
+
+var (
+	file_apiserver_v1_category_proto_rawDescOnce sync.Once
+	file_apiserver_v1_category_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_category_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_category_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_category_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_category_proto_rawDesc), len(file_apiserver_v1_category_proto_rawDesc)))
+	})
+	return file_apiserver_v1_category_proto_rawDescData
+}
+
+var file_apiserver_v1_category_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_apiserver_v1_category_proto_goTypes = []any{
+	(*Category)(nil),               // 0: v1.Category
+	(*CreateCategoryRequest)(nil),  // 1: v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil), // 2: v1.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),  // 3: v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil), // 4: v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),  // 5: v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil), // 6: v1.DeleteCategoryResponse
+	(*GetCategoryRequest)(nil),     // 7: v1.GetCategoryRequest
+	(*GetCategoryResponse)(nil),    // 8: v1.GetCategoryResponse
+	(*ListCategoryRequest)(nil),    // 9: v1.ListCategoryRequest
+	(*ListCategoryResponse)(nil),   // 10: v1.ListCategoryResponse
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
+}
+var file_apiserver_v1_category_proto_depIdxs = []int32{
+	11, // 0: v1.Category.createdAt:type_name -> google.protobuf.Timestamp
+	11, // 1: v1.Category.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 2: v1.GetCategoryResponse.category:type_name -> v1.Category
+	0,  // 3: v1.ListCategoryResponse.categories:type_name -> v1.Category
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_category_proto_init() }
+func file_apiserver_v1_category_proto_init() {
+	if File_apiserver_v1_category_proto != nil {
+		return
+	}
+	file_apiserver_v1_category_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_category_proto_rawDesc), len(file_apiserver_v1_category_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_category_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_category_proto_depIdxs,
+		MessageInfos:      file_apiserver_v1_category_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_category_proto = out.File
+	file_apiserver_v1_category_proto_goTypes = nil
+	file_apiserver_v1_category_proto_depIdxs = nil
+}
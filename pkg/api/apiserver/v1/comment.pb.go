@@ -0,0 +1,1012 @@
+// Comment API 定义，包含博客评论的请求和响应消息
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.1
+// source: apiserver/v1/comment.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CommentStatus 表示评论的审核状态
+type CommentStatus int32
+
+const (
+	// Pending 表示待审核，只有文章作者可见
+	CommentStatus_Pending CommentStatus = 0
+	// Approved 表示已通过审核，所有读者可见
+	CommentStatus_Approved CommentStatus = 1
+	// Spam 表示被标记为垃圾评论
+	CommentStatus_Spam CommentStatus = 2
+)
+
+// Enum value maps for CommentStatus.
+var (
+	CommentStatus_name = map[int32]string{
+		0: "Pending",
+		1: "Approved",
+		2: "Spam",
+	}
+	CommentStatus_value = map[string]int32{
+		"Pending":  0,
+		"Approved": 1,
+		"Spam":     2,
+	}
+)
+
+func (x CommentStatus) Enum() *CommentStatus {
+	p := new(CommentStatus)
+	*p = x
+	return p
+}
+
+func (x CommentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_apiserver_v1_comment_proto_enumTypes[0].Descriptor()
+}
+
+func (CommentStatus) Type() protoreflect.EnumType {
+	return &file_apiserver_v1_comment_proto_enumTypes[0]
+}
+
+func (x CommentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommentStatus.Descriptor instead.
+func (CommentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{0}
+}
+
+// Comment 表示博客评论
+type Comment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// commentID 表示评论 ID
+	CommentID string `protobuf:"bytes,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	// postID 表示评论所属的文章 ID
+	PostID string `protobuf:"bytes,2,opt,name=postID,proto3" json:"postID,omitempty"`
+	// userID 表示评论作者的用户 ID
+	UserID string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// parentID 表示回复的评论 ID，为空时表示直接评论文章
+	ParentID string `protobuf:"bytes,4,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	// content 表示评论内容
+	Content string `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	// status 表示评论的审核状态
+	Status CommentStatus `protobuf:"varint,6,opt,name=status,proto3,enum=v1.CommentStatus" json:"status,omitempty"`
+	// createdAt 表示评论创建时间
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// updatedAt 表示评论最后更新时间
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// replies 表示对该评论的回复，按创建时间从早到晚排列
+	Replies       []*Comment `protobuf:"bytes,9,rep,name=replies,proto3" json:"replies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Comment) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *Comment) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *Comment) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Comment) GetParentID() string {
+	if x != nil {
+		return x.ParentID
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_Pending
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+// CreateCommentRequest 表示创建评论请求
+type CreateCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示要评论的文章 ID，对应 {postID}
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// parentID 表示要回复的评论 ID，为空时表示直接评论文章
+	ParentID string `protobuf:"bytes,2,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	// content 表示评论内容
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCommentRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentID() string {
+	if x != nil {
+		return x.ParentID
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// CreateCommentResponse 表示创建评论响应
+type CreateCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// commentID 表示创建的评论 ID
+	CommentID string `protobuf:"bytes,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	// status 表示评论的审核状态，文章作者的评论无需审核
+	Status        CommentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=v1.CommentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResponse) ProtoMessage() {}
+
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCommentResponse) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *CreateCommentResponse) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_Pending
+}
+
+// UpdateCommentRequest 表示更新评论请求，只有评论作者可以修改评论
+type UpdateCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// commentID 表示要更新的评论 ID，对应 {commentID}
+	CommentID string `protobuf:"bytes,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	// content 表示更新后的评论内容
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateCommentRequest) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// UpdateCommentResponse 表示更新评论响应
+type UpdateCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status 表示更新后评论的审核状态，修改后的评论需要重新审核
+	Status        CommentStatus `protobuf:"varint,1,opt,name=status,proto3,enum=v1.CommentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCommentResponse) Reset() {
+	*x = UpdateCommentResponse{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentResponse) ProtoMessage() {}
+
+func (x *UpdateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateCommentResponse) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_Pending
+}
+
+// DeleteCommentRequest 表示删除评论请求，评论的所有回复会被一并删除
+type DeleteCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// commentID 表示要删除的评论 ID
+	CommentID     string `protobuf:"bytes,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCommentRequest) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+// DeleteCommentResponse 表示删除评论响应
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{6}
+}
+
+// ModerateCommentRequest 表示审核评论请求，只有文章作者可以审核评论
+type ModerateCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// commentID 表示要审核的评论 ID，对应 {commentID}
+	CommentID string `protobuf:"bytes,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	// status 表示审核后的状态
+	Status        CommentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=v1.CommentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentRequest) Reset() {
+	*x = ModerateCommentRequest{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentRequest) ProtoMessage() {}
+
+func (x *ModerateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentRequest.ProtoReflect.Descriptor instead.
+func (*ModerateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ModerateCommentRequest) GetCommentID() string {
+	if x != nil {
+		return x.CommentID
+	}
+	return ""
+}
+
+func (x *ModerateCommentRequest) GetStatus() CommentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommentStatus_Pending
+}
+
+// ModerateCommentResponse 表示审核评论响应
+type ModerateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateCommentResponse) Reset() {
+	*x = ModerateCommentResponse{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateCommentResponse) ProtoMessage() {}
+
+func (x *ModerateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateCommentResponse.ProtoReflect.Descriptor instead.
+func (*ModerateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{8}
+}
+
+// ListCommentRequest 表示获取文章评论列表请求
+type ListCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示文章 ID，对应 {postID}
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// page 表示页码，从 1 开始
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// status 表示可选的审核状态过滤，仅文章作者可用，指定时按时间倒序平铺返回评论
+	Status        *CommentStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.CommentStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentRequest) Reset() {
+	*x = ListCommentRequest{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentRequest) ProtoMessage() {}
+
+func (x *ListCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCommentRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ListCommentRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCommentRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentRequest) GetStatus() CommentStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return CommentStatus_Pending
+}
+
+// ListCommentResponse 表示获取文章评论列表响应
+type ListCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示顶层评论总数，按状态过滤时为符合条件的评论总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// page 表示当前页码
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// comments 表示评论列表，未按状态过滤时回复嵌套在 replies 中
+	Comments      []*Comment `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentResponse) Reset() {
+	*x = ListCommentResponse{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentResponse) ProtoMessage() {}
+
+func (x *ListCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListCommentResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListCommentResponse) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCommentResponse) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+// ListPublicCommentRequest 表示匿名读者获取文章评论列表请求
+type ListPublicCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示文章 ID，对应 {postID}
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// page 表示页码，从 1 开始
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize      int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicCommentRequest) Reset() {
+	*x = ListPublicCommentRequest{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicCommentRequest) ProtoMessage() {}
+
+func (x *ListPublicCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicCommentRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicCommentRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListPublicCommentRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ListPublicCommentRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPublicCommentRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListPublicCommentResponse 表示匿名读者获取文章评论列表响应
+type ListPublicCommentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示已通过审核的顶层评论总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// page 表示当前页码
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// comments 表示已通过审核的评论列表，回复嵌套在 replies 中
+	Comments      []*Comment `protobuf:"bytes,4,rep,name=comments,proto3" json:"comments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicCommentResponse) Reset() {
+	*x = ListPublicCommentResponse{}
+	mi := &file_apiserver_v1_comment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicCommentResponse) ProtoMessage() {}
+
+func (x *ListPublicCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_comment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicCommentResponse.ProtoReflect.Descriptor instead.
+func (*ListPublicCommentResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_comment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPublicCommentResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPublicCommentResponse) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPublicCommentResponse) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPublicCommentResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+var File_apiserver_v1_comment_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_comment_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapiserver/v1/comment.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd3\x02\n" +
+	"\aComment\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\tR\tcommentID\x12\x16\n" +
+	"\x06postID\x18\x02 \x01(\tR\x06postID\x12\x16\n" +
+	"\x06userID\x18\x03 \x01(\tR\x06userID\x12\x1a\n" +
+	"\bparentID\x18\x04 \x01(\tR\bparentID\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12)\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x11.v1.CommentStatusR\x06status\x128\n" +
+	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12%\n" +
+	"\areplies\x18\t \x03(\v2\v.v1.CommentR\areplies\"d\n" +
+	"\x14CreateCommentRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x1a\n" +
+	"\bparentID\x18\x02 \x01(\tR\bparentID\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"`\n" +
+	"\x15CreateCommentResponse\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\tR\tcommentID\x12)\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.v1.CommentStatusR\x06status\"N\n" +
+	"\x14UpdateCommentRequest\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\tR\tcommentID\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"B\n" +
+	"\x15UpdateCommentResponse\x12)\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x11.v1.CommentStatusR\x06status\"4\n" +
+	"\x14DeleteCommentRequest\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\tR\tcommentID\"\x17\n" +
+	"\x15DeleteCommentResponse\"a\n" +
+	"\x16ModerateCommentRequest\x12\x1c\n" +
+	"\tcommentID\x18\x01 \x01(\tR\tcommentID\x12)\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x11.v1.CommentStatusR\x06status\"\x19\n" +
+	"\x17ModerateCommentResponse\"\x97\x01\n" +
+	"\x12ListCommentRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12.\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x11.v1.CommentStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"\x8e\x01\n" +
+	"\x13ListCommentResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12'\n" +
+	"\bcomments\x18\x04 \x03(\v2\v.v1.CommentR\bcomments\"b\n" +
+	"\x18ListPublicCommentRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\"\x94\x01\n" +
+	"\x19ListPublicCommentResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12'\n" +
+	"\bcomments\x18\x04 \x03(\v2\v.v1.CommentR\bcomments*4\n" +
+	"\rCommentStatus\x12\v\n" +
+	"\aPending\x10\x00\x12\f\n" +
+	"\bApproved\x10\x01\x12\b\n" +
+	"\x04Spam\x10\x02B6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
+
+var (
+	file_apiserver_v1_comment_proto_rawDescOnce sync.Once
+	file_apiserver_v1_comment_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_comment_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_comment_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_comment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_comment_proto_rawDesc), len(file_apiserver_v1_comment_proto_rawDesc)))
+	})
+	return file_apiserver_v1_comment_proto_rawDescData
+}
+
+var file_apiserver_v1_comment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apiserver_v1_comment_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_apiserver_v1_comment_proto_goTypes = []any{
+	(CommentStatus)(0),                // 0: v1.CommentStatus
+	(*Comment)(nil),                   // 1: v1.Comment
+	(*CreateCommentRequest)(nil),      // 2: v1.CreateCommentRequest
+	(*CreateCommentResponse)(nil),     // 3: v1.CreateCommentResponse
+	(*UpdateCommentRequest)(nil),      // 4: v1.UpdateCommentRequest
+	(*UpdateCommentResponse)(nil),     // 5: v1.UpdateCommentResponse
+	(*DeleteCommentRequest)(nil),      // 6: v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),     // 7: v1.DeleteCommentResponse
+	(*ModerateCommentRequest)(nil),    // 8: v1.ModerateCommentRequest
+	(*ModerateCommentResponse)(nil),   // 9: v1.ModerateCommentResponse
+	(*ListCommentRequest)(nil),        // 10: v1.ListCommentRequest
+	(*ListCommentResponse)(nil),       // 11: v1.ListCommentResponse
+	(*ListPublicCommentRequest)(nil),  // 12: v1.ListPublicCommentRequest
+	(*ListPublicCommentResponse)(nil), // 13: v1.ListPublicCommentResponse
+	(*timestamppb.Timestamp)(nil),     // 14: google.protobuf.Timestamp
+}
+var file_apiserver_v1_comment_proto_depIdxs = []int32{
+	0,  // 0: v1.Comment.status:type_name -> v1.CommentStatus
+	14, // 1: v1.Comment.createdAt:type_name -> google.protobuf.Timestamp
+	14, // 2: v1.Comment.updatedAt:type_name -> google.protobuf.Timestamp
+	1,  // 3: v1.Comment.replies:type_name -> v1.Comment
+	0,  // 4: v1.CreateCommentResponse.status:type_name -> v1.CommentStatus
+	0,  // 5: v1.UpdateCommentResponse.status:type_name -> v1.CommentStatus
+	0,  // 6: v1.ModerateCommentRequest.status:type_name -> v1.CommentStatus
+	0,  // 7: v1.ListCommentRequest.status:type_name -> v1.CommentStatus
+	1,  // 8: v1.ListCommentResponse.comments:type_name -> v1.Comment
+	1,  // 9: v1.ListPublicCommentResponse.comments:type_name -> v1.Comment
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_comment_proto_init() }
+func file_apiserver_v1_comment_proto_init() {
+	if File_apiserver_v1_comment_proto != nil {
+		return
+	}
+	file_apiserver_v1_comment_proto_msgTypes[9].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_comment_proto_rawDesc), len(file_apiserver_v1_comment_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_comment_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_comment_proto_depIdxs,
+		EnumInfos:         file_apiserver_v1_comment_proto_enumTypes,
+		MessageInfos:      file_apiserver_v1_comment_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_comment_proto = out.File
+	file_apiserver_v1_comment_proto_goTypes = nil
+	file_apiserver_v1_comment_proto_depIdxs = nil
+}
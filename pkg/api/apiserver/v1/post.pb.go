@@ -11,6 +11,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -24,6 +25,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PostStatus 表示博客文章的状态
+type PostStatus int32
+
+const (
+	// Draft 表示草稿，只有作者可见
+	PostStatus_Draft PostStatus = 0
+	// Scheduled 表示定时发布，到达发布时间后自动发布
+	PostStatus_Scheduled PostStatus = 1
+	// Published 表示已发布，所有读者可见
+	PostStatus_Published PostStatus = 2
+	// Archived 表示已归档，不再对读者展示
+	PostStatus_Archived PostStatus = 3
+)
+
+// Enum value maps for PostStatus.
+var (
+	PostStatus_name = map[int32]string{
+		0: "Draft",
+		1: "Scheduled",
+		2: "Published",
+		3: "Archived",
+	}
+	PostStatus_value = map[string]int32{
+		"Draft":     0,
+		"Scheduled": 1,
+		"Published": 2,
+		"Archived":  3,
+	}
+)
+
+func (x PostStatus) Enum() *PostStatus {
+	p := new(PostStatus)
+	*p = x
+	return p
+}
+
+func (x PostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_apiserver_v1_post_proto_enumTypes[0].Descriptor()
+}
+
+func (PostStatus) Type() protoreflect.EnumType {
+	return &file_apiserver_v1_post_proto_enumTypes[0]
+}
+
+func (x PostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostStatus.Descriptor instead.
+func (PostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{0}
+}
+
 // Post 表示博客文章
 type Post struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -38,7 +96,17 @@ type Post struct {
 	// createdAt 表示博客创建时间
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示博客最后更新时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// status 表示博客状态
+	Status PostStatus `protobuf:"varint,7,opt,name=status,proto3,enum=v1.PostStatus" json:"status,omitempty"`
+	// publishedAt 表示博客发布时间，定时发布的博客为计划发布时间
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	// tags 表示博客的标签
+	Tags []*Tag `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	// categories 表示博客所属的分类
+	Categories []*Category `protobuf:"bytes,10,rep,name=categories,proto3" json:"categories,omitempty"`
+	// deletedAt 表示博客移入回收站的时间，未删除时为空
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -115,13 +183,52 @@ func (x *Post) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Post) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_Draft
+}
+
+func (x *Post) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Post) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Post) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Post) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 // CreatePostRequest 表示创建文章请求
 type CreatePostRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// title 表示博客标题
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	// content 表示博客内容
-	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// tags 表示博客的标签名称，不存在的标签会自动创建
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// categoryIDs 表示博客所属的分类 ID
+	CategoryIDs   []string `protobuf:"bytes,4,rep,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -170,6 +277,20 @@ func (x *CreatePostRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetCategoryIDs() []string {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
 // CreatePostResponse 表示创建文章响应
 type CreatePostResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -224,7 +345,11 @@ type UpdatePostRequest struct {
 	// title 表示更新后的博客标题
 	Title *string `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	// content 表示更新后的博客内容
-	Content       *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	Content *string `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`
+	// tags 表示更新后的标签名称列表（字符串数组），不传时不修改，传空数组时清空标签
+	Tags *structpb.ListValue `protobuf:"bytes,4,opt,name=tags,proto3" json:"tags,omitempty"`
+	// categoryIDs 表示更新后的分类 ID 列表（字符串数组），不传时不修改，传空数组时清空分类
+	CategoryIDs   *structpb.ListValue `protobuf:"bytes,5,opt,name=categoryIDs,proto3" json:"categoryIDs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -280,6 +405,20 @@ func (x *UpdatePostRequest) GetContent() string {
 	return ""
 }
 
+func (x *UpdatePostRequest) GetTags() *structpb.ListValue {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetCategoryIDs() *structpb.ListValue {
+	if x != nil {
+		return x.CategoryIDs
+	}
+	return nil
+}
+
 // UpdatePostResponse 表示更新文章响应
 type UpdatePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -500,7 +639,26 @@ type ListPostRequest struct {
 	// limit 表示每页数量
 	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	// title 表示可选的标题过滤
-	Title         *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Title *string `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	// status 表示可选的状态过滤
+	Status *PostStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.PostStatus,oneof" json:"status,omitempty"`
+	// tagID 表示可选的标签过滤
+	TagID *string `protobuf:"bytes,5,opt,name=tagID,proto3,oneof" json:"tagID,omitempty"`
+	// categoryID 表示可选的分类过滤
+	CategoryID *string `protobuf:"bytes,6,opt,name=categoryID,proto3,oneof" json:"categoryID,omitempty"`
+	// page_token 表示上一页响应中的 next_page_token，设置后（第一页为空字符串）按创建时间倒序使用键集分页，忽略 offset
+	PageToken *string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// with_total_count 表示键集分页时是否同时统计总数，默认不统计；偏移量分页始终统计总数
+	WithTotalCount bool `protobuf:"varint,8,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	// order_by 表示排序方式，格式为 "字段 [asc|desc]"，多个字段以逗号分隔，可选字段为 createdAt、updatedAt、title，默认按 ID 倒序，不能与 page_token 同时使用
+	OrderBy string `protobuf:"bytes,9,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// created_after 表示只返回不早于该时间创建的文章
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before 表示只返回早于该时间创建的文章
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// filter 表示过滤表达式，例如 title:go AND createdAt>2025-01-01，
+	// 可选字段为 title、content、status、createdAt、updatedAt、publishedAt
+	Filter        string `protobuf:"bytes,12,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -556,13 +714,78 @@ func (x *ListPostRequest) GetTitle() string {
 	return ""
 }
 
+func (x *ListPostRequest) GetStatus() PostStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return PostStatus_Draft
+}
+
+func (x *ListPostRequest) GetTagID() string {
+	if x != nil && x.TagID != nil {
+		return *x.TagID
+	}
+	return ""
+}
+
+func (x *ListPostRequest) GetCategoryID() string {
+	if x != nil && x.CategoryID != nil {
+		return *x.CategoryID
+	}
+	return ""
+}
+
+func (x *ListPostRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPostRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *ListPostRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPostRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListPostRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListPostRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
 // ListPostResponse 表示获取文章列表响应
 type ListPostResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// total_count 表示总文章数
+	// total_count 表示总文章数，不统计总数时为 0
 	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	// posts 表示文章列表
-	Posts         []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+	Posts []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+	// next_page_token 表示键集分页中下一页的令牌，没有下一页时为空
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -611,47 +834,913 @@ func (x *ListPostResponse) GetPosts() []*Post {
 	return nil
 }
 
-var File_apiserver_v1_post_proto protoreflect.FileDescriptor
+func (x *ListPostResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_apiserver_v1_post_proto_rawDesc = "" +
-	"\n" +
-	"\x17apiserver/v1/post.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xda\x01\n" +
-	"\x04Post\x12\x16\n" +
-	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x16\n" +
-	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x14\n" +
-	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x04 \x01(\tR\acontent\x128\n" +
-	"\tcreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\tupdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"C\n" +
-	"\x11CreatePostRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x02 \x01(\tR\acontent\",\n" +
-	"\x12CreatePostResponse\x12\x16\n" +
-	"\x06postID\x18\x01 \x01(\tR\x06postID\"{\n" +
-	"\x11UpdatePostRequest\x12\x16\n" +
-	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x19\n" +
-	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
-	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01B\b\n" +
-	"\x06_titleB\n" +
-	"\n" +
-	"\b_content\"\x14\n" +
-	"\x12UpdatePostResponse\"-\n" +
-	"\x11DeletePostRequest\x12\x18\n" +
-	"\apostIDs\x18\x01 \x03(\tR\apostIDs\"\x14\n" +
-	"\x12DeletePostResponse\"(\n" +
-	"\x0eGetPostRequest\x12\x16\n" +
-	"\x06postID\x18\x01 \x01(\tR\x06postID\"/\n" +
-	"\x0fGetPostResponse\x12\x1c\n" +
-	"\x04post\x18\x01 \x01(\v2\b.v1.PostR\x04post\"d\n" +
-	"\x0fListPostRequest\x12\x16\n" +
-	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x19\n" +
-	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01B\b\n" +
-	"\x06_title\"S\n" +
-	"\x10ListPostResponse\x12\x1f\n" +
-	"\vtotal_count\x18\x01 \x01(\x03R\n" +
-	"totalCount\x12\x1e\n" +
-	"\x05posts\x18\x02 \x03(\v2\b.v1.PostR\x05postsB6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
+// ListTrashPostRequest 表示获取回收站中的文章列表请求
+type ListTrashPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// offset 表示偏移量
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit 表示每页数量
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashPostRequest) Reset() {
+	*x = ListTrashPostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashPostRequest) ProtoMessage() {}
+
+func (x *ListTrashPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashPostRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListTrashPostRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListTrashPostRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListTrashPostResponse 表示获取回收站中的文章列表响应
+type ListTrashPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// total_count 表示回收站中的文章总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// posts 表示文章列表
+	Posts         []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashPostResponse) Reset() {
+	*x = ListTrashPostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashPostResponse) ProtoMessage() {}
+
+func (x *ListTrashPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashPostResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTrashPostResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListTrashPostResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// RestorePostRequest 表示从回收站恢复文章请求
+type RestorePostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示要恢复的文章 ID
+	PostID        string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRequest) ProtoMessage() {}
+
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestorePostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// RestorePostResponse 表示从回收站恢复文章响应
+type RestorePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostResponse) Reset() {
+	*x = RestorePostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostResponse) ProtoMessage() {}
+
+func (x *RestorePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{14}
+}
+
+// PurgePostRequest 表示彻底删除回收站中的文章请求
+type PurgePostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postIDs 表示要彻底删除的文章 ID 列表
+	PostIDs       []string `protobuf:"bytes,1,rep,name=postIDs,proto3" json:"postIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgePostRequest) Reset() {
+	*x = PurgePostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgePostRequest) ProtoMessage() {}
+
+func (x *PurgePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgePostRequest.ProtoReflect.Descriptor instead.
+func (*PurgePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PurgePostRequest) GetPostIDs() []string {
+	if x != nil {
+		return x.PostIDs
+	}
+	return nil
+}
+
+// PurgePostResponse 表示彻底删除回收站中的文章响应
+type PurgePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgePostResponse) Reset() {
+	*x = PurgePostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgePostResponse) ProtoMessage() {}
+
+func (x *PurgePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgePostResponse.ProtoReflect.Descriptor instead.
+func (*PurgePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{16}
+}
+
+// PublishPostRequest 表示发布文章请求
+type PublishPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示要发布的文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// publishAt 表示可选的发布时间，晚于当前时间时文章会在该时间自动发布
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publishAt,proto3,oneof" json:"publishAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostRequest) ProtoMessage() {}
+
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PublishPostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PublishPostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+// PublishPostResponse 表示发布文章响应
+type PublishPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status 表示发布后的文章状态
+	Status PostStatus `protobuf:"varint,1,opt,name=status,proto3,enum=v1.PostStatus" json:"status,omitempty"`
+	// publishedAt 表示文章的发布时间
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostResponse) Reset() {
+	*x = PublishPostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostResponse) ProtoMessage() {}
+
+func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostResponse.ProtoReflect.Descriptor instead.
+func (*PublishPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PublishPostResponse) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_Draft
+}
+
+func (x *PublishPostResponse) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+// UnpublishPostRequest 表示取消发布文章请求
+type UnpublishPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示要取消发布的文章 ID
+	PostID        string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishPostRequest) Reset() {
+	*x = UnpublishPostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishPostRequest) ProtoMessage() {}
+
+func (x *UnpublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnpublishPostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// UnpublishPostResponse 表示取消发布文章响应
+type UnpublishPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishPostResponse) Reset() {
+	*x = UnpublishPostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishPostResponse) ProtoMessage() {}
+
+func (x *UnpublishPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{20}
+}
+
+// ArchivePostRequest 表示归档文章请求
+type ArchivePostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示要归档的文章 ID
+	PostID        string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePostRequest) Reset() {
+	*x = ArchivePostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePostRequest) ProtoMessage() {}
+
+func (x *ArchivePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePostRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ArchivePostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// ArchivePostResponse 表示归档文章响应
+type ArchivePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePostResponse) Reset() {
+	*x = ArchivePostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePostResponse) ProtoMessage() {}
+
+func (x *ArchivePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePostResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{22}
+}
+
+// ListPublicPostRequest 表示匿名读者获取公开文章列表请求
+type ListPublicPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// page 表示页码，从 1 开始
+	Page int64 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// userID 表示作者 ID，不为空时只返回该用户的文章
+	UserID        string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicPostRequest) Reset() {
+	*x = ListPublicPostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicPostRequest) ProtoMessage() {}
+
+func (x *ListPublicPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicPostRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListPublicPostRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPublicPostRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPublicPostRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// ListPublicPostResponse 表示获取公开文章列表响应
+type ListPublicPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示符合条件的文章总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// page 表示当前页码
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// posts 表示文章列表
+	Posts         []*Post `protobuf:"bytes,4,rep,name=posts,proto3" json:"posts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPublicPostResponse) Reset() {
+	*x = ListPublicPostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPublicPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPublicPostResponse) ProtoMessage() {}
+
+func (x *ListPublicPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPublicPostResponse.ProtoReflect.Descriptor instead.
+func (*ListPublicPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPublicPostResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPublicPostResponse) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPublicPostResponse) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPublicPostResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+// GetPublicPostRequest 表示匿名读者获取公开文章请求
+type GetPublicPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示要获取的文章 ID
+	PostID        string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicPostRequest) Reset() {
+	*x = GetPublicPostRequest{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicPostRequest) ProtoMessage() {}
+
+func (x *GetPublicPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicPostRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPublicPostRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+// GetPublicPostResponse 表示获取公开文章响应
+type GetPublicPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// post 表示返回的文章信息
+	Post          *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicPostResponse) Reset() {
+	*x = GetPublicPostResponse{}
+	mi := &file_apiserver_v1_post_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicPostResponse) ProtoMessage() {}
+
+func (x *GetPublicPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicPostResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPublicPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+var File_apiserver_v1_post_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_post_proto_rawDesc = "" +
+	"\n" +
+	"\x17apiserver/v1/post.proto\x12\x02v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16apiserver/v1/tag.proto\x1a\x1bapiserver/v1/category.proto\"\xc5\x03\n" +
+	"\x04Post\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x16\n" +
+	"\x06userID\x18\x02 \x01(\tR\x06userID\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x128\n" +
+	"\tcreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12&\n" +
+	"\x06status\x18\a \x01(\x0e2\x0e.v1.PostStatusR\x06status\x12<\n" +
+	"\vpublishedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x1b\n" +
+	"\x04tags\x18\t \x03(\v2\a.v1.TagR\x04tags\x12,\n" +
+	"\n" +
+	"categories\x18\n" +
+	" \x03(\v2\f.v1.CategoryR\n" +
+	"categories\x128\n" +
+	"\tdeletedAt\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"y\n" +
+	"\x11CreatePostRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12 \n" +
+	"\vcategoryIDs\x18\x04 \x03(\tR\vcategoryIDs\",\n" +
+	"\x12CreatePostResponse\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\"\xe9\x01\n" +
+	"\x11UpdatePostRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x1d\n" +
+	"\acontent\x18\x03 \x01(\tH\x01R\acontent\x88\x01\x01\x12.\n" +
+	"\x04tags\x18\x04 \x01(\v2\x1a.google.protobuf.ListValueR\x04tags\x12<\n" +
+	"\vcategoryIDs\x18\x05 \x01(\v2\x1a.google.protobuf.ListValueR\vcategoryIDsB\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_content\"\x14\n" +
+	"\x12UpdatePostResponse\"-\n" +
+	"\x11DeletePostRequest\x12\x18\n" +
+	"\apostIDs\x18\x01 \x03(\tR\apostIDs\"\x14\n" +
+	"\x12DeletePostResponse\"(\n" +
+	"\x0eGetPostRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\"/\n" +
+	"\x0fGetPostResponse\x12\x1c\n" +
+	"\x04post\x18\x01 \x01(\v2\b.v1.PostR\x04post\"\x89\x04\n" +
+	"\x0fListPostRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12+\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x0e.v1.PostStatusH\x01R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05tagID\x18\x05 \x01(\tH\x02R\x05tagID\x88\x01\x01\x12#\n" +
+	"\n" +
+	"categoryID\x18\x06 \x01(\tH\x03R\n" +
+	"categoryID\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tH\x04R\tpageToken\x88\x01\x01\x12(\n" +
+	"\x10with_total_count\x18\b \x01(\bR\x0ewithTotalCount\x12\x19\n" +
+	"\border_by\x18\t \x01(\tR\aorderBy\x12?\n" +
+	"\rcreated_after\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x16\n" +
+	"\x06filter\x18\f \x01(\tR\x06filterB\b\n" +
+	"\x06_titleB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_tagIDB\r\n" +
+	"\v_categoryIDB\r\n" +
+	"\v_page_token\"{\n" +
+	"\x10ListPostResponse\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x1e\n" +
+	"\x05posts\x18\x02 \x03(\v2\b.v1.PostR\x05posts\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"D\n" +
+	"\x14ListTrashPostRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"X\n" +
+	"\x15ListTrashPostResponse\x12\x1f\n" +
+	"\vtotal_count\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x1e\n" +
+	"\x05posts\x18\x02 \x03(\v2\b.v1.PostR\x05posts\",\n" +
+	"\x12RestorePostRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\"\x15\n" +
+	"\x13RestorePostResponse\",\n" +
+	"\x10PurgePostRequest\x12\x18\n" +
+	"\apostIDs\x18\x01 \x03(\tR\apostIDs\"\x13\n" +
+	"\x11PurgePostResponse\"y\n" +
+	"\x12PublishPostRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12=\n" +
+	"\tpublishAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tpublishAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_publishAt\"{\n" +
+	"\x13PublishPostResponse\x12&\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x0e.v1.PostStatusR\x06status\x12<\n" +
+	"\vpublishedAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\".\n" +
+	"\x14UnpublishPostRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\"\x17\n" +
+	"\x15UnpublishPostResponse\",\n" +
+	"\x12ArchivePostRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\"\x15\n" +
+	"\x13ArchivePostResponse\"_\n" +
+	"\x15ListPublicPostRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x03R\bpageSize\x12\x16\n" +
+	"\x06userID\x18\x03 \x01(\tR\x06userID\"\x88\x01\n" +
+	"\x16ListPublicPostResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x1e\n" +
+	"\x05posts\x18\x04 \x03(\v2\b.v1.PostR\x05posts\".\n" +
+	"\x14GetPublicPostRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\"5\n" +
+	"\x15GetPublicPostResponse\x12\x1c\n" +
+	"\x04post\x18\x01 \x01(\v2\b.v1.PostR\x04post*C\n" +
+	"\n" +
+	"PostStatus\x12\t\n" +
+	"\x05Draft\x10\x00\x12\r\n" +
+	"\tScheduled\x10\x01\x12\r\n" +
+	"\tPublished\x10\x02\x12\f\n" +
+	"\bArchived\x10\x03B6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
 
 var (
 	file_apiserver_v1_post_proto_rawDescOnce sync.Once
@@ -665,31 +1754,68 @@ func file_apiserver_v1_post_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_post_proto_rawDescData
 }
 
-var file_apiserver_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_apiserver_v1_post_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apiserver_v1_post_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_apiserver_v1_post_proto_goTypes = []any{
-	(*Post)(nil),                  // 0: v1.Post
-	(*CreatePostRequest)(nil),     // 1: v1.CreatePostRequest
-	(*CreatePostResponse)(nil),    // 2: v1.CreatePostResponse
-	(*UpdatePostRequest)(nil),     // 3: v1.UpdatePostRequest
-	(*UpdatePostResponse)(nil),    // 4: v1.UpdatePostResponse
-	(*DeletePostRequest)(nil),     // 5: v1.DeletePostRequest
-	(*DeletePostResponse)(nil),    // 6: v1.DeletePostResponse
-	(*GetPostRequest)(nil),        // 7: v1.GetPostRequest
-	(*GetPostResponse)(nil),       // 8: v1.GetPostResponse
-	(*ListPostRequest)(nil),       // 9: v1.ListPostRequest
-	(*ListPostResponse)(nil),      // 10: v1.ListPostResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(PostStatus)(0),                // 0: v1.PostStatus
+	(*Post)(nil),                   // 1: v1.Post
+	(*CreatePostRequest)(nil),      // 2: v1.CreatePostRequest
+	(*CreatePostResponse)(nil),     // 3: v1.CreatePostResponse
+	(*UpdatePostRequest)(nil),      // 4: v1.UpdatePostRequest
+	(*UpdatePostResponse)(nil),     // 5: v1.UpdatePostResponse
+	(*DeletePostRequest)(nil),      // 6: v1.DeletePostRequest
+	(*DeletePostResponse)(nil),     // 7: v1.DeletePostResponse
+	(*GetPostRequest)(nil),         // 8: v1.GetPostRequest
+	(*GetPostResponse)(nil),        // 9: v1.GetPostResponse
+	(*ListPostRequest)(nil),        // 10: v1.ListPostRequest
+	(*ListPostResponse)(nil),       // 11: v1.ListPostResponse
+	(*ListTrashPostRequest)(nil),   // 12: v1.ListTrashPostRequest
+	(*ListTrashPostResponse)(nil),  // 13: v1.ListTrashPostResponse
+	(*RestorePostRequest)(nil),     // 14: v1.RestorePostRequest
+	(*RestorePostResponse)(nil),    // 15: v1.RestorePostResponse
+	(*PurgePostRequest)(nil),       // 16: v1.PurgePostRequest
+	(*PurgePostResponse)(nil),      // 17: v1.PurgePostResponse
+	(*PublishPostRequest)(nil),     // 18: v1.PublishPostRequest
+	(*PublishPostResponse)(nil),    // 19: v1.PublishPostResponse
+	(*UnpublishPostRequest)(nil),   // 20: v1.UnpublishPostRequest
+	(*UnpublishPostResponse)(nil),  // 21: v1.UnpublishPostResponse
+	(*ArchivePostRequest)(nil),     // 22: v1.ArchivePostRequest
+	(*ArchivePostResponse)(nil),    // 23: v1.ArchivePostResponse
+	(*ListPublicPostRequest)(nil),  // 24: v1.ListPublicPostRequest
+	(*ListPublicPostResponse)(nil), // 25: v1.ListPublicPostResponse
+	(*GetPublicPostRequest)(nil),   // 26: v1.GetPublicPostRequest
+	(*GetPublicPostResponse)(nil),  // 27: v1.GetPublicPostResponse
+	(*timestamppb.Timestamp)(nil),  // 28: google.protobuf.Timestamp
+	(*Tag)(nil),                    // 29: v1.Tag
+	(*Category)(nil),               // 30: v1.Category
+	(*structpb.ListValue)(nil),     // 31: google.protobuf.ListValue
 }
 var file_apiserver_v1_post_proto_depIdxs = []int32{
-	11, // 0: v1.Post.createdAt:type_name -> google.protobuf.Timestamp
-	11, // 1: v1.Post.updatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 2: v1.GetPostResponse.post:type_name -> v1.Post
-	0,  // 3: v1.ListPostResponse.posts:type_name -> v1.Post
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	28, // 0: v1.Post.createdAt:type_name -> google.protobuf.Timestamp
+	28, // 1: v1.Post.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 2: v1.Post.status:type_name -> v1.PostStatus
+	28, // 3: v1.Post.publishedAt:type_name -> google.protobuf.Timestamp
+	29, // 4: v1.Post.tags:type_name -> v1.Tag
+	30, // 5: v1.Post.categories:type_name -> v1.Category
+	28, // 6: v1.Post.deletedAt:type_name -> google.protobuf.Timestamp
+	31, // 7: v1.UpdatePostRequest.tags:type_name -> google.protobuf.ListValue
+	31, // 8: v1.UpdatePostRequest.categoryIDs:type_name -> google.protobuf.ListValue
+	1,  // 9: v1.GetPostResponse.post:type_name -> v1.Post
+	0,  // 10: v1.ListPostRequest.status:type_name -> v1.PostStatus
+	28, // 11: v1.ListPostRequest.created_after:type_name -> google.protobuf.Timestamp
+	28, // 12: v1.ListPostRequest.created_before:type_name -> google.protobuf.Timestamp
+	1,  // 13: v1.ListPostResponse.posts:type_name -> v1.Post
+	1,  // 14: v1.ListTrashPostResponse.posts:type_name -> v1.Post
+	28, // 15: v1.PublishPostRequest.publishAt:type_name -> google.protobuf.Timestamp
+	0,  // 16: v1.PublishPostResponse.status:type_name -> v1.PostStatus
+	28, // 17: v1.PublishPostResponse.publishedAt:type_name -> google.protobuf.Timestamp
+	1,  // 18: v1.ListPublicPostResponse.posts:type_name -> v1.Post
+	1,  // 19: v1.GetPublicPostResponse.post:type_name -> v1.Post
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_post_proto_init() }
@@ -697,20 +1823,24 @@ func file_apiserver_v1_post_proto_init() {
 	if File_apiserver_v1_post_proto != nil {
 		return
 	}
+	file_apiserver_v1_tag_proto_init()
+	file_apiserver_v1_category_proto_init()
 	file_apiserver_v1_post_proto_msgTypes[3].OneofWrappers = []any{}
 	file_apiserver_v1_post_proto_msgTypes[9].OneofWrappers = []any{}
+	file_apiserver_v1_post_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_post_proto_rawDesc), len(file_apiserver_v1_post_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_apiserver_v1_post_proto_goTypes,
 		DependencyIndexes: file_apiserver_v1_post_proto_depIdxs,
+		EnumInfos:         file_apiserver_v1_post_proto_enumTypes,
 		MessageInfos:      file_apiserver_v1_post_proto_msgTypes,
 	}.Build()
 	File_apiserver_v1_post_proto = out.File
@@ -0,0 +1,768 @@
+// PostRevision API 定义，包含博客文章历史版本的请求和响应消息
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.1
+// source: apiserver/v1/post_revision.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DiffOperation 表示差异行的类型
+type DiffOperation int32
+
+const (
+	// Equal 表示两个版本中相同的行
+	DiffOperation_Equal DiffOperation = 0
+	// Insert 表示新版本中新增的行
+	DiffOperation_Insert DiffOperation = 1
+	// Delete 表示旧版本中被删除的行
+	DiffOperation_Delete DiffOperation = 2
+)
+
+// Enum value maps for DiffOperation.
+var (
+	DiffOperation_name = map[int32]string{
+		0: "Equal",
+		1: "Insert",
+		2: "Delete",
+	}
+	DiffOperation_value = map[string]int32{
+		"Equal":  0,
+		"Insert": 1,
+		"Delete": 2,
+	}
+)
+
+func (x DiffOperation) Enum() *DiffOperation {
+	p := new(DiffOperation)
+	*p = x
+	return p
+}
+
+func (x DiffOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_apiserver_v1_post_revision_proto_enumTypes[0].Descriptor()
+}
+
+func (DiffOperation) Type() protoreflect.EnumType {
+	return &file_apiserver_v1_post_revision_proto_enumTypes[0]
+}
+
+func (x DiffOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOperation.Descriptor instead.
+func (DiffOperation) EnumDescriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{0}
+}
+
+// PostRevision 表示博客文章的一个历史版本，保存的是某次更新之前的文章内容
+type PostRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示博文 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// version 表示版本号，同一篇文章的版本号从 1 开始递增
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// title 表示该版本的博客标题
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// content 表示该版本的博客内容
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// createdAt 表示该版本的创建时间，即文章被更新的时间
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PostRevision) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PostRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PostRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PostRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListPostRevisionRequest 表示获取文章历史版本列表请求
+type ListPostRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// offset 表示偏移量
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit 表示每页数量
+	Limit         int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionRequest) Reset() {
+	*x = ListPostRevisionRequest{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionRequest) ProtoMessage() {}
+
+func (x *ListPostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPostRevisionRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ListPostRevisionRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPostRevisionRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListPostRevisionResponse 表示获取文章历史版本列表响应
+type ListPostRevisionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示历史版本总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// revisions 表示历史版本列表，按版本号从新到旧排列
+	Revisions     []*PostRevision `protobuf:"bytes,2,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionResponse) Reset() {
+	*x = ListPostRevisionResponse{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionResponse) ProtoMessage() {}
+
+func (x *ListPostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPostRevisionResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListPostRevisionResponse) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+// GetPostRevisionRequest 表示获取文章历史版本请求
+type GetPostRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// version 表示版本号
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRevisionRequest) Reset() {
+	*x = GetPostRevisionRequest{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionRequest) ProtoMessage() {}
+
+func (x *GetPostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPostRevisionRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *GetPostRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// GetPostRevisionResponse 表示获取文章历史版本响应
+type GetPostRevisionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// revision 表示返回的历史版本
+	Revision      *PostRevision `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRevisionResponse) Reset() {
+	*x = GetPostRevisionResponse{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionResponse) ProtoMessage() {}
+
+func (x *GetPostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPostRevisionResponse) GetRevision() *PostRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// DiffLine 表示差异结果中的一行
+type DiffLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// operation 表示该行的差异类型
+	Operation DiffOperation `protobuf:"varint,1,opt,name=operation,proto3,enum=v1.DiffOperation" json:"operation,omitempty"`
+	// text 表示该行的内容，不包含换行符
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DiffLine) GetOperation() DiffOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return DiffOperation_Equal
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// DiffPostRevisionRequest 表示比较文章两个版本的请求
+type DiffPostRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// from 表示旧版本的版本号
+	From int64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to 表示新版本的版本号，为 0 时与文章当前内容比较
+	To            int64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffPostRevisionRequest) Reset() {
+	*x = DiffPostRevisionRequest{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPostRevisionRequest) ProtoMessage() {}
+
+func (x *DiffPostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*DiffPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiffPostRevisionRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *DiffPostRevisionRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+// DiffPostRevisionResponse 表示比较文章两个版本的响应
+type DiffPostRevisionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// fromTitle 表示旧版本的标题
+	FromTitle string `protobuf:"bytes,1,opt,name=fromTitle,proto3" json:"fromTitle,omitempty"`
+	// toTitle 表示新版本的标题
+	ToTitle string `protobuf:"bytes,2,opt,name=toTitle,proto3" json:"toTitle,omitempty"`
+	// lines 表示内容按行比较的结果
+	Lines         []*DiffLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffPostRevisionResponse) Reset() {
+	*x = DiffPostRevisionResponse{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPostRevisionResponse) ProtoMessage() {}
+
+func (x *DiffPostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*DiffPostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiffPostRevisionResponse) GetFromTitle() string {
+	if x != nil {
+		return x.FromTitle
+	}
+	return ""
+}
+
+func (x *DiffPostRevisionResponse) GetToTitle() string {
+	if x != nil {
+		return x.ToTitle
+	}
+	return ""
+}
+
+func (x *DiffPostRevisionResponse) GetLines() []*DiffLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// RestorePostRevisionRequest 表示将文章恢复到历史版本的请求
+type RestorePostRevisionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// postID 表示文章 ID
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID,omitempty"`
+	// version 表示要恢复的版本号
+	Version       int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRevisionRequest) Reset() {
+	*x = RestorePostRevisionRequest{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRevisionRequest) ProtoMessage() {}
+
+func (x *RestorePostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestorePostRevisionRequest) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *RestorePostRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RestorePostRevisionResponse 表示将文章恢复到历史版本的响应
+type RestorePostRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRevisionResponse) Reset() {
+	*x = RestorePostRevisionResponse{}
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRevisionResponse) ProtoMessage() {}
+
+func (x *RestorePostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_post_revision_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_post_revision_proto_rawDescGZIP(), []int{9}
+}
+
+var File_apiserver_v1_post_revision_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_post_revision_proto_rawDesc = "" +
+	"\n" +
+	" apiserver/v1/post_revision.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x01\n" +
+	"\fPostRevision\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x128\n" +
+	"\tcreatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"_\n" +
+	"\x17ListPostRevisionRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"j\n" +
+	"\x18ListPostRevisionResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12.\n" +
+	"\trevisions\x18\x02 \x03(\v2\x10.v1.PostRevisionR\trevisions\"J\n" +
+	"\x16GetPostRevisionRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"G\n" +
+	"\x17GetPostRevisionResponse\x12,\n" +
+	"\brevision\x18\x01 \x01(\v2\x10.v1.PostRevisionR\brevision\"O\n" +
+	"\bDiffLine\x12/\n" +
+	"\toperation\x18\x01 \x01(\x0e2\x11.v1.DiffOperationR\toperation\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"U\n" +
+	"\x17DiffPostRevisionRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\"v\n" +
+	"\x18DiffPostRevisionResponse\x12\x1c\n" +
+	"\tfromTitle\x18\x01 \x01(\tR\tfromTitle\x12\x18\n" +
+	"\atoTitle\x18\x02 \x01(\tR\atoTitle\x12\"\n" +
+	"\x05lines\x18\x03 \x03(\v2\f.v1.DiffLineR\x05lines\"N\n" +
+	"\x1aRestorePostRevisionRequest\x12\x16\n" +
+	"\x06postID\x18\x01 \x01(\tR\x06postID\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\x1d\n" +
+	"\x1bRestorePostRevisionResponse*2\n" +
+	"\rDiffOperation\x12\t\n" +
+	"\x05Equal\x10\x00\x12\n" +
+	"\n" +
+	"\x06Insert\x10\x01\x12\n" +
+	"\n" +
+	"\x06Delete\x10\x02B6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
+
+var (
+	file_apiserver_v1_post_revision_proto_rawDescOnce sync.Once
+	file_apiserver_v1_post_revision_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_post_revision_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_post_revision_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_post_revision_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_post_revision_proto_rawDesc), len(file_apiserver_v1_post_revision_proto_rawDesc)))
+	})
+	return file_apiserver_v1_post_revision_proto_rawDescData
+}
+
+var file_apiserver_v1_post_revision_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_apiserver_v1_post_revision_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_apiserver_v1_post_revision_proto_goTypes = []any{
+	(DiffOperation)(0),                  // 0: v1.DiffOperation
+	(*PostRevision)(nil),                // 1: v1.PostRevision
+	(*ListPostRevisionRequest)(nil),     // 2: v1.ListPostRevisionRequest
+	(*ListPostRevisionResponse)(nil),    // 3: v1.ListPostRevisionResponse
+	(*GetPostRevisionRequest)(nil),      // 4: v1.GetPostRevisionRequest
+	(*GetPostRevisionResponse)(nil),     // 5: v1.GetPostRevisionResponse
+	(*DiffLine)(nil),                    // 6: v1.DiffLine
+	(*DiffPostRevisionRequest)(nil),     // 7: v1.DiffPostRevisionRequest
+	(*DiffPostRevisionResponse)(nil),    // 8: v1.DiffPostRevisionResponse
+	(*RestorePostRevisionRequest)(nil),  // 9: v1.RestorePostRevisionRequest
+	(*RestorePostRevisionResponse)(nil), // 10: v1.RestorePostRevisionResponse
+	(*timestamppb.Timestamp)(nil),       // 11: google.protobuf.Timestamp
+}
+var file_apiserver_v1_post_revision_proto_depIdxs = []int32{
+	11, // 0: v1.PostRevision.createdAt:type_name -> google.protobuf.Timestamp
+	1,  // 1: v1.ListPostRevisionResponse.revisions:type_name -> v1.PostRevision
+	1,  // 2: v1.GetPostRevisionResponse.revision:type_name -> v1.PostRevision
+	0,  // 3: v1.DiffLine.operation:type_name -> v1.DiffOperation
+	6,  // 4: v1.DiffPostRevisionResponse.lines:type_name -> v1.DiffLine
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_post_revision_proto_init() }
+func file_apiserver_v1_post_revision_proto_init() {
+	if File_apiserver_v1_post_revision_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_post_revision_proto_rawDesc), len(file_apiserver_v1_post_revision_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_post_revision_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_post_revision_proto_depIdxs,
+		EnumInfos:         file_apiserver_v1_post_revision_proto_enumTypes,
+		MessageInfos:      file_apiserver_v1_post_revision_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_post_revision_proto = out.File
+	file_apiserver_v1_post_revision_proto_goTypes = nil
+	file_apiserver_v1_post_revision_proto_depIdxs = nil
+}
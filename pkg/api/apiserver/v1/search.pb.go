@@ -0,0 +1,471 @@
+// Search API 定义，包含博客全文检索的请求和响应消息
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.1
+// source: apiserver/v1/search.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchHit 表示一条检索结果
+type SearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// post 表示命中的文章
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	// score 表示相关度得分，得分越高越靠前
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// titleSnippet 表示高亮后的标题，命中的关键词使用 <mark> 标签包裹
+	TitleSnippet string `protobuf:"bytes,3,opt,name=titleSnippet,proto3" json:"titleSnippet,omitempty"`
+	// contentSnippet 表示高亮后的正文摘要，命中的关键词使用 <mark> 标签包裹
+	ContentSnippet string `protobuf:"bytes,4,opt,name=contentSnippet,proto3" json:"contentSnippet,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_apiserver_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchHit) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchHit) GetTitleSnippet() string {
+	if x != nil {
+		return x.TitleSnippet
+	}
+	return ""
+}
+
+func (x *SearchHit) GetContentSnippet() string {
+	if x != nil {
+		return x.ContentSnippet
+	}
+	return ""
+}
+
+// SearchPostRequest 表示检索当前用户文章请求
+type SearchPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// q 表示检索关键词，多个关键词使用空格分隔
+	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// page 表示页码，从 1 开始
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize      int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostRequest) Reset() {
+	*x = SearchPostRequest{}
+	mi := &file_apiserver_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostRequest) ProtoMessage() {}
+
+func (x *SearchPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchPostRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchPostRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchPostRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// SearchPostResponse 表示检索当前用户文章响应
+type SearchPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示命中的文章总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// page 表示当前页码
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// hits 表示按相关度排序的检索结果
+	Hits          []*SearchHit `protobuf:"bytes,4,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostResponse) Reset() {
+	*x = SearchPostResponse{}
+	mi := &file_apiserver_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostResponse) ProtoMessage() {}
+
+func (x *SearchPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchPostResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchPostResponse) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchPostResponse) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPostResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// SearchPublicPostRequest 表示匿名读者检索公开文章请求
+type SearchPublicPostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// q 表示检索关键词，多个关键词使用空格分隔
+	Q string `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	// page 表示页码，从 1 开始
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// userID 表示作者 ID，不为空时只检索该用户的文章
+	UserID        string `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPublicPostRequest) Reset() {
+	*x = SearchPublicPostRequest{}
+	mi := &file_apiserver_v1_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPublicPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPublicPostRequest) ProtoMessage() {}
+
+func (x *SearchPublicPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPublicPostRequest.ProtoReflect.Descriptor instead.
+func (*SearchPublicPostRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_search_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchPublicPostRequest) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchPublicPostRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchPublicPostRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPublicPostRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// SearchPublicPostResponse 表示检索公开文章响应
+type SearchPublicPostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示命中的文章总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// page 表示当前页码
+	Page int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	// pageSize 表示每页数量
+	PageSize int64 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// hits 表示按相关度排序的检索结果
+	Hits          []*SearchHit `protobuf:"bytes,4,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPublicPostResponse) Reset() {
+	*x = SearchPublicPostResponse{}
+	mi := &file_apiserver_v1_search_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPublicPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPublicPostResponse) ProtoMessage() {}
+
+func (x *SearchPublicPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_search_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPublicPostResponse.ProtoReflect.Descriptor instead.
+func (*SearchPublicPostResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_search_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchPublicPostResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchPublicPostResponse) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchPublicPostResponse) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPublicPostResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+var File_apiserver_v1_search_proto protoreflect.FileDescriptor
+
+const file_apiserver_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x19apiserver/v1/search.proto\x12\x02v1\x1a\x17apiserver/v1/post.proto\"\x8b\x01\n" +
+	"\tSearchHit\x12\x1c\n" +
+	"\x04post\x18\x01 \x01(\v2\b.v1.PostR\x04post\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\"\n" +
+	"\ftitleSnippet\x18\x03 \x01(\tR\ftitleSnippet\x12&\n" +
+	"\x0econtentSnippet\x18\x04 \x01(\tR\x0econtentSnippet\"Q\n" +
+	"\x11SearchPostRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\"\x87\x01\n" +
+	"\x12SearchPostResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12!\n" +
+	"\x04hits\x18\x04 \x03(\v2\r.v1.SearchHitR\x04hits\"o\n" +
+	"\x17SearchPublicPostRequest\x12\f\n" +
+	"\x01q\x18\x01 \x01(\tR\x01q\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12\x16\n" +
+	"\x06userID\x18\x04 \x01(\tR\x06userID\"\x8d\x01\n" +
+	"\x18SearchPublicPostResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x03R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x03R\bpageSize\x12!\n" +
+	"\x04hits\x18\x04 \x03(\v2\r.v1.SearchHitR\x04hitsB6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
+
+var (
+	file_apiserver_v1_search_proto_rawDescOnce sync.Once
+	file_apiserver_v1_search_proto_rawDescData []byte
+)
+
+func file_apiserver_v1_search_proto_rawDescGZIP() []byte {
+	file_apiserver_v1_search_proto_rawDescOnce.Do(func() {
+		file_apiserver_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_apiserver_v1_search_proto_rawDesc), len(file_apiserver_v1_search_proto_rawDesc)))
+	})
+	return file_apiserver_v1_search_proto_rawDescData
+}
+
+var file_apiserver_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_apiserver_v1_search_proto_goTypes = []any{
+	(*SearchHit)(nil),                // 0: v1.SearchHit
+	(*SearchPostRequest)(nil),        // 1: v1.SearchPostRequest
+	(*SearchPostResponse)(nil),       // 2: v1.SearchPostResponse
+	(*SearchPublicPostRequest)(nil),  // 3: v1.SearchPublicPostRequest
+	(*SearchPublicPostResponse)(nil), // 4: v1.SearchPublicPostResponse
+	(*Post)(nil),                     // 5: v1.Post
+}
+var file_apiserver_v1_search_proto_depIdxs = []int32{
+	5, // 0: v1.SearchHit.post:type_name -> v1.Post
+	0, // 1: v1.SearchPostResponse.hits:type_name -> v1.SearchHit
+	0, // 2: v1.SearchPublicPostResponse.hits:type_name -> v1.SearchHit
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_apiserver_v1_search_proto_init() }
+func file_apiserver_v1_search_proto_init() {
+	if File_apiserver_v1_search_proto != nil {
+		return
+	}
+	file_apiserver_v1_post_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_search_proto_rawDesc), len(file_apiserver_v1_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_apiserver_v1_search_proto_goTypes,
+		DependencyIndexes: file_apiserver_v1_search_proto_depIdxs,
+		MessageInfos:      file_apiserver_v1_search_proto_msgTypes,
+	}.Build()
+	File_apiserver_v1_search_proto = out.File
+	file_apiserver_v1_search_proto_goTypes = nil
+	file_apiserver_v1_search_proto_depIdxs = nil
+}
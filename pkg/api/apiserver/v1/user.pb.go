@@ -42,7 +42,11 @@ type User struct {
 	// createdAt 表示用户注册时间
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	// updatedAt 表示用户最后更新时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// disabled 表示用户是否被管理员禁用
+	Disabled bool `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// deletedAt 表示用户注销的时间，未注销时为空
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -133,6 +137,20 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *User) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 // LoginRequest 表示登录请求
 type LoginRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -191,12 +209,22 @@ func (x *LoginRequest) GetPassword() string {
 // LoginResponse 表示登录响应
 type LoginResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// token 表示返回的身份验证令牌
+	// token 表示返回的身份验证令牌，需要两步验证时为空
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	// expireAt 表示该 token 的过期时间
-	ExpireAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ExpireAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	// refreshToken 表示用于换取新令牌的刷新令牌，只能使用一次
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// refreshTokenExpireAt 表示刷新令牌的过期时间
+	RefreshTokenExpireAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refreshTokenExpireAt,proto3" json:"refreshTokenExpireAt,omitempty"`
+	// totpRequired 表示用户启用了两步验证，需要使用 challengeToken 和验证码调用 LoginTOTP 完成登录
+	TotpRequired bool `protobuf:"varint,5,opt,name=totpRequired,proto3" json:"totpRequired,omitempty"`
+	// challengeToken 表示两步验证的挑战令牌，短期有效
+	ChallengeToken string `protobuf:"bytes,6,opt,name=challengeToken,proto3" json:"challengeToken,omitempty"`
+	// challengeExpireAt 表示挑战令牌的过期时间
+	ChallengeExpireAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=challengeExpireAt,proto3" json:"challengeExpireAt,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
@@ -243,16 +271,108 @@ func (x *LoginResponse) GetExpireAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRefreshTokenExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpireAt
+	}
+	return nil
+}
+
+func (x *LoginResponse) GetTotpRequired() bool {
+	if x != nil {
+		return x.TotpRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetChallengeExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChallengeExpireAt
+	}
+	return nil
+}
+
+// LoginTOTPRequest 表示两步验证登录的请求
+type LoginTOTPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// challengeToken 表示 Login 返回的挑战令牌
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken,omitempty"`
+	// code 表示验证器应用生成的 6 位验证码，或者一个未使用的恢复码
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginTOTPRequest) Reset() {
+	*x = LoginTOTPRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginTOTPRequest) ProtoMessage() {}
+
+func (x *LoginTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginTOTPRequest.ProtoReflect.Descriptor instead.
+func (*LoginTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LoginTOTPRequest) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *LoginTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
 // RefreshTokenRequest 表示刷新令牌的请求
 type RefreshTokenRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// refreshToken 表示登录或上次刷新时返回的刷新令牌
+	RefreshToken  string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RefreshTokenRequest) Reset() {
 	*x = RefreshTokenRequest{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[3]
+	mi := &file_apiserver_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -264,7 +384,7 @@ func (x *RefreshTokenRequest) String() string {
 func (*RefreshTokenRequest) ProtoMessage() {}
 
 func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[3]
+	mi := &file_apiserver_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -277,7 +397,14 @@ func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{3}
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
 }
 
 // RefreshTokenResponse 表示刷新令牌的响应
@@ -286,14 +413,18 @@ type RefreshTokenResponse struct {
 	// token 表示返回的身份验证令牌
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	// expireAt 表示该 token 的过期时间
-	ExpireAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ExpireAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expireAt,proto3" json:"expireAt,omitempty"`
+	// refreshToken 表示轮换后的新刷新令牌，原刷新令牌不能再次使用
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	// refreshTokenExpireAt 表示新刷新令牌的过期时间
+	RefreshTokenExpireAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=refreshTokenExpireAt,proto3" json:"refreshTokenExpireAt,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *RefreshTokenResponse) Reset() {
 	*x = RefreshTokenResponse{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[4]
+	mi := &file_apiserver_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -305,7 +436,7 @@ func (x *RefreshTokenResponse) String() string {
 func (*RefreshTokenResponse) ProtoMessage() {}
 
 func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[4]
+	mi := &file_apiserver_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +449,7 @@ func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{4}
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RefreshTokenResponse) GetToken() string {
@@ -335,6 +466,94 @@ func (x *RefreshTokenResponse) GetExpireAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshTokenExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpireAt
+	}
+	return nil
+}
+
+// LogoutRequest 表示退出登录的请求
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+// LogoutResponse 表示退出登录的响应
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
 // ChangePasswordRequest 表示修改密码请求
 type ChangePasswordRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -350,7 +569,7 @@ type ChangePasswordRequest struct {
 
 func (x *ChangePasswordRequest) Reset() {
 	*x = ChangePasswordRequest{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[5]
+	mi := &file_apiserver_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +581,7 @@ func (x *ChangePasswordRequest) String() string {
 func (*ChangePasswordRequest) ProtoMessage() {}
 
 func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[5]
+	mi := &file_apiserver_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +594,7 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ChangePasswordRequest) GetUserID() string {
@@ -408,7 +627,7 @@ type ChangePasswordResponse struct {
 
 func (x *ChangePasswordResponse) Reset() {
 	*x = ChangePasswordResponse{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[6]
+	mi := &file_apiserver_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +639,7 @@ func (x *ChangePasswordResponse) String() string {
 func (*ChangePasswordResponse) ProtoMessage() {}
 
 func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[6]
+	mi := &file_apiserver_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,41 +652,33 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
-// CreateUserRequest 表示创建用户请求
-type CreateUserRequest struct {
+// EnrollTOTPRequest 表示绑定两步验证的请求
+type EnrollTOTPRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// username 表示用户名称
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	// password 表示用户密码
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// nickname 表示用户昵称
-	Nickname *string `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
-	// email 表示用户电子邮箱
-	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
-	// phone 表示用户手机号
-	Phone         string `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	// userID 表示用户 ID，只能为自己绑定
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[7]
+func (x *EnrollTOTPRequest) Reset() {
+	*x = EnrollTOTPRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *EnrollTOTPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*EnrollTOTPRequest) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[7]
+func (x *EnrollTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,70 +689,99 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use EnrollTOTPRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateUserRequest) GetUsername() string {
+func (x *EnrollTOTPRequest) GetUserID() string {
 	if x != nil {
-		return x.Username
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetPassword() string {
+// EnrollTOTPResponse 表示绑定两步验证的响应
+type EnrollTOTPResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// secret 表示 base32 编码的 TOTP 密钥，可以手动输入到验证器应用中
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// uri 表示 otpauth URI，可以生成二维码供验证器应用扫描
+	Uri           string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTOTPResponse) Reset() {
+	*x = EnrollTOTPResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTOTPResponse) ProtoMessage() {}
+
+func (x *EnrollTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[11]
 	if x != nil {
-		return x.Password
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserRequest) GetNickname() string {
-	if x != nil && x.Nickname != nil {
-		return *x.Nickname
-	}
-	return ""
+// Deprecated: Use EnrollTOTPResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateUserRequest) GetEmail() string {
+func (x *EnrollTOTPResponse) GetSecret() string {
 	if x != nil {
-		return x.Email
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetPhone() string {
+func (x *EnrollTOTPResponse) GetUri() string {
 	if x != nil {
-		return x.Phone
+		return x.Uri
 	}
 	return ""
 }
 
-// CreateUserResponse 表示创建用户响应
-type CreateUserResponse struct {
+// ConfirmTOTPRequest 表示确认并启用两步验证的请求
+type ConfirmTOTPRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// userID 表示新创建的用户 ID
-	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// userID 表示用户 ID
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// code 表示验证器应用生成的 6 位验证码
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[8]
+func (x *ConfirmTOTPRequest) Reset() {
+	*x = ConfirmTOTPRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *ConfirmTOTPRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*ConfirmTOTPRequest) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[8]
+func (x *ConfirmTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,12 +792,386 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ConfirmTOTPRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CreateUserResponse) GetUserID() string {
+func (x *ConfirmTOTPRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ConfirmTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// ConfirmTOTPResponse 表示确认并启用两步验证的响应
+type ConfirmTOTPResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// recoveryCodes 表示恢复码，每个只能使用一次，只在此时返回
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTOTPResponse) Reset() {
+	*x = ConfirmTOTPResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTOTPResponse) ProtoMessage() {}
+
+func (x *ConfirmTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTOTPResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmTOTPResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// DisableTOTPRequest 表示停用两步验证的请求
+type DisableTOTPRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// code 表示验证码或恢复码，管理员停用其他用户的两步验证时无需填写
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPRequest) Reset() {
+	*x = DisableTOTPRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPRequest) ProtoMessage() {}
+
+func (x *DisableTOTPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPRequest.ProtoReflect.Descriptor instead.
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DisableTOTPRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DisableTOTPRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// DisableTOTPResponse 表示停用两步验证的响应
+type DisableTOTPResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTOTPResponse) Reset() {
+	*x = DisableTOTPResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTOTPResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTOTPResponse) ProtoMessage() {}
+
+func (x *DisableTOTPResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTOTPResponse.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+// RegenerateRecoveryCodesRequest 表示重新生成恢复码的请求
+type RegenerateRecoveryCodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// code 表示验证码或恢复码
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// RegenerateRecoveryCodesResponse 表示重新生成恢复码的响应
+type RegenerateRecoveryCodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// recoveryCodes 表示新的恢复码，原有的恢复码全部失效
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// CreateUserRequest 表示创建用户请求
+type CreateUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// username 表示用户名称
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// password 表示用户密码
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// nickname 表示用户昵称
+	Nickname *string `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	// email 表示用户电子邮箱
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// phone 表示用户手机号
+	Phone         string `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+// CreateUserResponse 表示创建用户响应
+type CreateUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示新创建的用户 ID
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateUserResponse) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
@@ -567,35 +1181,453 @@ func (x *CreateUserResponse) GetUserID() string {
 // UpdateUserRequest 表示更新用户请求
 type UpdateUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// userID 表示用户 ID
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	// username 表示可选的用户名称
-	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// nickname 表示可选的用户昵称
-	Nickname *string `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
-	// email 表示可选的用户电子邮箱
-	Email *string `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
-	// phone 表示可选的用户手机号
-	Phone         *string `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// userID 表示用户 ID
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// username 表示可选的用户名称
+	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// nickname 表示可选的用户昵称
+	Nickname *string `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	// email 表示可选的用户电子邮箱
+	Email *string `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	// phone 表示可选的用户手机号
+	Phone *string `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// disabled 表示是否禁用该用户，仅管理员可以设置
+	Disabled      *bool `protobuf:"varint,6,opt,name=disabled,proto3,oneof" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetDisabled() bool {
+	if x != nil && x.Disabled != nil {
+		return *x.Disabled
+	}
+	return false
+}
+
+// UpdateUserResponse 表示更新用户响应
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+// DeleteUserRequest 表示删除用户请求
+type DeleteUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// DeleteUserResponse 表示删除用户响应
+type DeleteUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// purgeAt 表示用户数据将被彻底删除的时间，未设置注销冷静期时为空，表示已立即删除
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=purgeAt,proto3" json:"purgeAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteUserResponse) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+// GetUserRequest 表示获取用户请求
+type GetUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+// GetUserResponse 表示获取用户响应
+type GetUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// user 表示返回的用户信息
+	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ListUserRequest 表示用户列表请求
+type ListUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// offset 表示偏移量
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit 表示每页数量
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page_token 表示上一页响应中的 next_page_token，设置后（第一页为空字符串）按创建时间倒序使用键集分页，忽略 offset
+	PageToken *string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	// with_total_count 表示键集分页时是否同时统计总数，默认不统计；偏移量分页始终统计总数
+	WithTotalCount bool `protobuf:"varint,4,opt,name=with_total_count,json=withTotalCount,proto3" json:"with_total_count,omitempty"`
+	// order_by 表示排序方式，格式为 "字段 [asc|desc]"，多个字段以逗号分隔，可选字段为 createdAt、updatedAt、username，默认按 ID 倒序，不能与 page_token 同时使用
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// created_after 表示只返回不早于该时间创建的用户
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// created_before 表示只返回早于该时间创建的用户
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// filter 表示过滤表达式，例如 username:alice AND createdAt>2025-01-01，
+	// 可选字段为 username、nickname、email、phone、disabled、createdAt、updatedAt
+	Filter        string `protobuf:"bytes,8,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRequest) Reset() {
+	*x = ListUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRequest) ProtoMessage() {}
+
+func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListUserRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetWithTotalCount() bool {
+	if x != nil {
+		return x.WithTotalCount
+	}
+	return false
+}
+
+func (x *ListUserRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListUserRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListUserRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+// ListUserResponse 表示用户列表响应
+type ListUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示总用户数，不统计总数时为 0
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// users 表示用户列表
+	Users []*User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+	// next_page_token 表示键集分页中下一页的令牌，没有下一页时为空
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[9]
+func (x *ListUserResponse) Reset() {
+	*x = ListUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *ListUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*ListUserResponse) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[9]
+func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,68 +1638,58 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
+func (*ListUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateUserRequest) GetUserID() string {
+func (x *ListUserResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetNickname() string {
-	if x != nil && x.Nickname != nil {
-		return *x.Nickname
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateUserRequest) GetEmail() string {
-	if x != nil && x.Email != nil {
-		return *x.Email
+func (x *ListUserResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateUserRequest) GetPhone() string {
-	if x != nil && x.Phone != nil {
-		return *x.Phone
+func (x *ListUserResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-// UpdateUserResponse 表示更新用户响应
-type UpdateUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// ExportUserDataRequest 表示导出用户数据请求
+type ExportUserDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// userID 表示用户 ID
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// format 表示导出格式，可选值为 zip、json，默认为 zip
+	Format        *string `protobuf:"bytes,2,opt,name=format,proto3,oneof" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserResponse) Reset() {
-	*x = UpdateUserResponse{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[10]
+func (x *ExportUserDataRequest) Reset() {
+	*x = ExportUserDataRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserResponse) String() string {
+func (x *ExportUserDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserResponse) ProtoMessage() {}
+func (*ExportUserDataRequest) ProtoMessage() {}
 
-func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[10]
+func (x *ExportUserDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,35 +1700,51 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExportUserDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportUserDataRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{28}
 }
 
-// DeleteUserRequest 表示删除用户请求
-type DeleteUserRequest struct {
+func (x *ExportUserDataRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ExportUserDataRequest) GetFormat() string {
+	if x != nil && x.Format != nil {
+		return *x.Format
+	}
+	return ""
+}
+
+// ListTrashUserRequest 表示获取已注销用户列表请求
+type ListTrashUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// userID 表示用户 ID
-	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// offset 表示偏移量
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// limit 表示每页数量
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[11]
+func (x *ListTrashUserRequest) Reset() {
+	*x = ListTrashUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *ListTrashUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*ListTrashUserRequest) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[11]
+func (x *ListTrashUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -717,40 +1755,51 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListTrashUserRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DeleteUserRequest) GetUserID() string {
+func (x *ListTrashUserRequest) GetOffset() int64 {
 	if x != nil {
-		return x.UserID
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-// DeleteUserResponse 表示删除用户响应
-type DeleteUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *ListTrashUserRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListTrashUserResponse 表示获取已注销用户列表响应
+type ListTrashUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// totalCount 表示已注销用户总数
+	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	// users 表示用户列表
+	Users         []*User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[12]
+func (x *ListTrashUserResponse) Reset() {
+	*x = ListTrashUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *ListTrashUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*ListTrashUserResponse) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[12]
+func (x *ListTrashUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,13 +1810,27 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListTrashUserResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{30}
 }
 
-// GetUserRequest 表示获取用户请求
-type GetUserRequest struct {
+func (x *ListTrashUserResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListTrashUserResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// RestoreUserRequest 表示恢复已注销用户请求
+type RestoreUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// userID 表示用户 ID
 	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
@@ -775,21 +1838,21 @@ type GetUserRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[13]
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *RestoreUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*RestoreUserRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[13]
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,42 +1863,40 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetUserRequest) GetUserID() string {
+func (x *RestoreUserRequest) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-// GetUserResponse 表示获取用户响应
-type GetUserResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// user 表示返回的用户信息
-	User          *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+// RestoreUserResponse 表示恢复已注销用户响应
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[14]
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *RestoreUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*RestoreUserResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[14]
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,44 +1907,35 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetUserResponse) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{32}
 }
 
-// ListUserRequest 表示用户列表请求
-type ListUserRequest struct {
+// PurgeUserRequest 表示彻底删除已注销用户请求
+type PurgeUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// offset 表示偏移量
-	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	// limit 表示每页数量
-	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// userID 表示用户 ID
+	UserID        string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserRequest) Reset() {
-	*x = ListUserRequest{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[15]
+func (x *PurgeUserRequest) Reset() {
+	*x = PurgeUserRequest{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserRequest) String() string {
+func (x *PurgeUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRequest) ProtoMessage() {}
+func (*PurgeUserRequest) ProtoMessage() {}
 
-func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[15]
+func (x *PurgeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,51 +1946,40 @@ func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
-func (*ListUserRequest) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListUserRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
+// Deprecated: Use PurgeUserRequest.ProtoReflect.Descriptor instead.
+func (*PurgeUserRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListUserRequest) GetLimit() int64 {
+func (x *PurgeUserRequest) GetUserID() string {
 	if x != nil {
-		return x.Limit
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-// ListUserResponse 表示用户列表响应
-type ListUserResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// totalCount 表示总用户数
-	TotalCount int64 `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
-	// users 表示用户列表
-	Users         []*User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+// PurgeUserResponse 表示彻底删除已注销用户响应
+type PurgeUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserResponse) Reset() {
-	*x = ListUserResponse{}
-	mi := &file_apiserver_v1_user_proto_msgTypes[16]
+func (x *PurgeUserResponse) Reset() {
+	*x = PurgeUserResponse{}
+	mi := &file_apiserver_v1_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserResponse) String() string {
+func (x *PurgeUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserResponse) ProtoMessage() {}
+func (*PurgeUserResponse) ProtoMessage() {}
 
-func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_apiserver_v1_user_proto_msgTypes[16]
+func (x *PurgeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,30 +1990,16 @@ func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
-func (*ListUserResponse) Descriptor() ([]byte, []int) {
-	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *ListUserResponse) GetTotalCount() int64 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListUserResponse) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use PurgeUserResponse.ProtoReflect.Descriptor instead.
+func (*PurgeUserResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_user_proto_rawDescGZIP(), []int{34}
 }
 
 var File_apiserver_v1_user_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x17apiserver/v1/user.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x02\n" +
+	"\x17apiserver/v1/user.proto\x12\x02v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xea\x02\n" +
 	"\x04User\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
@@ -981,22 +2008,57 @@ const file_apiserver_v1_user_proto_rawDesc = "" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x1c\n" +
 	"\tpostCount\x18\x06 \x01(\x03R\tpostCount\x128\n" +
 	"\tcreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\tupdatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"F\n" +
+	"\tupdatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bdisabled\x18\t \x01(\bR\bdisabled\x128\n" +
+	"\tdeletedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"F\n" +
 	"\fLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"]\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xe7\x02\n" +
 	"\rLoginResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x126\n" +
-	"\bexpireAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\"\x15\n" +
-	"\x13RefreshTokenRequest\"d\n" +
+	"\bexpireAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x12N\n" +
+	"\x14refreshTokenExpireAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x14refreshTokenExpireAt\x12\"\n" +
+	"\ftotpRequired\x18\x05 \x01(\bR\ftotpRequired\x12&\n" +
+	"\x0echallengeToken\x18\x06 \x01(\tR\x0echallengeToken\x12H\n" +
+	"\x11challengeExpireAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x11challengeExpireAt\"N\n" +
+	"\x10LoginTOTPRequest\x12&\n" +
+	"\x0echallengeToken\x18\x01 \x01(\tR\x0echallengeToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"9\n" +
+	"\x13RefreshTokenRequest\x12\"\n" +
+	"\frefreshToken\x18\x01 \x01(\tR\frefreshToken\"\xd8\x01\n" +
 	"\x14RefreshTokenResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x126\n" +
-	"\bexpireAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\"s\n" +
+	"\bexpireAt\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\x12\"\n" +
+	"\frefreshToken\x18\x03 \x01(\tR\frefreshToken\x12N\n" +
+	"\x14refreshTokenExpireAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x14refreshTokenExpireAt\"\x0f\n" +
+	"\rLogoutRequest\"\x10\n" +
+	"\x0eLogoutResponse\"s\n" +
 	"\x15ChangePasswordRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12 \n" +
 	"\voldPassword\x18\x02 \x01(\tR\voldPassword\x12 \n" +
 	"\vnewPassword\x18\x03 \x01(\tR\vnewPassword\"\x18\n" +
-	"\x16ChangePasswordResponse\"\xa5\x01\n" +
+	"\x16ChangePasswordResponse\"+\n" +
+	"\x11EnrollTOTPRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\">\n" +
+	"\x12EnrollTOTPResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\"@\n" +
+	"\x12ConfirmTOTPRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\";\n" +
+	"\x13ConfirmTOTPResponse\x12$\n" +
+	"\rrecoveryCodes\x18\x01 \x03(\tR\rrecoveryCodes\"@\n" +
+	"\x12DisableTOTPRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x15\n" +
+	"\x13DisableTOTPResponse\"L\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"G\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12$\n" +
+	"\rrecoveryCodes\x18\x01 \x03(\tR\rrecoveryCodes\"\xa5\x01\n" +
 	"\x11CreateUserRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
@@ -1005,33 +2067,63 @@ const file_apiserver_v1_user_proto_rawDesc = "" +
 	"\x05phone\x18\x05 \x01(\tR\x05phoneB\v\n" +
 	"\t_nickname\",\n" +
 	"\x12CreateUserResponse\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\"\xd1\x01\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\xff\x01\n" +
 	"\x11UpdateUserRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1f\n" +
 	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12\x1f\n" +
 	"\bnickname\x18\x03 \x01(\tH\x01R\bnickname\x88\x01\x01\x12\x19\n" +
 	"\x05email\x18\x04 \x01(\tH\x02R\x05email\x88\x01\x01\x12\x19\n" +
-	"\x05phone\x18\x05 \x01(\tH\x03R\x05phone\x88\x01\x01B\v\n" +
+	"\x05phone\x18\x05 \x01(\tH\x03R\x05phone\x88\x01\x01\x12\x1f\n" +
+	"\bdisabled\x18\x06 \x01(\bH\x04R\bdisabled\x88\x01\x01B\v\n" +
 	"\t_usernameB\v\n" +
 	"\t_nicknameB\b\n" +
 	"\x06_emailB\b\n" +
-	"\x06_phone\"\x14\n" +
+	"\x06_phoneB\v\n" +
+	"\t_disabled\"\x14\n" +
 	"\x12UpdateUserResponse\"+\n" +
 	"\x11DeleteUserRequest\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x14\n" +
-	"\x12DeleteUserResponse\"(\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"J\n" +
+	"\x12DeleteUserResponse\x124\n" +
+	"\apurgeAt\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"(\n" +
 	"\x0eGetUserRequest\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\"/\n" +
 	"\x0fGetUserResponse\x12\x1c\n" +
-	"\x04user\x18\x01 \x01(\v2\b.v1.UserR\x04user\"?\n" +
+	"\x04user\x18\x01 \x01(\v2\b.v1.UserR\x04user\"\xd3\x02\n" +
 	"\x0fListUserRequest\x12\x16\n" +
 	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x03R\x05limit\"R\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tH\x00R\tpageToken\x88\x01\x01\x12(\n" +
+	"\x10with_total_count\x18\x04 \x01(\bR\x0ewithTotalCount\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\x12?\n" +
+	"\rcreated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12\x16\n" +
+	"\x06filter\x18\b \x01(\tR\x06filterB\r\n" +
+	"\v_page_token\"z\n" +
 	"\x10ListUserResponse\x12\x1e\n" +
 	"\n" +
 	"totalCount\x18\x01 \x01(\x03R\n" +
 	"totalCount\x12\x1e\n" +
-	"\x05users\x18\x02 \x03(\v2\b.v1.UserR\x05usersB6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
+	"\x05users\x18\x02 \x03(\v2\b.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"W\n" +
+	"\x15ExportUserDataRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1b\n" +
+	"\x06format\x18\x02 \x01(\tH\x00R\x06format\x88\x01\x01B\t\n" +
+	"\a_format\"D\n" +
+	"\x14ListTrashUserRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"W\n" +
+	"\x15ListTrashUserResponse\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x1e\n" +
+	"\x05users\x18\x02 \x03(\v2\b.v1.UserR\x05users\",\n" +
+	"\x12RestoreUserRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x15\n" +
+	"\x13RestoreUserResponse\"*\n" +
+	"\x10PurgeUserRequest\x12\x16\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\"\x13\n" +
+	"\x11PurgeUserResponseB6Z4github.com/loveRyujin/fast_blog/pkg/api/apiserver/v1b\x06proto3"
 
 var (
 	file_apiserver_v1_user_proto_rawDescOnce sync.Once
@@ -1045,39 +2137,65 @@ func file_apiserver_v1_user_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_user_proto_rawDescData
 }
 
-var file_apiserver_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_apiserver_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_apiserver_v1_user_proto_goTypes = []any{
-	(*User)(nil),                   // 0: v1.User
-	(*LoginRequest)(nil),           // 1: v1.LoginRequest
-	(*LoginResponse)(nil),          // 2: v1.LoginResponse
-	(*RefreshTokenRequest)(nil),    // 3: v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),   // 4: v1.RefreshTokenResponse
-	(*ChangePasswordRequest)(nil),  // 5: v1.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil), // 6: v1.ChangePasswordResponse
-	(*CreateUserRequest)(nil),      // 7: v1.CreateUserRequest
-	(*CreateUserResponse)(nil),     // 8: v1.CreateUserResponse
-	(*UpdateUserRequest)(nil),      // 9: v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),     // 10: v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),      // 11: v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),     // 12: v1.DeleteUserResponse
-	(*GetUserRequest)(nil),         // 13: v1.GetUserRequest
-	(*GetUserResponse)(nil),        // 14: v1.GetUserResponse
-	(*ListUserRequest)(nil),        // 15: v1.ListUserRequest
-	(*ListUserResponse)(nil),       // 16: v1.ListUserResponse
-	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
+	(*User)(nil),                            // 0: v1.User
+	(*LoginRequest)(nil),                    // 1: v1.LoginRequest
+	(*LoginResponse)(nil),                   // 2: v1.LoginResponse
+	(*LoginTOTPRequest)(nil),                // 3: v1.LoginTOTPRequest
+	(*RefreshTokenRequest)(nil),             // 4: v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),            // 5: v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),                   // 6: v1.LogoutRequest
+	(*LogoutResponse)(nil),                  // 7: v1.LogoutResponse
+	(*ChangePasswordRequest)(nil),           // 8: v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 9: v1.ChangePasswordResponse
+	(*EnrollTOTPRequest)(nil),               // 10: v1.EnrollTOTPRequest
+	(*EnrollTOTPResponse)(nil),              // 11: v1.EnrollTOTPResponse
+	(*ConfirmTOTPRequest)(nil),              // 12: v1.ConfirmTOTPRequest
+	(*ConfirmTOTPResponse)(nil),             // 13: v1.ConfirmTOTPResponse
+	(*DisableTOTPRequest)(nil),              // 14: v1.DisableTOTPRequest
+	(*DisableTOTPResponse)(nil),             // 15: v1.DisableTOTPResponse
+	(*RegenerateRecoveryCodesRequest)(nil),  // 16: v1.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil), // 17: v1.RegenerateRecoveryCodesResponse
+	(*CreateUserRequest)(nil),               // 18: v1.CreateUserRequest
+	(*CreateUserResponse)(nil),              // 19: v1.CreateUserResponse
+	(*UpdateUserRequest)(nil),               // 20: v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),              // 21: v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),               // 22: v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),              // 23: v1.DeleteUserResponse
+	(*GetUserRequest)(nil),                  // 24: v1.GetUserRequest
+	(*GetUserResponse)(nil),                 // 25: v1.GetUserResponse
+	(*ListUserRequest)(nil),                 // 26: v1.ListUserRequest
+	(*ListUserResponse)(nil),                // 27: v1.ListUserResponse
+	(*ExportUserDataRequest)(nil),           // 28: v1.ExportUserDataRequest
+	(*ListTrashUserRequest)(nil),            // 29: v1.ListTrashUserRequest
+	(*ListTrashUserResponse)(nil),           // 30: v1.ListTrashUserResponse
+	(*RestoreUserRequest)(nil),              // 31: v1.RestoreUserRequest
+	(*RestoreUserResponse)(nil),             // 32: v1.RestoreUserResponse
+	(*PurgeUserRequest)(nil),                // 33: v1.PurgeUserRequest
+	(*PurgeUserResponse)(nil),               // 34: v1.PurgeUserResponse
+	(*timestamppb.Timestamp)(nil),           // 35: google.protobuf.Timestamp
 }
 var file_apiserver_v1_user_proto_depIdxs = []int32{
-	17, // 0: v1.User.createdAt:type_name -> google.protobuf.Timestamp
-	17, // 1: v1.User.updatedAt:type_name -> google.protobuf.Timestamp
-	17, // 2: v1.LoginResponse.expireAt:type_name -> google.protobuf.Timestamp
-	17, // 3: v1.RefreshTokenResponse.expireAt:type_name -> google.protobuf.Timestamp
-	0,  // 4: v1.GetUserResponse.user:type_name -> v1.User
-	0,  // 5: v1.ListUserResponse.users:type_name -> v1.User
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	35, // 0: v1.User.createdAt:type_name -> google.protobuf.Timestamp
+	35, // 1: v1.User.updatedAt:type_name -> google.protobuf.Timestamp
+	35, // 2: v1.User.deletedAt:type_name -> google.protobuf.Timestamp
+	35, // 3: v1.LoginResponse.expireAt:type_name -> google.protobuf.Timestamp
+	35, // 4: v1.LoginResponse.refreshTokenExpireAt:type_name -> google.protobuf.Timestamp
+	35, // 5: v1.LoginResponse.challengeExpireAt:type_name -> google.protobuf.Timestamp
+	35, // 6: v1.RefreshTokenResponse.expireAt:type_name -> google.protobuf.Timestamp
+	35, // 7: v1.RefreshTokenResponse.refreshTokenExpireAt:type_name -> google.protobuf.Timestamp
+	35, // 8: v1.DeleteUserResponse.purgeAt:type_name -> google.protobuf.Timestamp
+	0,  // 9: v1.GetUserResponse.user:type_name -> v1.User
+	35, // 10: v1.ListUserRequest.created_after:type_name -> google.protobuf.Timestamp
+	35, // 11: v1.ListUserRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,  // 12: v1.ListUserResponse.users:type_name -> v1.User
+	0,  // 13: v1.ListTrashUserResponse.users:type_name -> v1.User
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_user_proto_init() }
@@ -1085,15 +2203,17 @@ func file_apiserver_v1_user_proto_init() {
 	if File_apiserver_v1_user_proto != nil {
 		return
 	}
-	file_apiserver_v1_user_proto_msgTypes[7].OneofWrappers = []any{}
-	file_apiserver_v1_user_proto_msgTypes[9].OneofWrappers = []any{}
+	file_apiserver_v1_user_proto_msgTypes[18].OneofWrappers = []any{}
+	file_apiserver_v1_user_proto_msgTypes[20].OneofWrappers = []any{}
+	file_apiserver_v1_user_proto_msgTypes[26].OneofWrappers = []any{}
+	file_apiserver_v1_user_proto_msgTypes[28].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_user_proto_rawDesc), len(file_apiserver_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,50 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// CacheTypeNone 表示不使用缓存，所有查询直接访问存储层
+	CacheTypeNone = "none"
+	// CacheTypeMemory 表示使用进程内的 LRU 缓存，多实例部署时各实例的缓存互相独立
+	CacheTypeMemory = "memory"
+	// CacheTypeRedis 表示使用 redis 缓存，连接配置位于 redis 配置项中
+	CacheTypeRedis = "redis"
+)
+
+var availableCacheTypes = sets.New(CacheTypeNone, CacheTypeMemory, CacheTypeRedis)
+
+type CacheOptions struct {
+	Type    string        `json:"type" mapstructure:"type"`         // 缓存实现，支持none、memory、redis
+	Size    int           `json:"size" mapstructure:"size"`         // memory缓存最多保存的条目数，超出时淘汰最久未使用的条目
+	PostTTL time.Duration `json:"post-ttl" mapstructure:"post-ttl"` // 文章缓存的过期时间，为0时不缓存文章
+	UserTTL time.Duration `json:"user-ttl" mapstructure:"user-ttl"` // 用户缓存的过期时间，为0时不缓存用户
+}
+
+func NewCacheOptions() *CacheOptions {
+	return &CacheOptions{
+		Type:    CacheTypeMemory,
+		Size:    10000,
+		PostTTL: 5 * time.Minute,
+		UserTTL: 5 * time.Minute,
+	}
+}
+
+// 校验缓存配置
+func (o *CacheOptions) Validate() error {
+	if !availableCacheTypes.Has(o.Type) {
+		return fmt.Errorf("invalid cache type: %s, available types: %v", o.Type, sets.List(availableCacheTypes))
+	}
+	if o.Type == CacheTypeMemory && o.Size <= 0 {
+		return fmt.Errorf("cache.size must be greater than 0")
+	}
+	if o.PostTTL < 0 || o.UserTTL < 0 {
+		return fmt.Errorf("cache.post-ttl and cache.user-ttl must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package options
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// DBTypeMySQL 表示使用 MySQL 作为存储后端
+	DBTypeMySQL = "mysql"
+	// DBTypeSQLite 表示使用 SQLite 作为存储后端
+	DBTypeSQLite = "sqlite"
+	// DBTypePostgreSQL 表示使用 PostgreSQL 作为存储后端
+	DBTypePostgreSQL = "postgresql"
+)
+
+var availableDBTypes = sets.New(DBTypeMySQL, DBTypeSQLite, DBTypePostgreSQL)
+
+type DBOptions struct {
+	Type string `json:"type" mapstructure:"type"` // 存储后端类型，支持mysql、sqlite、postgresql，对应的连接配置分别位于mysql、sqlite、postgresql配置项中
+}
+
+func NewDBOptions() *DBOptions {
+	return &DBOptions{
+		Type: DBTypeMySQL,
+	}
+}
+
+// 校验存储后端配置
+func (o *DBOptions) Validate() error {
+	if !availableDBTypes.Has(o.Type) {
+		return fmt.Errorf("invalid db type: %s, available types: %v", o.Type, sets.List(availableDBTypes))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// LockoutStoreMemory 表示登录失败计数保存在进程内，多实例部署时各实例的计数互相独立
+	LockoutStoreMemory = "memory"
+	// LockoutStoreRedis 表示登录失败计数保存在 redis 中，连接配置位于 redis 配置项中
+	LockoutStoreRedis = "redis"
+)
+
+var availableLockoutStores = sets.New(LockoutStoreMemory, LockoutStoreRedis)
+
+type LockoutOptions struct {
+	Store           string        `json:"store" mapstructure:"store"`                         // 登录失败计数的存储，支持memory、redis
+	MaxUserFailures int           `json:"max-user-failures" mapstructure:"max-user-failures"` // 同一用户名允许的失败次数，达到后锁定该用户名，为0时不限制
+	MaxIPFailures   int           `json:"max-ip-failures" mapstructure:"max-ip-failures"`     // 同一客户端IP允许的失败次数，达到后锁定该IP，为0时不限制
+	Window          time.Duration `json:"window" mapstructure:"window"`                       // 失败计数的有效期
+	BaseDuration    time.Duration `json:"base-duration" mapstructure:"base-duration"`         // 第一次锁定的时长，之后每多失败一次锁定时长翻倍
+	MaxDuration     time.Duration `json:"max-duration" mapstructure:"max-duration"`           // 锁定时长的上限
+}
+
+func NewLockoutOptions() *LockoutOptions {
+	return &LockoutOptions{
+		Store:           LockoutStoreMemory,
+		MaxUserFailures: 5,
+		MaxIPFailures:   20,
+		Window:          15 * time.Minute,
+		BaseDuration:    time.Minute,
+		MaxDuration:     time.Hour,
+	}
+}
+
+// 校验登录锁定配置
+func (o *LockoutOptions) Validate() error {
+	if !availableLockoutStores.Has(o.Store) {
+		return fmt.Errorf("invalid lockout store: %s, available stores: %v", o.Store, sets.List(availableLockoutStores))
+	}
+	if o.MaxUserFailures < 0 || o.MaxIPFailures < 0 {
+		return fmt.Errorf("lockout.max-user-failures and lockout.max-ip-failures must not be negative")
+	}
+	if o.Window <= 0 || o.BaseDuration <= 0 {
+		return fmt.Errorf("lockout.window and lockout.base-duration must be greater than 0")
+	}
+	if o.MaxDuration < o.BaseDuration {
+		return fmt.Errorf("lockout.max-duration must not be less than lockout.base-duration")
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type PostgreSQLOptions struct {
+	Addr                  string        `json:"addr,omitempty" mapstructure:"addr"`
+	Username              string        `json:"username,omitempty" mapstructure:"username"`
+	Password              string        `json:"-" mapstructure:"password"`
+	Database              string        `json:"database" mapstructure:"database"`
+	SSLMode               string        `json:"ssl-mode,omitempty" mapstructure:"ssl-mode"`
+	MaxIdleConnections    int           `json:"max-idle-connections,omitempty" mapstructure:"max-idle-connections,omitempty"`
+	MaxOpenConnections    int           `json:"max-open-connections,omitempty" mapstructure:"max-open-connections,omitempty"`
+	MaxConnectionLifeTime time.Duration `json:"max-connection-lifetime,omitzero" mapstructure:"max-connection-life-time"`
+}
+
+func NewPostgreSQLOptions() *PostgreSQLOptions {
+	return &PostgreSQLOptions{
+		Addr:                  "localhost:5432",
+		Username:              "postgres",
+		Database:              "fastgo",
+		SSLMode:               "disable",
+		MaxIdleConnections:    100,
+		MaxOpenConnections:    100,
+		MaxConnectionLifeTime: time.Duration(10) * time.Second,
+	}
+}
+
+// 校验postgresql配置
+func (o *PostgreSQLOptions) Validate() error {
+	// 检验postgresql地址格式，必须为host:port格式
+	if o.Addr == "" {
+		return fmt.Errorf("postgresql.addr is required")
+	}
+	host, portStr, err := net.SplitHostPort(o.Addr)
+	if err != nil {
+		return fmt.Errorf("postgresql addr wrong format: %s: %v", o.Addr, err)
+	}
+	if host == "" {
+		return fmt.Errorf("postgresql addr host is required")
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < MINPORTNUM || port > MAXPORTNUM {
+		return fmt.Errorf("postgresql addr port is invalid: %s", portStr)
+	}
+
+	// 校验postgresql用户名、数据库，本地信任认证时密码可以为空
+	if o.Username == "" {
+		return fmt.Errorf("postgresql.username is required")
+	}
+	if o.Database == "" {
+		return fmt.Errorf("postgresql.database is required")
+	}
+
+	// 校验postgresql连接池配置
+	if o.MaxIdleConnections <= 0 {
+		return fmt.Errorf("postgresql.max-idle-connections must be greater than 0")
+	}
+	if o.MaxOpenConnections <= 0 {
+		return fmt.Errorf("postgresql.max-open-connections must be greater than 0")
+	}
+	if o.MaxOpenConnections < o.MaxIdleConnections {
+		return fmt.Errorf("postgresql.max-open-connections must be greater than or equal to postgresql.max-idle-connections")
+	}
+	if o.MaxConnectionLifeTime <= 0 {
+		return fmt.Errorf("postgresql.max-connection-lifetime must be greater than 0")
+	}
+
+	return nil
+}
+
+// DSN return DSN from PostgreSQLOptions.
+func (o *PostgreSQLOptions) DSN() string {
+	dsn := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(o.Username, o.Password),
+		Host:   o.Addr,
+		Path:   o.Database,
+	}
+	if o.SSLMode != "" {
+		dsn.RawQuery = url.Values{"sslmode": []string{o.SSLMode}}.Encode()
+	}
+
+	return dsn.String()
+}
+
+// NewDB create postgresql store with the given config.
+func (o *PostgreSQLOptions) NewDB() (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(o.DSN()), &gorm.Config{
+		// PrepareStmt executes the given query in cached statement.
+		// This can improve performance.
+		PrepareStmt: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	// SetMaxOpenConns sets the maximum number of open connections to the database.
+	sqlDB.SetMaxOpenConns(o.MaxOpenConnections)
+
+	// SetConnMaxLifetime sets the maximum amount of time a connection may be reused.
+	sqlDB.SetConnMaxLifetime(o.MaxConnectionLifeTime)
+
+	// SetMaxIdleConns sets the maximum number of connections in the idle connection pool.
+	sqlDB.SetMaxIdleConns(o.MaxIdleConnections)
+
+	return db, nil
+}
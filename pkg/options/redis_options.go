@@ -0,0 +1,78 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisOptions struct {
+	Addr         string        `json:"addr,omitempty" mapstructure:"addr"`
+	Username     string        `json:"username,omitempty" mapstructure:"username"`
+	Password     string        `json:"-" mapstructure:"password"`
+	Database     int           `json:"database" mapstructure:"database"`
+	PoolSize     int           `json:"pool-size,omitempty" mapstructure:"pool-size"`        // 连接池大小，为 0 时使用 go-redis 的默认值
+	DialTimeout  time.Duration `json:"dial-timeout,omitzero" mapstructure:"dial-timeout"`   // 建立连接的超时时间
+	ReadTimeout  time.Duration `json:"read-timeout,omitzero" mapstructure:"read-timeout"`   // 读取命令结果的超时时间
+	WriteTimeout time.Duration `json:"write-timeout,omitzero" mapstructure:"write-timeout"` // 发送命令的超时时间
+}
+
+func NewRedisOptions() *RedisOptions {
+	return &RedisOptions{
+		Addr:         "127.0.0.1:6379",
+		DialTimeout:  5 * time.Second,
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+	}
+}
+
+// 校验redis配置
+func (o *RedisOptions) Validate() error {
+	host, portStr, err := net.SplitHostPort(o.Addr)
+	if err != nil {
+		return fmt.Errorf("redis addr wrong format: %s: %v", o.Addr, err)
+	}
+	if host == "" {
+		return fmt.Errorf("redis addr host is required")
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < MINPORTNUM || port > MAXPORTNUM {
+		return fmt.Errorf("redis addr port is invalid: %s", portStr)
+	}
+
+	if o.Database < 0 {
+		return fmt.Errorf("redis.database must not be negative")
+	}
+	if o.PoolSize < 0 {
+		return fmt.Errorf("redis.pool-size must not be negative")
+	}
+
+	return nil
+}
+
+// NewClient 创建 redis 客户端，并通过 PING 检查连接是否可用.
+func (o *RedisOptions) NewClient() (*redis.Client, error) {
+	client := redis.NewClient(&redis.Options{
+		Addr:         o.Addr,
+		Username:     o.Username,
+		Password:     o.Password,
+		DB:           o.Database,
+		PoolSize:     o.PoolSize,
+		DialTimeout:  o.DialTimeout,
+		ReadTimeout:  o.ReadTimeout,
+		WriteTimeout: o.WriteTimeout,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), o.DialTimeout)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to redis %s: %w", o.Addr, err)
+	}
+
+	return client, nil
+}
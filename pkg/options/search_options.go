@@ -0,0 +1,36 @@
+package options
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// SearchEngineMySQL 表示使用 MySQL 的 FULLTEXT 索引进行全文检索
+	SearchEngineMySQL = "mysql"
+	// SearchEngineBleve 表示使用内嵌的 bleve 索引进行全文检索
+	SearchEngineBleve = "bleve"
+)
+
+var availableSearchEngines = sets.New(SearchEngineMySQL, SearchEngineBleve)
+
+type SearchOptions struct {
+	Engine    string `json:"engine" mapstructure:"engine"`         // 全文检索引擎，支持mysql、bleve
+	IndexPath string `json:"index-path" mapstructure:"index-path"` // bleve索引的存储目录，为空时索引只保存在内存中
+}
+
+func NewSearchOptions() *SearchOptions {
+	return &SearchOptions{
+		Engine: SearchEngineBleve,
+	}
+}
+
+// 校验全文检索配置
+func (o *SearchOptions) Validate() error {
+	if !availableSearchEngines.Has(o.Engine) {
+		return fmt.Errorf("invalid search engine: %s, available engines: %v", o.Engine, sets.List(availableSearchEngines))
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package options
+
+import (
+	"fmt"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// SQLiteMemoryDatabase 表示使用内存数据库，服务退出后数据即丢失.
+const SQLiteMemoryDatabase = ":memory:"
+
+type SQLiteOptions struct {
+	Database           string `json:"database" mapstructure:"database"`                                             // 数据库文件路径，为:memory:时使用内存数据库
+	MaxOpenConnections int    `json:"max-open-connections,omitempty" mapstructure:"max-open-connections,omitempty"` // SQLite同一时间只允许一个写入者，默认为1
+}
+
+func NewSQLiteOptions() *SQLiteOptions {
+	return &SQLiteOptions{
+		Database:           SQLiteMemoryDatabase,
+		MaxOpenConnections: 1,
+	}
+}
+
+// 校验sqlite配置
+func (o *SQLiteOptions) Validate() error {
+	if o.Database == "" {
+		return fmt.Errorf("sqlite.database is required")
+	}
+	if o.MaxOpenConnections <= 0 {
+		return fmt.Errorf("sqlite.max-open-connections must be greater than 0")
+	}
+
+	return nil
+}
+
+// DSN return DSN from SQLiteOptions.
+func (o *SQLiteOptions) DSN() string {
+	// 内存数据库需要共享缓存，否则每个连接都会看到一个独立的空数据库
+	if o.Database == SQLiteMemoryDatabase {
+		return "file::memory:?cache=shared"
+	}
+
+	return fmt.Sprintf("file:%s?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", o.Database)
+}
+
+// NewDB create sqlite store with the given config.
+func (o *SQLiteOptions) NewDB() (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(o.DSN()), &gorm.Config{
+		PrepareStmt: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	// SetMaxOpenConns sets the maximum number of open connections to the database.
+	sqlDB.SetMaxOpenConns(o.MaxOpenConnections)
+
+	// 内存数据库在最后一个连接关闭时销毁，因此连接需要一直保留
+	sqlDB.SetMaxIdleConns(o.MaxOpenConnections)
+	sqlDB.SetConnMaxLifetime(0)
+
+	return db, nil
+}
@@ -0,0 +1,32 @@
+package token
+
+import (
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// SignChallenge 为已通过密码验证、还需要完成两步验证的用户 userID 签发短期有效的挑战令牌.
+// 挑战令牌不包含会话 ID，头部类型与访问令牌不同，不能用于访问其它接口.
+func (m *Manager) SignChallenge(userID string) (string, time.Time, error) {
+	claims := m.newClaims(userID, m.challengeExpiration)
+
+	tokenString, err := m.sign(claims, challengeTokenType)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return tokenString, claims.ExpiresAt.Time, nil
+}
+
+// ParseChallenge 解析挑战令牌，返回挑战令牌所属的用户 ID.
+func (m *Manager) ParseChallenge(tokenString string) (string, error) {
+	var claims Claims
+	if err := m.parse(tokenString, &claims, challengeTokenType); err != nil {
+		return "", err
+	}
+
+	if claims.Subject == "" || claims.SessionID != "" {
+		return "", jwt.ErrSignatureInvalid
+	}
+	return claims.Subject, nil
+}
@@ -0,0 +1,167 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// minRSAKeyBits 是 RSA 密钥的最小长度.
+const minRSAKeyBits = 2048
+
+// Key 是签发或验证 token 使用的非对称密钥，支持 RSA（RS256）和 Ed25519（EdDSA）.
+type Key struct {
+	// ID 是密钥的 kid，取 RFC 7638 定义的 JWK 指纹，签发的 token 头部会带上该值
+	ID string
+	// method 是密钥对应的签名算法
+	method jwt.SigningMethod
+	// private 是签名使用的私钥，只有公钥的密钥为 nil，只能用于验证
+	private crypto.Signer
+	// public 是验证签名使用的公钥
+	public crypto.PublicKey
+}
+
+// JWK 是 RFC 7517 定义的 JSON Web Key，只包含公钥参数.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// N 和 E 是 RSA 公钥的模数和指数
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Crv 和 X 是 Ed25519 公钥的曲线和公钥值
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSet 是 RFC 7517 定义的 JWK Set，由 /.well-known/jwks.json 返回.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// LoadKeyFile 从 PEM 文件中加载密钥.
+func LoadKeyFile(path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ParseKeyPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+// ParseKeyPEM 解析 PEM 编码的 RSA 或 Ed25519 密钥.
+// 支持 PKCS#8、PKCS#1 格式的私钥和 PKIX、PKCS#1 格式的公钥，公钥只能用于验证.
+func ParseKeyPEM(data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	var (
+		raw any
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		raw, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		raw, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		raw, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		raw, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key := &Key{}
+	switch k := raw.(type) {
+	case *rsa.PrivateKey:
+		key.method, key.private, key.public = jwt.SigningMethodRS256, k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.method, key.public = jwt.SigningMethodRS256, k
+	case ed25519.PrivateKey:
+		key.method, key.private, key.public = jwt.SigningMethodEdDSA, k, k.Public()
+	case ed25519.PublicKey:
+		key.method, key.public = jwt.SigningMethodEdDSA, k
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, only RSA and Ed25519 keys are supported", raw)
+	}
+	if pub, ok := key.public.(*rsa.PublicKey); ok && pub.N.BitLen() < minRSAKeyBits {
+		return nil, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+	}
+
+	key.ID, err = key.thumbprint()
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// CanSign 返回密钥是否包含私钥，只有包含私钥的密钥可以签发 token.
+func (k *Key) CanSign() bool {
+	return k.private != nil
+}
+
+// JWK 返回密钥的公钥部分.
+func (k *Key) JWK() JWK {
+	jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.method.Alg()}
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// thumbprint 计算 RFC 7638 定义的 JWK 指纹，只使用必需的公钥参数并按字典序排列.
+func (k *Key) thumbprint() (string, error) {
+	jwk := k.JWK()
+
+	var members any
+	switch jwk.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	default:
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
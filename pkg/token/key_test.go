@@ -0,0 +1,112 @@
+package token_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loveRyujin/fast_blog/pkg/token"
+)
+
+func encodePEM(t *testing.T, typ string, key any) []byte {
+	var (
+		der []byte
+		err error
+	)
+	if typ == "PUBLIC KEY" {
+		der, err = x509.MarshalPKIXPublicKey(key)
+	} else {
+		der, err = x509.MarshalPKCS8PrivateKey(key)
+	}
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+}
+
+func TestKeyRotation(t *testing.T) {
+	_, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaPriv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	signingKey, err := token.ParseKeyPEM(encodePEM(t, "PRIVATE KEY", edPriv))
+	require.NoError(t, err)
+	assert.True(t, signingKey.CanSign())
+
+	// 轮换后旧的 RSA 密钥只保留公钥用于验证，kid 与私钥相同
+	oldKey, err := token.ParseKeyPEM(encodePEM(t, "PUBLIC KEY", &rsaPriv.PublicKey))
+	require.NoError(t, err)
+	assert.False(t, oldKey.CanSign())
+	oldPrivKey, err := token.ParseKeyPEM(encodePEM(t, "PRIVATE KEY", rsaPriv))
+	require.NoError(t, err)
+	assert.Equal(t, oldPrivKey.ID, oldKey.ID)
+
+	tokens, err := token.NewManager(token.WithKeys(signingKey, oldKey))
+	require.NoError(t, err)
+
+	tokenString, _, err := tokens.Sign("user-000001", "session-1", nil)
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "EdDSA", parsed.Method.Alg())
+	assert.Equal(t, signingKey.ID, parsed.Header["kid"])
+
+	claims, err := tokens.ParseBearer("Bearer " + tokenString)
+	require.NoError(t, err)
+	assert.Equal(t, "user-000001", claims.UserID())
+	assert.Equal(t, "session-1", claims.SessionID)
+
+	sign := func(method jwt.SigningMethod, kid string, key any) string {
+		tk := jwt.NewWithClaims(method, jwt.MapClaims{
+			"sub": "user-000001",
+			"sid": "session-1",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		tk.Header["kid"] = kid
+		s, err := tk.SignedString(key)
+		require.NoError(t, err)
+		return s
+	}
+
+	// 旧密钥签发的 token 仍然有效
+	_, err = tokens.Parse(sign(jwt.SigningMethodRS256, oldKey.ID, rsaPriv))
+	require.NoError(t, err)
+
+	// 未知的 kid 和与密钥不一致的算法都被拒绝
+	_, err = tokens.Parse(sign(jwt.SigningMethodRS256, "unknown", rsaPriv))
+	require.Error(t, err)
+	_, err = tokens.Parse(sign(jwt.SigningMethodHS256, signingKey.ID, []byte(signingKey.ID)))
+	require.Error(t, err)
+
+	jwks := tokens.JWKS()
+	require.Len(t, jwks.Keys, 2)
+	assert.Equal(t, signingKey.ID, jwks.Keys[0].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+	assert.Equal(t, "RS256", jwks.Keys[1].Alg)
+}
+
+func TestParseKeyPEM(t *testing.T) {
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = token.ParseKeyPEM(encodePEM(t, "PRIVATE KEY", weak))
+	require.Error(t, err)
+
+	_, err = token.ParseKeyPEM([]byte("not a key"))
+	require.Error(t, err)
+
+	// 只有公钥的密钥不能用于签发 token
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	pubKey, err := token.ParseKeyPEM(encodePEM(t, "PUBLIC KEY", pub))
+	require.NoError(t, err)
+	_, err = token.NewManager(token.WithKeys(pubKey))
+	require.Error(t, err)
+}
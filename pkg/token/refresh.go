@@ -0,0 +1,29 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// refreshTokenBytes 是刷新令牌包含的随机字节数.
+const refreshTokenBytes = 32
+
+// NewRefreshToken 生成一个不透明的随机刷新令牌，同时返回用于保存和查询的摘要.
+// 刷新令牌只在签发时返回给客户端，服务端只保存摘要.
+func NewRefreshToken() (string, string, error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	refreshToken := base64.RawURLEncoding.EncodeToString(b)
+	return refreshToken, HashRefreshToken(refreshToken), nil
+}
+
+// HashRefreshToken 返回刷新令牌的 SHA-256 摘要.
+func HashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -3,112 +3,275 @@ package token
 import (
 	"errors"
 	"fmt"
-	"sync"
+	"slices"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 )
 
-// Config 包括 token 包的配置选项.
-type Config struct {
-	// key 用于签发和解析 token 的密钥.
+// minHMACKeyLength 是 HS256 密钥的最小长度，HS256 的密钥不应短于 256 位.
+const minHMACKeyLength = 32
+
+// insecureKeys 是曾经内置在代码和示例配置中的密钥，已经公开，不能用于签发 token.
+var insecureKeys = []string{"Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5"}
+
+const (
+	// accessTokenType 是访问令牌头部的类型，与 jwt 库的默认值一致，兼容已经签发的 token
+	accessTokenType = "JWT"
+	// challengeTokenType 是两步验证挑战令牌头部的类型，避免挑战令牌被当作访问令牌使用
+	challengeTokenType = "totp-challenge+jwt"
+)
+
+var (
+	// ErrMissingKey 表示没有配置签发 token 的密钥.
+	ErrMissingKey = errors.New("token: either an HMAC key or a signing key is required")
+	// ErrInsecureKey 表示 HMAC 密钥过短或者是已经公开的内置密钥.
+	ErrInsecureKey = errors.New("token: the HMAC key is too short or is a well-known default key")
+)
+
+// Claims 是 token 中的声明.
+// 用户 ID 保存在标准声明 sub 中，iss、aud、jti、exp、iat 和 nbf 同样使用标准声明.
+type Claims struct {
+	jwt.RegisteredClaims
+	// SessionID 是签发 token 的登录会话 ID，会话被撤销后该会话签发的 token 不再有效
+	SessionID string `json:"sid"`
+	// Roles 是签发 token 时用户拥有的角色，供其他服务使用，本服务仍然以授权策略为准
+	Roles []string `json:"roles,omitempty"`
+}
+
+// UserID 返回 token 所属的用户 ID.
+func (c *Claims) UserID() string {
+	return c.Subject
+}
+
+// Manager 签发和解析 token.
+type Manager struct {
+	// key 是以 HS256 签发和验证 token 的密钥，配置了非对称密钥时不使用
 	key string
-	// identityKey 是 token 中用户身份的键.
-	identityKey string
+	// signingKey 是签发 token 使用的非对称密钥，为 nil 时使用 key 以 HS256 签发
+	signingKey *Key
+	// verificationKeys 是可以用于验证 token 的非对称密钥，以 kid 为键，包含 signingKey
+	verificationKeys map[string]*Key
+	// issuer 是 token 的签发者，不为空时解析 token 会校验 iss
+	issuer string
+	// audience 是 token 的受众，不为空时解析 token 会校验 aud
+	audience string
 	// expiration 是签发的 token 过期时间
 	expiration time.Duration
+	// challengeExpiration 是两步验证挑战令牌的过期时间
+	challengeExpiration time.Duration
 }
 
-var (
-	config = Config{"Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5", "identityKey", 2 * time.Hour}
-	once   sync.Once // 确保配置只被初始化一次
-)
+// Option 是 Manager 的配置选项.
+type Option func(*Manager)
 
-// Init 设置包级别的配置 config, config 会用于本包后面的 token 签发和解析.
-func Init(key string, identityKey string, expiration time.Duration) {
-	once.Do(func() {
-		if key != "" {
-			config.key = key // 设置密钥
-		}
-		if identityKey != "" {
-			config.identityKey = identityKey // 设置身份键
+// WithHMACKey 设置以 HS256 签发和验证 token 的密钥.
+func WithHMACKey(key string) Option {
+	return func(m *Manager) {
+		m.key = key
+	}
+}
+
+// WithKeys 设置非对称密钥，使用第一个密钥签发 token，所有密钥都可以用于验证 token，此时不再使用 HMAC 密钥.
+// 轮换密钥时将新密钥放在第一个，旧密钥保留到其签发的 token 全部过期.
+func WithKeys(keys ...*Key) Option {
+	return func(m *Manager) {
+		if len(keys) == 0 {
+			return
 		}
-		if expiration != 0 {
-			config.expiration = expiration
+		m.signingKey = keys[0]
+		m.verificationKeys = make(map[string]*Key, len(keys))
+		for _, k := range keys {
+			m.verificationKeys[k.ID] = k
 		}
-	})
+	}
 }
 
-// Parse 使用指定的密钥 key 解析 token，解析成功返回 token 上下文，否则报错.
-func Parse(tokenString string, key string) (string, error) {
-	// 解析 token
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (any, error) {
-		// 确保 token 加密算法是预期的加密算法
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, jwt.ErrSignatureInvalid
-		}
+// WithIssuer 设置 token 的签发者.
+func WithIssuer(issuer string) Option {
+	return func(m *Manager) {
+		m.issuer = issuer
+	}
+}
 
-		return []byte(key), nil // 返回密钥
-	})
-	// 解析失败
-	if err != nil {
-		return "", err
+// WithAudience 设置 token 的受众.
+func WithAudience(audience string) Option {
+	return func(m *Manager) {
+		m.audience = audience
+	}
+}
+
+// WithExpiration 设置签发的 token 过期时间.
+func WithExpiration(expiration time.Duration) Option {
+	return func(m *Manager) {
+		m.expiration = expiration
 	}
+}
 
-	var identityKey string
-	// 如果解析成功，从 token 中取出 token 的主题
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		if key, exists := claims[config.identityKey]; exists {
-			if identity, valid := key.(string); valid {
-				identityKey = identity // 获取身份键
-			}
+// WithChallengeExpiration 设置两步验证挑战令牌的过期时间.
+func WithChallengeExpiration(expiration time.Duration) Option {
+	return func(m *Manager) {
+		m.challengeExpiration = expiration
+	}
+}
+
+// NewManager 创建 Manager 实例，必须配置 HMAC 密钥或非对称签发密钥.
+// HMAC 密钥过短或者是已经公开的内置密钥时返回 ErrInsecureKey.
+func NewManager(opts ...Option) (*Manager, error) {
+	m := &Manager{expiration: 15 * time.Minute, challengeExpiration: 5 * time.Minute}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.signingKey != nil {
+		if !m.signingKey.CanSign() {
+			return nil, errors.New("token: the signing key must be a private key")
 		}
+		return m, nil
+	}
+
+	if m.key == "" {
+		return nil, ErrMissingKey
 	}
-	if identityKey == "" {
-		return "", jwt.ErrSignatureInvalid
+	if len(m.key) < minHMACKeyLength || slices.Contains(insecureKeys, m.key) {
+		return nil, ErrInsecureKey
 	}
+	return m, nil
+}
 
-	return identityKey, nil
+// Parse 解析 token，解析成功返回 token 中的声明，否则报错.
+// 不包含用户 ID 或会话 ID 的 token、挑战令牌，以及签发者、受众与配置不一致的 token 视为无效.
+func (m *Manager) Parse(tokenString string) (*Claims, error) {
+	var claims Claims
+	if err := m.parse(tokenString, &claims, accessTokenType); err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" || claims.SessionID == "" {
+		return nil, jwt.ErrSignatureInvalid
+	}
+	return &claims, nil
+}
+
+// parse 解析 token 并校验头部的类型 typ、签发者和受众.
+func (m *Manager) parse(tokenString string, claims *Claims, typ string) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, m.keyFunc)
+	if err != nil {
+		return err
+	}
+
+	if t, _ := token.Header["typ"].(string); t != typ {
+		return jwt.ErrSignatureInvalid
+	}
+	if m.issuer != "" && !claims.VerifyIssuer(m.issuer, true) {
+		return jwt.ErrTokenInvalidIssuer
+	}
+	if m.audience != "" && !claims.VerifyAudience(m.audience, true) {
+		return jwt.ErrTokenInvalidAudience
+	}
+	return nil
 }
 
-// ParseRequest 从请求头中获取令牌，并将其传递给 Parse 函数以解析令牌.
-func ParseRequest(c *gin.Context) (string, error) {
-	header := c.Request.Header.Get("Authorization")
+// ParseRequest 从请求头中获取令牌，并将其传递给 Parse 方法以解析令牌.
+func (m *Manager) ParseRequest(c *gin.Context) (*Claims, error) {
+	return m.ParseBearer(c.Request.Header.Get("Authorization"))
+}
 
+// ParseBearer 从 `Bearer <token>` 格式的认证信息中取出令牌，并将其传递给 Parse 方法以解析令牌.
+func (m *Manager) ParseBearer(header string) (*Claims, error) {
 	if len(header) == 0 {
 		//nolint: err113
-		return "", errors.New("the length of the `Authorization` header is zero") // 返回错误
+		return nil, errors.New("the length of the `Authorization` header is zero") // 返回错误
 	}
 
 	var token string
-	// 从请求头中取出 token
+	// 从认证信息中取出 token
 	fmt.Sscanf(header, "Bearer %s", &token)
 
-	return Parse(token, config.key)
+	return m.Parse(token)
 }
 
-// Sign 使用 jwtSecret 签发 token，token 的 claims 中会存放传入的 subject.
-func Sign(identityKey string) (string, time.Time, error) {
-	// 计算过期时间
-	expireAt := time.Now().Add(config.expiration)
-
-	// Token 的内容
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		config.identityKey: identityKey,       // 存放用户身份
-		"nbf":              time.Now().Unix(), // token 生效时间
-		"iat":              time.Now().Unix(), // token 签发时间
-		"exp":              expireAt.Unix(),   // token 过期时间
-	})
-	if config.key == "" {
-		return "", time.Time{}, jwt.ErrInvalidKey
-	}
+// Sign 为用户 userID 的登录会话 sessionID 签发 token，roles 是用户当前拥有的角色.
+func (m *Manager) Sign(userID string, sessionID string, roles []string) (string, time.Time, error) {
+	claims := m.newClaims(userID, m.expiration)
+	claims.SessionID = sessionID // 存放登录会话 ID
+	claims.Roles = roles
 
-	// 签发 token
-	tokenString, err := token.SignedString([]byte(config.key))
+	tokenString, err := m.sign(claims, accessTokenType)
 	if err != nil {
 		return "", time.Time{}, err
 	}
+	return tokenString, claims.ExpiresAt.Time, nil // 返回 token 字符串、过期时间和错误
+}
 
-	return tokenString, expireAt, nil // 返回 token 字符串、过期时间和错误
+// newClaims 创建用户 userID 的、有效期为 expiration 的声明.
+func (m *Manager) newClaims(userID string, expiration time.Duration) *Claims {
+	now := time.Now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,                                  // 存放用户 ID
+			Issuer:    m.issuer,                                // token 签发者
+			ID:        uuid.New().String(),                     // token 唯一标识
+			NotBefore: jwt.NewNumericDate(now),                 // token 生效时间
+			IssuedAt:  jwt.NewNumericDate(now),                 // token 签发时间
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiration)), // token 过期时间
+		},
+	}
+	if m.audience != "" {
+		claims.Audience = jwt.ClaimStrings{m.audience}
+	}
+	return claims
+}
+
+// sign 签名声明，typ 是 token 头部的类型，用于区分访问令牌和挑战令牌.
+// 配置了非对称密钥时使用签发密钥签名并在头部带上 kid，否则使用 HMAC 密钥以 HS256 签名.
+func (m *Manager) sign(claims *Claims, typ string) (string, error) {
+	if key := m.signingKey; key != nil {
+		token := jwt.NewWithClaims(key.method, claims)
+		token.Header["typ"] = typ
+		token.Header["kid"] = key.ID
+		return token.SignedString(key.private)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["typ"] = typ
+	return token.SignedString([]byte(m.key))
+}
+
+// JWKS 返回可以用于验证 token 的公钥集合，没有配置非对称密钥时为空集合.
+func (m *Manager) JWKS() *JWKSet {
+	set := &JWKSet{Keys: make([]JWK, 0, len(m.verificationKeys))}
+	if m.signingKey == nil {
+		return set
+	}
+
+	// 签发 token 使用的密钥排在第一个
+	set.Keys = append(set.Keys, m.signingKey.JWK())
+	for _, k := range m.verificationKeys {
+		if k != m.signingKey {
+			set.Keys = append(set.Keys, k.JWK())
+		}
+	}
+	return set
+}
+
+// keyFunc 根据 token 头部的 kid 和签名算法返回验证签名使用的密钥.
+func (m *Manager) keyFunc(token *jwt.Token) (any, error) {
+	// 没有配置非对称密钥时，只接受使用 HMAC 密钥签名的 token
+	if m.signingKey == nil {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return []byte(m.key), nil
+	}
+
+	// 配置了非对称密钥时，token 的签名算法必须与 kid 对应密钥的算法一致，避免算法混淆攻击
+	kid, _ := token.Header["kid"].(string)
+	key, ok := m.verificationKeys[kid]
+	if !ok || token.Method.Alg() != key.method.Alg() {
+		return nil, jwt.ErrSignatureInvalid
+	}
+	return key.public, nil
 }
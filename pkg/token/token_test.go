@@ -0,0 +1,89 @@
+package token_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loveRyujin/fast_blog/pkg/token"
+)
+
+const testKey = "test-key-0123456789-0123456789-0123456789"
+
+func TestNewManager(t *testing.T) {
+	_, err := token.NewManager()
+	require.ErrorIs(t, err, token.ErrMissingKey)
+
+	_, err = token.NewManager(token.WithHMACKey("short"))
+	require.ErrorIs(t, err, token.ErrInsecureKey)
+
+	// 曾经内置在代码和示例配置中的密钥已经公开，不能使用
+	_, err = token.NewManager(token.WithHMACKey("Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5"))
+	require.ErrorIs(t, err, token.ErrInsecureKey)
+}
+
+func TestManagerClaims(t *testing.T) {
+	tokens, err := token.NewManager(
+		token.WithHMACKey(testKey),
+		token.WithIssuer("fast_blog"),
+		token.WithAudience("fast_blog"),
+		token.WithExpiration(time.Minute),
+	)
+	require.NoError(t, err)
+
+	tokenString, expireAt, err := tokens.Sign("user-000001", "session-1", []string{"role::user"})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), expireAt, time.Second)
+
+	claims, err := tokens.Parse(tokenString)
+	require.NoError(t, err)
+	assert.Equal(t, "user-000001", claims.UserID())
+	assert.Equal(t, "session-1", claims.SessionID)
+	assert.Equal(t, "fast_blog", claims.Issuer)
+	assert.Equal(t, []string{"role::user"}, claims.Roles)
+	assert.True(t, claims.VerifyAudience("fast_blog", true))
+	assert.NotEmpty(t, claims.ID)
+
+	// 每次签发的 token 都有不同的 jti
+	other, _, err := tokens.Sign("user-000001", "session-1", nil)
+	require.NoError(t, err)
+	otherClaims, err := tokens.Parse(other)
+	require.NoError(t, err)
+	assert.NotEqual(t, claims.ID, otherClaims.ID)
+
+	// 受众或签发者不一致、密钥不同的 token 被拒绝
+	for _, opts := range [][]token.Option{
+		{token.WithHMACKey(testKey), token.WithIssuer("fast_blog"), token.WithAudience("other")},
+		{token.WithHMACKey(testKey), token.WithIssuer("other"), token.WithAudience("fast_blog")},
+		{token.WithHMACKey(testKey + "-other")},
+	} {
+		verifier, err := token.NewManager(opts...)
+		require.NoError(t, err)
+		_, err = verifier.Parse(tokenString)
+		assert.Error(t, err)
+	}
+}
+
+func TestManagerChallenge(t *testing.T) {
+	tokens, err := token.NewManager(token.WithHMACKey(testKey), token.WithChallengeExpiration(time.Minute))
+	require.NoError(t, err)
+
+	challenge, expireAt, err := tokens.SignChallenge("user-000001")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), expireAt, time.Second)
+
+	userID, err := tokens.ParseChallenge(challenge)
+	require.NoError(t, err)
+	assert.Equal(t, "user-000001", userID)
+
+	// 挑战令牌不能作为访问令牌使用，访问令牌也不能作为挑战令牌使用
+	_, err = tokens.Parse(challenge)
+	assert.Error(t, err)
+
+	accessToken, _, err := tokens.Sign("user-000001", "session-1", nil)
+	require.NoError(t, err)
+	_, err = tokens.ParseChallenge(accessToken)
+	assert.Error(t, err)
+}
@@ -0,0 +1,77 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/loveRyujin/fast_blog/pkg/totp"
+)
+
+func TestCode(t *testing.T) {
+	// RFC 6238 附录 B 中 SHA1 的测试向量，取后 6 位
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	for unix, want := range map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	} {
+		code, err := totp.Code(secret, totp.Step(time.Unix(unix, 0)))
+		require.NoError(t, err)
+		assert.Equal(t, want, code, unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := totp.NewSecret()
+	require.NoError(t, err)
+
+	now := time.Now()
+	code, err := totp.Code(secret, totp.Step(now))
+	require.NoError(t, err)
+
+	step, ok := totp.Validate(secret, code, now)
+	assert.True(t, ok)
+	assert.Equal(t, totp.Step(now), step)
+
+	// 允许前后一个时间步的时钟误差
+	_, ok = totp.Validate(secret, code, now.Add(totp.Period))
+	assert.True(t, ok)
+	_, ok = totp.Validate(secret, code, now.Add(3*totp.Period))
+	assert.False(t, ok)
+
+	_, ok = totp.Validate(secret, "12345", now)
+	assert.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri := totp.URI("fast_blog", "alice", "JBSWY3DPEHPK3PXP")
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/fast_blog:alice?"))
+	assert.Contains(t, uri, "secret=JBSWY3DPEHPK3PXP")
+	assert.Contains(t, uri, "issuer=fast_blog")
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := totp.NewRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		assert.Len(t, code, 11)
+		assert.False(t, totp.IsCode(code))
+		assert.False(t, seen[code])
+		seen[code] = true
+	}
+
+	// 摘要忽略大小写和连字符
+	assert.Equal(t, totp.HashRecoveryCode(codes[0]), totp.HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))))
+	assert.NotEqual(t, totp.HashRecoveryCode(codes[0]), totp.HashRecoveryCode(codes[1]))
+}
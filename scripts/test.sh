@@ -35,9 +35,9 @@ fg::test::user()
 {
   username=$(fg::test::username)
   # 1. 创建 fastgo 用户
-  ${CCURL} "${Header}" http://${INSECURE_SERVER}/v1/users \
-    -d'{"username":"'${username}'","password":"fastgo1234","nickname":"fastgo","email":"colin404@foxmail.com","phone":"'$(date +%s)'"}'; echo
-  echo -e "\033[32m1. 成功创建 ${username} 用户\033[0m"
+  userID=`${CCURL} "${Header}" http://${INSECURE_SERVER}/v1/users \
+    -d'{"username":"'${username}'","password":"fastgo1234","nickname":"fastgo","email":"colin404@foxmail.com","phone":"'$(date +%s)'"}' | grep -Po 'user-[a-z0-9]+'`
+  echo -e "\033[32m1. 成功创建 ${username} 用户: ${userID}\033[0m"
 
   token="-HAuthorization: Bearer $(fg::test::login ${username} fastgo1234)"
 
@@ -46,16 +46,16 @@ fg::test::user()
   echo -e "\033[32m2. 成功列出所有用户\033[0m"
 
   # 3. 获取 fastgo 用户的详细信息
-  ${RCURL} "${token}" http://${INSECURE_SERVER}/v1/users/${username}; echo
+  ${RCURL} "${token}" http://${INSECURE_SERVER}/v1/users/${userID}; echo
   echo -e "\033[32m3. 成功获取 ${username} 用户详细信息\033[0m"
 
   # 4. 修改 fastgo 用户
-  ${UCURL} "${Header}" "${token}" http://${INSECURE_SERVER}/v1/users/${username} \
+  ${UCURL} "${Header}" "${token}" http://${INSECURE_SERVER}/v1/users/${userID} \
     -d'{"nickname":"fastgo(modified)"}'; echo
   echo -e "\033[32m4. 成功修改 ${username} 用户信息\033[0m"
 
   # 5. 删除 fastgo 用户
-  ${DCURL} "${token}" http://${INSECURE_SERVER}/v1/users/${username}; echo
+  ${DCURL} "${token}" http://${INSECURE_SERVER}/v1/users/${userID}; echo
   echo -e "\033[32m5. 成功删除 ${username} 用户\033[0m"
 
   echo -e '\033[32m==> 所有用户接口测试成功\033[0m'
@@ -67,8 +67,8 @@ fg::test::post()
 
   username=$(fg::test::username)
   # 1. 创建测试用户
-  ${CCURL} "${Header}" "${token}" http://${INSECURE_SERVER}/v1/users \
-    -d'{"username":"'${username}'","password":"fastgo1234","nickname":"fastgo","email":"colin404@foxmail.com","phone":"'$(date +%s)'"}'; echo
+  userID=`${CCURL} "${Header}" "${token}" http://${INSECURE_SERVER}/v1/users \
+    -d'{"username":"'${username}'","password":"fastgo1234","nickname":"fastgo","email":"colin404@foxmail.com","phone":"'$(date +%s)'"}' | grep -Po 'user-[a-z0-9]+'`
   echo -e "\033[32m1. 成功创建测试用户: ${username}\033[0m"
 
   token="-HAuthorization: Bearer $(fg::test::login ${username} fastgo1234)"
@@ -93,7 +93,7 @@ fg::test::post()
   ${DCURL} "${token}" http://${INSECURE_SERVER}/v1/posts -d'{"postIDs":["'${postID}'"]}'; echo
   echo -e "\033[32m6. 成功删除博客 ${postID}\033[0m"
 
-  ${DCURL} "${token}" http://${INSECURE_SERVER}/v1/users/${username}; echo
+  ${DCURL} "${token}" http://${INSECURE_SERVER}/v1/users/${userID}; echo
   echo -e "\033[32m7. 成功删除测试用户：${username}\033[0m"
 
   echo -e '\033[32m==> 所有博客接口测试成功\033[0m'